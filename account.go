@@ -206,14 +206,121 @@ type AccountRequirementsDisabledReason string
 
 // List of values that AccountRequirementsDisabledReason can take
 const (
-	AccountRequirementsDisabledReasonFieldsNeeded           AccountRequirementsDisabledReason = "fields_needed"
-	AccountRequirementsDisabledReasonListed                 AccountRequirementsDisabledReason = "listed"
-	AccountRequirementsDisabledReasonOther                  AccountRequirementsDisabledReason = "other"
-	AccountRequirementsDisabledReasonRejectedFraud          AccountRequirementsDisabledReason = "rejected.fraud"
-	AccountRequirementsDisabledReasonRejectedListed         AccountRequirementsDisabledReason = "rejected.listed"
-	AccountRequirementsDisabledReasonRejectedOther          AccountRequirementsDisabledReason = "rejected.other"
-	AccountRequirementsDisabledReasonRejectedTermsOfService AccountRequirementsDisabledReason = "rejected.terms_of_service"
-	AccountRequirementsDisabledReasonUnderReview            AccountRequirementsDisabledReason = "under_review"
+	AccountRequirementsDisabledReasonFieldsNeeded                    AccountRequirementsDisabledReason = "fields_needed"
+	AccountRequirementsDisabledReasonListed                          AccountRequirementsDisabledReason = "listed"
+	AccountRequirementsDisabledReasonOther                           AccountRequirementsDisabledReason = "other"
+	AccountRequirementsDisabledReasonPlatformPaused                  AccountRequirementsDisabledReason = "platform_paused"
+	AccountRequirementsDisabledReasonRejectedFraud                   AccountRequirementsDisabledReason = "rejected.fraud"
+	AccountRequirementsDisabledReasonRejectedListed                  AccountRequirementsDisabledReason = "rejected.listed"
+	AccountRequirementsDisabledReasonRejectedOther                   AccountRequirementsDisabledReason = "rejected.other"
+	AccountRequirementsDisabledReasonRejectedTermsOfService          AccountRequirementsDisabledReason = "rejected.terms_of_service"
+	AccountRequirementsDisabledReasonRequirementsPastDue             AccountRequirementsDisabledReason = "requirements.past_due"
+	AccountRequirementsDisabledReasonRequirementsPendingVerification AccountRequirementsDisabledReason = "requirements.pending_verification"
+	AccountRequirementsDisabledReasonUnderReview                     AccountRequirementsDisabledReason = "under_review"
+)
+
+// The code for the type of error.
+type AccountFutureRequirementsErrorCode string
+
+// List of values that AccountFutureRequirementsErrorCode can take
+const (
+	AccountFutureRequirementsErrorCodeInvalidAddressCityStatePostalCode                      AccountFutureRequirementsErrorCode = "invalid_address_city_state_postal_code"
+	AccountFutureRequirementsErrorCodeInvalidStreetAddress                                   AccountFutureRequirementsErrorCode = "invalid_street_address"
+	AccountFutureRequirementsErrorCodeInvalidValueOther                                      AccountFutureRequirementsErrorCode = "invalid_value_other"
+	AccountFutureRequirementsErrorCodeVerificationDocumentAddressMismatch                    AccountFutureRequirementsErrorCode = "verification_document_address_mismatch"
+	AccountFutureRequirementsErrorCodeVerificationDocumentAddressMissing                     AccountFutureRequirementsErrorCode = "verification_document_address_missing"
+	AccountFutureRequirementsErrorCodeVerificationDocumentCorrupt                            AccountFutureRequirementsErrorCode = "verification_document_corrupt"
+	AccountFutureRequirementsErrorCodeVerificationDocumentCountryNotSupported                AccountFutureRequirementsErrorCode = "verification_document_country_not_supported"
+	AccountFutureRequirementsErrorCodeVerificationDocumentDobMismatch                        AccountFutureRequirementsErrorCode = "verification_document_dob_mismatch"
+	AccountFutureRequirementsErrorCodeVerificationDocumentDuplicateType                      AccountFutureRequirementsErrorCode = "verification_document_duplicate_type"
+	AccountFutureRequirementsErrorCodeVerificationDocumentExpired                            AccountFutureRequirementsErrorCode = "verification_document_expired"
+	AccountFutureRequirementsErrorCodeVerificationDocumentFailedCopy                         AccountFutureRequirementsErrorCode = "verification_document_failed_copy"
+	AccountFutureRequirementsErrorCodeVerificationDocumentFailedGreyscale                    AccountFutureRequirementsErrorCode = "verification_document_failed_greyscale"
+	AccountFutureRequirementsErrorCodeVerificationDocumentFailedOther                        AccountFutureRequirementsErrorCode = "verification_document_failed_other"
+	AccountFutureRequirementsErrorCodeVerificationDocumentFailedTestMode                     AccountFutureRequirementsErrorCode = "verification_document_failed_test_mode"
+	AccountFutureRequirementsErrorCodeVerificationDocumentFraudulent                         AccountFutureRequirementsErrorCode = "verification_document_fraudulent"
+	AccountFutureRequirementsErrorCodeVerificationDocumentIDNumberMismatch                   AccountFutureRequirementsErrorCode = "verification_document_id_number_mismatch"
+	AccountFutureRequirementsErrorCodeVerificationDocumentIDNumberMissing                    AccountFutureRequirementsErrorCode = "verification_document_id_number_missing"
+	AccountFutureRequirementsErrorCodeVerificationDocumentIncomplete                         AccountFutureRequirementsErrorCode = "verification_document_incomplete"
+	AccountFutureRequirementsErrorCodeVerificationDocumentInvalid                            AccountFutureRequirementsErrorCode = "verification_document_invalid"
+	AccountFutureRequirementsErrorCodeVerificationDocumentIssueOrExpiryDateMissing           AccountFutureRequirementsErrorCode = "verification_document_issue_or_expiry_date_missing"
+	AccountFutureRequirementsErrorCodeVerificationDocumentManipulated                        AccountFutureRequirementsErrorCode = "verification_document_manipulated"
+	AccountFutureRequirementsErrorCodeVerificationDocumentMissingBack                        AccountFutureRequirementsErrorCode = "verification_document_missing_back"
+	AccountFutureRequirementsErrorCodeVerificationDocumentMissingFront                       AccountFutureRequirementsErrorCode = "verification_document_missing_front"
+	AccountFutureRequirementsErrorCodeVerificationDocumentNameMismatch                       AccountFutureRequirementsErrorCode = "verification_document_name_mismatch"
+	AccountFutureRequirementsErrorCodeVerificationDocumentNameMissing                        AccountFutureRequirementsErrorCode = "verification_document_name_missing"
+	AccountFutureRequirementsErrorCodeVerificationDocumentNationalityMismatch                AccountFutureRequirementsErrorCode = "verification_document_nationality_mismatch"
+	AccountFutureRequirementsErrorCodeVerificationDocumentNotReadable                        AccountFutureRequirementsErrorCode = "verification_document_not_readable"
+	AccountFutureRequirementsErrorCodeVerificationDocumentNotSigned                          AccountFutureRequirementsErrorCode = "verification_document_not_signed"
+	AccountFutureRequirementsErrorCodeVerificationDocumentNotUploaded                        AccountFutureRequirementsErrorCode = "verification_document_not_uploaded"
+	AccountFutureRequirementsErrorCodeVerificationDocumentPhotoMismatch                      AccountFutureRequirementsErrorCode = "verification_document_photo_mismatch"
+	AccountFutureRequirementsErrorCodeVerificationDocumentTooLarge                           AccountFutureRequirementsErrorCode = "verification_document_too_large"
+	AccountFutureRequirementsErrorCodeVerificationDocumentTypeNotSupported                   AccountFutureRequirementsErrorCode = "verification_document_type_not_supported"
+	AccountFutureRequirementsErrorCodeVerificationFailedAddressMatch                         AccountFutureRequirementsErrorCode = "verification_failed_address_match"
+	AccountFutureRequirementsErrorCodeVerificationFailedBusinessIecNumber                    AccountFutureRequirementsErrorCode = "verification_failed_business_iec_number"
+	AccountFutureRequirementsErrorCodeVerificationFailedDocumentMatch                        AccountFutureRequirementsErrorCode = "verification_failed_document_match"
+	AccountFutureRequirementsErrorCodeVerificationFailedIDNumberMatch                        AccountFutureRequirementsErrorCode = "verification_failed_id_number_match"
+	AccountFutureRequirementsErrorCodeVerificationFailedKeyedIdentity                        AccountFutureRequirementsErrorCode = "verification_failed_keyed_identity"
+	AccountFutureRequirementsErrorCodeVerificationFailedKeyedMatch                           AccountFutureRequirementsErrorCode = "verification_failed_keyed_match"
+	AccountFutureRequirementsErrorCodeVerificationFailedNameMatch                            AccountFutureRequirementsErrorCode = "verification_failed_name_match"
+	AccountFutureRequirementsErrorCodeVerificationFailedOther                                AccountFutureRequirementsErrorCode = "verification_failed_other"
+	AccountFutureRequirementsErrorCodeVerificationFailedTaxIDMatch                           AccountFutureRequirementsErrorCode = "verification_failed_tax_id_match"
+	AccountFutureRequirementsErrorCodeVerificationFailedTaxIDNotIssued                       AccountFutureRequirementsErrorCode = "verification_failed_tax_id_not_issued"
+	AccountFutureRequirementsErrorCodeVerificationMissingExecutives                          AccountFutureRequirementsErrorCode = "verification_missing_executives"
+	AccountFutureRequirementsErrorCodeVerificationMissingOwners                              AccountFutureRequirementsErrorCode = "verification_missing_owners"
+	AccountFutureRequirementsErrorCodeVerificationRequiresAdditionalMemorandumOfAssociations AccountFutureRequirementsErrorCode = "verification_requires_additional_memorandum_of_associations"
+)
+
+// The code for the type of error.
+type AccountRequirementsErrorCode string
+
+// List of values that AccountRequirementsErrorCode can take
+const (
+	AccountRequirementsErrorCodeInvalidAddressCityStatePostalCode                      AccountRequirementsErrorCode = "invalid_address_city_state_postal_code"
+	AccountRequirementsErrorCodeInvalidStreetAddress                                   AccountRequirementsErrorCode = "invalid_street_address"
+	AccountRequirementsErrorCodeInvalidValueOther                                      AccountRequirementsErrorCode = "invalid_value_other"
+	AccountRequirementsErrorCodeVerificationDocumentAddressMismatch                    AccountRequirementsErrorCode = "verification_document_address_mismatch"
+	AccountRequirementsErrorCodeVerificationDocumentAddressMissing                     AccountRequirementsErrorCode = "verification_document_address_missing"
+	AccountRequirementsErrorCodeVerificationDocumentCorrupt                            AccountRequirementsErrorCode = "verification_document_corrupt"
+	AccountRequirementsErrorCodeVerificationDocumentCountryNotSupported                AccountRequirementsErrorCode = "verification_document_country_not_supported"
+	AccountRequirementsErrorCodeVerificationDocumentDobMismatch                        AccountRequirementsErrorCode = "verification_document_dob_mismatch"
+	AccountRequirementsErrorCodeVerificationDocumentDuplicateType                      AccountRequirementsErrorCode = "verification_document_duplicate_type"
+	AccountRequirementsErrorCodeVerificationDocumentExpired                            AccountRequirementsErrorCode = "verification_document_expired"
+	AccountRequirementsErrorCodeVerificationDocumentFailedCopy                         AccountRequirementsErrorCode = "verification_document_failed_copy"
+	AccountRequirementsErrorCodeVerificationDocumentFailedGreyscale                    AccountRequirementsErrorCode = "verification_document_failed_greyscale"
+	AccountRequirementsErrorCodeVerificationDocumentFailedOther                        AccountRequirementsErrorCode = "verification_document_failed_other"
+	AccountRequirementsErrorCodeVerificationDocumentFailedTestMode                     AccountRequirementsErrorCode = "verification_document_failed_test_mode"
+	AccountRequirementsErrorCodeVerificationDocumentFraudulent                         AccountRequirementsErrorCode = "verification_document_fraudulent"
+	AccountRequirementsErrorCodeVerificationDocumentIDNumberMismatch                   AccountRequirementsErrorCode = "verification_document_id_number_mismatch"
+	AccountRequirementsErrorCodeVerificationDocumentIDNumberMissing                    AccountRequirementsErrorCode = "verification_document_id_number_missing"
+	AccountRequirementsErrorCodeVerificationDocumentIncomplete                         AccountRequirementsErrorCode = "verification_document_incomplete"
+	AccountRequirementsErrorCodeVerificationDocumentInvalid                            AccountRequirementsErrorCode = "verification_document_invalid"
+	AccountRequirementsErrorCodeVerificationDocumentIssueOrExpiryDateMissing           AccountRequirementsErrorCode = "verification_document_issue_or_expiry_date_missing"
+	AccountRequirementsErrorCodeVerificationDocumentManipulated                        AccountRequirementsErrorCode = "verification_document_manipulated"
+	AccountRequirementsErrorCodeVerificationDocumentMissingBack                        AccountRequirementsErrorCode = "verification_document_missing_back"
+	AccountRequirementsErrorCodeVerificationDocumentMissingFront                       AccountRequirementsErrorCode = "verification_document_missing_front"
+	AccountRequirementsErrorCodeVerificationDocumentNameMismatch                       AccountRequirementsErrorCode = "verification_document_name_mismatch"
+	AccountRequirementsErrorCodeVerificationDocumentNameMissing                        AccountRequirementsErrorCode = "verification_document_name_missing"
+	AccountRequirementsErrorCodeVerificationDocumentNationalityMismatch                AccountRequirementsErrorCode = "verification_document_nationality_mismatch"
+	AccountRequirementsErrorCodeVerificationDocumentNotReadable                        AccountRequirementsErrorCode = "verification_document_not_readable"
+	AccountRequirementsErrorCodeVerificationDocumentNotSigned                          AccountRequirementsErrorCode = "verification_document_not_signed"
+	AccountRequirementsErrorCodeVerificationDocumentNotUploaded                        AccountRequirementsErrorCode = "verification_document_not_uploaded"
+	AccountRequirementsErrorCodeVerificationDocumentPhotoMismatch                      AccountRequirementsErrorCode = "verification_document_photo_mismatch"
+	AccountRequirementsErrorCodeVerificationDocumentTooLarge                           AccountRequirementsErrorCode = "verification_document_too_large"
+	AccountRequirementsErrorCodeVerificationDocumentTypeNotSupported                   AccountRequirementsErrorCode = "verification_document_type_not_supported"
+	AccountRequirementsErrorCodeVerificationFailedAddressMatch                         AccountRequirementsErrorCode = "verification_failed_address_match"
+	AccountRequirementsErrorCodeVerificationFailedBusinessIecNumber                    AccountRequirementsErrorCode = "verification_failed_business_iec_number"
+	AccountRequirementsErrorCodeVerificationFailedDocumentMatch                        AccountRequirementsErrorCode = "verification_failed_document_match"
+	AccountRequirementsErrorCodeVerificationFailedIDNumberMatch                        AccountRequirementsErrorCode = "verification_failed_id_number_match"
+	AccountRequirementsErrorCodeVerificationFailedKeyedIdentity                        AccountRequirementsErrorCode = "verification_failed_keyed_identity"
+	AccountRequirementsErrorCodeVerificationFailedKeyedMatch                           AccountRequirementsErrorCode = "verification_failed_keyed_match"
+	AccountRequirementsErrorCodeVerificationFailedNameMatch                            AccountRequirementsErrorCode = "verification_failed_name_match"
+	AccountRequirementsErrorCodeVerificationFailedOther                                AccountRequirementsErrorCode = "verification_failed_other"
+	AccountRequirementsErrorCodeVerificationFailedTaxIDMatch                           AccountRequirementsErrorCode = "verification_failed_tax_id_match"
+	AccountRequirementsErrorCodeVerificationFailedTaxIDNotIssued                       AccountRequirementsErrorCode = "verification_failed_tax_id_not_issued"
+	AccountRequirementsErrorCodeVerificationMissingExecutives                          AccountRequirementsErrorCode = "verification_missing_executives"
+	AccountRequirementsErrorCodeVerificationMissingOwners                              AccountRequirementsErrorCode = "verification_missing_owners"
+	AccountRequirementsErrorCodeVerificationRequiresAdditionalMemorandumOfAssociations AccountRequirementsErrorCode = "verification_requires_additional_memorandum_of_associations"
 )
 
 // How frequently funds will be paid out. One of `manual` (payouts only created via API call), `daily`, `weekly`, or `monthly`.
@@ -855,6 +962,19 @@ func (p *AccountExternalAccountParams) AppendTo(body *form.Values, keyParts []st
 	}
 }
 
+// ExternalAccountListParams are the parameters allowed when listing the
+// external accounts of an account, which may be either bank accounts or
+// cards.
+type ExternalAccountListParams struct {
+	ListParams `form:"*"`
+	// The identifier of the account whose external accounts are listed.
+	Account *string `form:"-"` // Included in URL
+	// Filter external accounts according to a particular object type, either
+	// `bank_account` or `card`. All external accounts are listed if it's
+	// unset.
+	Object *string `form:"object"`
+}
+
 // Business information about the account.
 type AccountBusinessProfile struct {
 	// [The merchant category code for the account](https://stripe.com/docs/connect/setting-mcc). MCCs are used to classify businesses based on the goods or services they provide.
@@ -1035,7 +1155,7 @@ type AccountFutureRequirementsAlternative struct {
 // Fields that are `currently_due` and need to be collected again because validation or verification failed.
 type AccountFutureRequirementsError struct {
 	// The code for the type of error.
-	Code string `json:"code"`
+	Code AccountFutureRequirementsErrorCode `json:"code"`
 	// An informative message that indicates the error type and provides additional details about the error.
 	Reason string `json:"reason"`
 	// The specific user onboarding requirement field (in the requirements hash) that needs to be resolved.
@@ -1071,7 +1191,7 @@ type AccountRequirementsAlternative struct {
 // Fields that are `currently_due` and need to be collected again because validation or verification failed.
 type AccountRequirementsError struct {
 	// The code for the type of error.
-	Code string `json:"code"`
+	Code AccountRequirementsErrorCode `json:"code"`
 	// An informative message that indicates the error type and provides additional details about the error.
 	Reason string `json:"reason"`
 	// The specific user onboarding requirement field (in the requirements hash) that needs to be resolved.
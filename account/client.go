@@ -39,8 +39,20 @@ func Get() (*stripe.Account, error) {
 
 // Get retrieves the authenticating account.
 func (c Client) Get() (*stripe.Account, error) {
+	return c.GetWithParams(nil)
+}
+
+// GetWithParams retrieves the authenticating account, using params for
+// options like a request context or expansions.
+func GetWithParams(params *stripe.AccountParams) (*stripe.Account, error) {
+	return getC().GetWithParams(params)
+}
+
+// GetWithParams retrieves the authenticating account, using params for
+// options like a request context or expansions.
+func (c Client) GetWithParams(params *stripe.AccountParams) (*stripe.Account, error) {
 	account := &stripe.Account{}
-	err := c.B.Call(http.MethodGet, "/v1/account", c.Key, nil, account)
+	err := c.B.Call(http.MethodGet, "/v1/account", c.Key, params, account)
 	return account, err
 }
 
@@ -136,5 +148,5 @@ func (i *Iter) AccountList() *stripe.AccountList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
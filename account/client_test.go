@@ -1,6 +1,7 @@
 package account
 
 import (
+	"context"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
@@ -20,6 +21,14 @@ func TestAccountGet(t *testing.T) {
 	assert.NotNil(t, account)
 }
 
+func TestAccountGetWithParams(t *testing.T) {
+	params := &stripe.AccountParams{}
+	params.Context = context.Background()
+	account, err := GetWithParams(params)
+	assert.Nil(t, err)
+	assert.NotNil(t, account)
+}
+
 func TestAccountGetByID(t *testing.T) {
 	account, err := GetByID("acct_123", nil)
 	assert.Nil(t, err)
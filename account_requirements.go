@@ -0,0 +1,72 @@
+package stripe
+
+//
+// Public types
+//
+
+// AccountPayoutBlockers describes what's keeping payouts to an account
+// disabled, as returned by Account.PayoutBlockers.
+type AccountPayoutBlockers struct {
+	// CurrentlyDue are the fields that need to be collected, like an
+	// external account for a new account.
+	CurrentlyDue []string
+
+	// DisabledReason is why the account is disabled, if it is. Payouts can be
+	// disabled while it's empty, for example until a first external account
+	// is added.
+	DisabledReason AccountRequirementsDisabledReason
+
+	// Errors are the fields that need to be collected again because their
+	// validation or verification failed.
+	Errors []*AccountRequirementsError
+
+	// PastDue are the fields that weren't collected by their deadline.
+	PastDue []string
+
+	// PendingVerification are the fields that are being verified. Payouts can
+	// resume once their verification succeeds, without any action.
+	PendingVerification []string
+}
+
+//
+// Public functions
+//
+
+// FieldsDue returns the fields in PastDue and CurrentlyDue, without
+// duplicates, past due fields first. They're the fields to collect for
+// payouts to resume.
+func (b *AccountPayoutBlockers) FieldsDue() []string {
+	seen := make(map[string]bool)
+	var fields []string
+	for _, list := range [][]string{b.PastDue, b.CurrentlyDue} {
+		for _, field := range list {
+			if !seen[field] {
+				seen[field] = true
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields
+}
+
+// PayoutBlockers returns what's keeping payouts to the account disabled,
+// based on its requirements, or nil if payouts are enabled.
+//
+// The fields of the blockers are all empty if the account's requirements
+// weren't returned, or if payouts were disabled for a reason that isn't tied
+// to requirements.
+func (a *Account) PayoutBlockers() *AccountPayoutBlockers {
+	if a.PayoutsEnabled {
+		return nil
+	}
+
+	blockers := &AccountPayoutBlockers{}
+	if r := a.Requirements; r != nil {
+		blockers.CurrentlyDue = r.CurrentlyDue
+		blockers.DisabledReason = r.DisabledReason
+		blockers.Errors = r.Errors
+		blockers.PastDue = r.PastDue
+		blockers.PendingVerification = r.PendingVerification
+	}
+	return blockers
+}
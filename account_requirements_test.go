@@ -0,0 +1,65 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestAccount_PayoutBlockers(t *testing.T) {
+	data := []byte(`{
+		"id": "acct_123",
+		"object": "account",
+		"payouts_enabled": false,
+		"future_requirements": {
+			"currently_due": ["company.tax_id"],
+			"errors": [{
+				"code": "verification_failed_tax_id_match",
+				"reason": "The tax ID doesn't match",
+				"requirement": "company.tax_id"
+			}]
+		},
+		"requirements": {
+			"currently_due": ["external_account", "individual.id_number"],
+			"disabled_reason": "requirements.past_due",
+			"errors": [{
+				"code": "verification_document_expired",
+				"reason": "The document is expired",
+				"requirement": "individual.verification.document"
+			}],
+			"eventually_due": ["individual.id_number"],
+			"past_due": ["individual.verification.document", "external_account"],
+			"pending_verification": ["individual.dob.day"]
+		}
+	}`)
+
+	var account Account
+	err := json.Unmarshal(data, &account)
+	assert.NoError(t, err)
+
+	assert.Equal(t, AccountFutureRequirementsErrorCodeVerificationFailedTaxIDMatch, account.FutureRequirements.Errors[0].Code)
+
+	blockers := account.PayoutBlockers()
+	assert.NotNil(t, blockers)
+	assert.Equal(t, AccountRequirementsDisabledReasonRequirementsPastDue, blockers.DisabledReason)
+	assert.Equal(t, 1, len(blockers.Errors))
+	assert.Equal(t, AccountRequirementsErrorCodeVerificationDocumentExpired, blockers.Errors[0].Code)
+	assert.Equal(t, []string{"individual.dob.day"}, blockers.PendingVerification)
+	assert.Equal(t, []string{
+		"individual.verification.document",
+		"external_account",
+		"individual.id_number",
+	}, blockers.FieldsDue())
+
+	account.PayoutsEnabled = true
+	assert.Nil(t, account.PayoutBlockers())
+}
+
+func TestAccount_PayoutBlockers_NoRequirements(t *testing.T) {
+	account := &Account{}
+	blockers := account.PayoutBlockers()
+	assert.NotNil(t, blockers)
+	assert.Equal(t, AccountRequirementsDisabledReason(""), blockers.DisabledReason)
+	assert.Nil(t, blockers.FieldsDue())
+}
@@ -142,7 +142,7 @@ func TestAccount_Unmarshal(t *testing.T) {
 	assert.Equal(t, 2, len(account.Requirements.CurrentlyDue))
 	assert.Equal(t, AccountRequirementsDisabledReasonFieldsNeeded, account.Requirements.DisabledReason)
 	assert.Equal(t, 1, len(account.Requirements.Errors))
-	assert.Equal(t, "invalid_value_other", account.Requirements.Errors[0].Code)
+	assert.Equal(t, AccountRequirementsErrorCodeInvalidValueOther, account.Requirements.Errors[0].Code)
 	assert.Equal(t, 1, len(account.Requirements.EventuallyDue))
 	assert.Equal(t, 0, len(account.Requirements.PastDue))
 
@@ -108,5 +108,5 @@ func (i *Iter) AppsSecretList() *stripe.AppsSecretList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
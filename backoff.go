@@ -0,0 +1,156 @@
+package stripe
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+//
+// Public types
+//
+
+// Backoff determines how long the backend waits before retrying a request
+// that failed. It can be set with BackendConfig.Backoff to align the
+// library's retries with an organization-wide retry policy.
+//
+// Implementations must be safe for concurrent use, since a backend may retry
+// several requests at once.
+type Backoff interface {
+	// NextDelay returns the time to wait before making the given retry of a
+	// request, starting at 1 for the first retry. err is the error of the
+	// previous attempt, and resp its response, which is nil if the request
+	// failed before a response was received.
+	NextDelay(attempt int, err error, resp *http.Response) time.Duration
+}
+
+// DecorrelatedJitterBackoff waits a random time between InitialInterval and an
+// upper bound that triples with every retry, capped at MaxInterval. It spreads
+// out retries from many clients more than ExponentialBackoff does.
+//
+// Unlike the classic decorrelated jitter algorithm, which derives each bound
+// from the previous delay, the bound is derived from the attempt number so
+// that a single DecorrelatedJitterBackoff can be shared by concurrent
+// requests.
+type DecorrelatedJitterBackoff struct {
+	// InitialInterval is the shortest time waited before a retry.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the time waited before a retry.
+	MaxInterval time.Duration
+}
+
+// NextDelay returns the time to wait before the given retry.
+func (b *DecorrelatedJitterBackoff) NextDelay(attempt int, err error, resp *http.Response) time.Duration {
+	upper := growInterval(b.InitialInterval, 3, attempt, b.MaxInterval)
+	if upper <= b.InitialInterval {
+		return upper
+	}
+	return b.InitialInterval + time.Duration(randInclusive(int64(upper-b.InitialInterval)))
+}
+
+// ExponentialBackoff doubles the time waited after every retry, from
+// InitialInterval up to MaxInterval, and shortens each wait by a random amount
+// of up to a quarter so that clients that failed at the same time don't retry
+// in lockstep.
+type ExponentialBackoff struct {
+	// InitialInterval is the time waited before the first retry.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the time waited before a retry.
+	MaxInterval time.Duration
+}
+
+// NextDelay returns the time to wait before the given retry.
+func (b *ExponentialBackoff) NextDelay(attempt int, err error, resp *http.Response) time.Duration {
+	delay := growInterval(b.InitialInterval, 2, attempt, b.MaxInterval)
+	return delay - time.Duration(randInclusive(int64(delay/4)))
+}
+
+// FixedBackoff always waits the same time before a retry.
+type FixedBackoff struct {
+	// Interval is the time waited before every retry.
+	Interval time.Duration
+}
+
+// NextDelay returns the time to wait before the given retry.
+func (b *FixedBackoff) NextDelay(attempt int, err error, resp *http.Response) time.Duration {
+	return b.Interval
+}
+
+//
+// Private types
+//
+
+// defaultBackoff is the backoff used by backends that aren't configured with
+// one. Its delay grows quadratically from minNetworkRetriesDelay, with
+// jitter, up to maxNetworkRetriesDelay.
+type defaultBackoff struct{}
+
+func (defaultBackoff) NextDelay(attempt int, err error, resp *http.Response) time.Duration {
+	numRetries := attempt - 1
+	if numRetries < 0 {
+		numRetries = 0
+	}
+
+	// Apply exponential backoff with minNetworkRetriesDelay on the
+	// number of num_retries so far as inputs, without allowing the number to
+	// exceed maxNetworkRetriesDelay. The number of retries is checked first
+	// so that squaring it can't overflow.
+	delay := maxNetworkRetriesDelay
+	if numRetries <= int(maxNetworkRetriesDelay/minNetworkRetriesDelay) {
+		delay = minNetworkRetriesDelay + minNetworkRetriesDelay*time.Duration(numRetries*numRetries)
+		if delay > maxNetworkRetriesDelay {
+			delay = maxNetworkRetriesDelay
+		}
+	}
+
+	// Apply some jitter by randomizing the value in the range of 75%-100%.
+	jitter := randInclusive(int64(delay/4) - 1)
+	delay -= time.Duration(jitter)
+
+	// But never sleep less than the base sleep seconds.
+	if delay < minNetworkRetriesDelay {
+		delay = minNetworkRetriesDelay
+	}
+
+	return delay
+}
+
+//
+// Private functions
+//
+
+// growInterval returns initial multiplied by factor for every attempt after
+// the first, capped at max if it's set. Without max, it's capped at the
+// longest time.Duration, since large attempt numbers overflow it.
+func growInterval(initial time.Duration, factor float64, attempt int, max time.Duration) time.Duration {
+	if initial <= 0 {
+		return 0
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := float64(initial) * math.Pow(factor, float64(attempt-1))
+	if max > 0 && delay > float64(max) {
+		return max
+	}
+	// float64(math.MaxInt64) rounds up to 2^63, which doesn't fit either
+	if delay >= float64(math.MaxInt64) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// randInclusive returns a random number between 0 and n, inclusive, or 0 if n
+// isn't positive.
+func randInclusive(n int64) int64 {
+	if n <= 0 {
+		return 0
+	}
+	if n == math.MaxInt64 {
+		return rand.Int63()
+	}
+	return rand.Int63n(n + 1)
+}
@@ -0,0 +1,126 @@
+package stripe
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestDecorrelatedJitterBackoff(t *testing.T) {
+	b := &DecorrelatedJitterBackoff{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second}
+
+	for i := 0; i < 20; i++ {
+		delay := b.NextDelay(1, nil, nil)
+		assert.Equal(t, 100*time.Millisecond, delay)
+
+		delay = b.NextDelay(2, nil, nil)
+		assert.True(t, delay >= 100*time.Millisecond && delay <= 300*time.Millisecond, delay)
+
+		delay = b.NextDelay(10, nil, nil)
+		assert.True(t, delay >= 100*time.Millisecond && delay <= time.Second, delay)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := &ExponentialBackoff{InitialInterval: 100 * time.Millisecond, MaxInterval: time.Second}
+
+	for i := 0; i < 20; i++ {
+		delay := b.NextDelay(1, nil, nil)
+		assert.True(t, delay >= 75*time.Millisecond && delay <= 100*time.Millisecond, delay)
+
+		delay = b.NextDelay(3, nil, nil)
+		assert.True(t, delay >= 300*time.Millisecond && delay <= 400*time.Millisecond, delay)
+
+		delay = b.NextDelay(10, nil, nil)
+		assert.True(t, delay >= 750*time.Millisecond && delay <= time.Second, delay)
+	}
+}
+
+func TestFixedBackoff(t *testing.T) {
+	b := &FixedBackoff{Interval: time.Second}
+	assert.Equal(t, time.Second, b.NextDelay(1, nil, nil))
+	assert.Equal(t, time.Second, b.NextDelay(5, nil, nil))
+}
+
+func TestDefaultBackoff(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		delay := defaultBackoff{}.NextDelay(1, nil, nil)
+		assert.Equal(t, minNetworkRetriesDelay, delay)
+
+		delay = defaultBackoff{}.NextDelay(10, nil, nil)
+		assert.True(t, delay >= maxNetworkRetriesDelay*3/4 && delay <= maxNetworkRetriesDelay, delay)
+	}
+}
+
+func TestBackoff_LargeAttempt(t *testing.T) {
+	const attempt = 1 << 30
+
+	// Without MaxInterval, the delay is capped instead of overflowing
+	delay := (&DecorrelatedJitterBackoff{InitialInterval: time.Second}).NextDelay(attempt, nil, nil)
+	assert.True(t, delay >= time.Second, delay)
+
+	delay = (&ExponentialBackoff{InitialInterval: time.Second}).NextDelay(attempt, nil, nil)
+	assert.True(t, delay >= time.Duration(math.MaxInt64)/4*3, delay)
+
+	delay = (&ExponentialBackoff{InitialInterval: time.Second, MaxInterval: time.Minute}).NextDelay(attempt, nil, nil)
+	assert.True(t, delay >= 45*time.Second && delay <= time.Minute, delay)
+
+	delay = defaultBackoff{}.NextDelay(attempt, nil, nil)
+	assert.True(t, delay >= maxNetworkRetriesDelay*3/4 && delay <= maxNetworkRetriesDelay, delay)
+
+	// A zero interval doesn't grow
+	assert.Equal(t, time.Duration(0), (&ExponentialBackoff{}).NextDelay(attempt, nil, nil))
+	assert.Equal(t, time.Duration(0), (&DecorrelatedJitterBackoff{}).NextDelay(attempt, nil, nil))
+}
+
+type recordingBackoff struct {
+	attempts    []int
+	statusCodes []int
+}
+
+func (b *recordingBackoff) NextDelay(attempt int, err error, resp *http.Response) time.Duration {
+	b.attempts = append(b.attempts, attempt)
+	b.statusCodes = append(b.statusCodes, resp.StatusCode)
+	return time.Millisecond
+}
+
+func TestDo_Backoff(t *testing.T) {
+	requestNum := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestNum++
+		if requestNum < 3 {
+			w.WriteHeader(http.StatusConflict)
+			_, err := w.Write([]byte(`{"error":{"message":"conflict"}}`))
+			assert.NoError(t, err)
+			return
+		}
+		_, err := w.Write([]byte(`{}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	backoff := &recordingBackoff{}
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			Backoff:           backoff,
+			LeveledLogger:     debugLeveledLogger,
+			MaxNetworkRetries: Int64(2),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+
+	request, err := backend.NewRequest(http.MethodGet, "/v1/charges", "sk_test_123", "application/x-www-form-urlencoded", nil)
+	assert.NoError(t, err)
+
+	var response APIResource
+	assert.NoError(t, backend.Do(request, nil, &response))
+
+	assert.Equal(t, 3, requestNum)
+	assert.Equal(t, []int{1, 2}, backoff.attempts)
+	assert.Equal(t, []int{http.StatusConflict, http.StatusConflict}, backoff.statusCodes)
+}
@@ -42,5 +42,5 @@ func (i *Iter) BalanceTransaction() *stripe.BalanceTransaction {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -19,6 +19,7 @@ const (
 	BalanceTransactionReportingCategoryChargeFailure               BalanceTransactionReportingCategory = "charge_failure"
 	BalanceTransactionReportingCategoryConnectCollectionTransfer   BalanceTransactionReportingCategory = "connect_collection_transfer"
 	BalanceTransactionReportingCategoryConnectReservedFunds        BalanceTransactionReportingCategory = "connect_reserved_funds"
+	BalanceTransactionReportingCategoryContribution                BalanceTransactionReportingCategory = "contribution"
 	BalanceTransactionReportingCategoryDispute                     BalanceTransactionReportingCategory = "dispute"
 	BalanceTransactionReportingCategoryDisputeReversal             BalanceTransactionReportingCategory = "dispute_reversal"
 	BalanceTransactionReportingCategoryFee                         BalanceTransactionReportingCategory = "fee"
@@ -28,6 +29,8 @@ const (
 	BalanceTransactionReportingCategoryOtherAdjustment             BalanceTransactionReportingCategory = "other_adjustment"
 	BalanceTransactionReportingCategoryPartialCaptureReversal      BalanceTransactionReportingCategory = "partial_capture_reversal"
 	BalanceTransactionReportingCategoryPayout                      BalanceTransactionReportingCategory = "payout"
+	BalanceTransactionReportingCategoryPayoutMinimumBalanceHold    BalanceTransactionReportingCategory = "payout_minimum_balance_hold"
+	BalanceTransactionReportingCategoryPayoutMinimumBalanceRelease BalanceTransactionReportingCategory = "payout_minimum_balance_release"
 	BalanceTransactionReportingCategoryPayoutReversal              BalanceTransactionReportingCategory = "payout_reversal"
 	BalanceTransactionReportingCategoryPlatformEarning             BalanceTransactionReportingCategory = "platform_earning"
 	BalanceTransactionReportingCategoryPlatformEarningRefund       BalanceTransactionReportingCategory = "platform_earning_refund"
@@ -85,11 +88,16 @@ const (
 	BalanceTransactionTypeIssuingAuthorizationDispute     BalanceTransactionType = "issuing_dispute"
 	BalanceTransactionTypeIssuingAuthorizationTransaction BalanceTransactionType = "issuing_transaction"
 	BalanceTransactionTypePayment                         BalanceTransactionType = "payment"
+	BalanceTransactionTypePaymentNetworkReserveHold       BalanceTransactionType = "payment_network_reserve_hold"
+	BalanceTransactionTypePaymentNetworkReserveRelease    BalanceTransactionType = "payment_network_reserve_release"
 	BalanceTransactionTypePaymentFailureRefund            BalanceTransactionType = "payment_failure_refund"
 	BalanceTransactionTypePaymentRefund                   BalanceTransactionType = "payment_refund"
+	BalanceTransactionTypePaymentReversal                 BalanceTransactionType = "payment_reversal"
 	BalanceTransactionTypePayout                          BalanceTransactionType = "payout"
 	BalanceTransactionTypePayoutCancel                    BalanceTransactionType = "payout_cancel"
 	BalanceTransactionTypePayoutFailure                   BalanceTransactionType = "payout_failure"
+	BalanceTransactionTypePayoutMinimumBalanceHold        BalanceTransactionType = "payout_minimum_balance_hold"
+	BalanceTransactionTypePayoutMinimumBalanceRelease     BalanceTransactionType = "payout_minimum_balance_release"
 	BalanceTransactionTypeRefund                          BalanceTransactionType = "refund"
 	BalanceTransactionTypeRefundFailure                   BalanceTransactionType = "refund_failure"
 	BalanceTransactionTypeReserveTransaction              BalanceTransactionType = "reserve_transaction"
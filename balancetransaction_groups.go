@@ -0,0 +1,107 @@
+package stripe
+
+// The groupings in this file classify balance transactions the way they're
+// usually summarized in a ledger: money moving in from customers (charge-like),
+// money taken by Stripe or a platform (fee-like), and money moving out to a
+// bank account (payout-like). Values not listed in any group, like transfers
+// or top-ups, report false from every helper.
+
+//
+// Public functions
+//
+
+// IsChargeLike returns true for reporting categories describing a payment from
+// a customer or its reversal, such as charges, refunds and disputes.
+func (c BalanceTransactionReportingCategory) IsChargeLike() bool {
+	_, ok := chargeLikeReportingCategories[c]
+	return ok
+}
+
+// IsFeeLike returns true for reporting categories describing fees collected by
+// Stripe or a platform, and their refunds.
+func (c BalanceTransactionReportingCategory) IsFeeLike() bool {
+	_, ok := feeLikeReportingCategories[c]
+	return ok
+}
+
+// IsPayoutLike returns true for reporting categories describing funds sent to
+// or returned from an external bank account.
+func (c BalanceTransactionReportingCategory) IsPayoutLike() bool {
+	_, ok := payoutLikeReportingCategories[c]
+	return ok
+}
+
+// IsChargeLike returns true for transaction types describing a payment from a
+// customer or its reversal, such as charges and refunds.
+func (t BalanceTransactionType) IsChargeLike() bool {
+	_, ok := chargeLikeTypes[t]
+	return ok
+}
+
+// IsFeeLike returns true for transaction types describing fees collected by
+// Stripe or a platform, and their refunds.
+func (t BalanceTransactionType) IsFeeLike() bool {
+	_, ok := feeLikeTypes[t]
+	return ok
+}
+
+// IsPayoutLike returns true for transaction types describing funds sent to or
+// returned from an external bank account.
+func (t BalanceTransactionType) IsPayoutLike() bool {
+	_, ok := payoutLikeTypes[t]
+	return ok
+}
+
+//
+// Private variables
+//
+
+var chargeLikeReportingCategories = map[BalanceTransactionReportingCategory]struct{}{
+	BalanceTransactionReportingCategoryCharge:                 {},
+	BalanceTransactionReportingCategoryChargeFailure:          {},
+	BalanceTransactionReportingCategoryDispute:                {},
+	BalanceTransactionReportingCategoryDisputeReversal:        {},
+	BalanceTransactionReportingCategoryPartialCaptureReversal: {},
+	BalanceTransactionReportingCategoryRefund:                 {},
+	BalanceTransactionReportingCategoryRefundFailure:          {},
+}
+
+var feeLikeReportingCategories = map[BalanceTransactionReportingCategory]struct{}{
+	BalanceTransactionReportingCategoryFee:                   {},
+	BalanceTransactionReportingCategoryPlatformEarning:       {},
+	BalanceTransactionReportingCategoryPlatformEarningRefund: {},
+	BalanceTransactionReportingCategoryTax:                   {},
+}
+
+var payoutLikeReportingCategories = map[BalanceTransactionReportingCategory]struct{}{
+	BalanceTransactionReportingCategoryPayout:                      {},
+	BalanceTransactionReportingCategoryPayoutMinimumBalanceHold:    {},
+	BalanceTransactionReportingCategoryPayoutMinimumBalanceRelease: {},
+	BalanceTransactionReportingCategoryPayoutReversal:              {},
+}
+
+var chargeLikeTypes = map[BalanceTransactionType]struct{}{
+	BalanceTransactionTypeCharge:               {},
+	BalanceTransactionTypePayment:              {},
+	BalanceTransactionTypePaymentFailureRefund: {},
+	BalanceTransactionTypePaymentRefund:        {},
+	BalanceTransactionTypePaymentReversal:      {},
+	BalanceTransactionTypeRefund:               {},
+	BalanceTransactionTypeRefundFailure:        {},
+}
+
+var feeLikeTypes = map[BalanceTransactionType]struct{}{
+	BalanceTransactionTypeApplicationFee:       {},
+	BalanceTransactionTypeApplicationFeeRefund: {},
+	BalanceTransactionTypeStripeFee:            {},
+	BalanceTransactionTypeStripeFxFee:          {},
+	BalanceTransactionTypeTaxFee:               {},
+}
+
+var payoutLikeTypes = map[BalanceTransactionType]struct{}{
+	BalanceTransactionTypePayout:                      {},
+	BalanceTransactionTypePayoutCancel:                {},
+	BalanceTransactionTypePayoutFailure:               {},
+	BalanceTransactionTypePayoutMinimumBalanceHold:    {},
+	BalanceTransactionTypePayoutMinimumBalanceRelease: {},
+}
@@ -0,0 +1,29 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestBalanceTransactionReportingCategory_Groups(t *testing.T) {
+	assert.True(t, BalanceTransactionReportingCategoryDispute.IsChargeLike())
+	assert.False(t, BalanceTransactionReportingCategoryDispute.IsFeeLike())
+
+	assert.True(t, BalanceTransactionReportingCategoryPlatformEarningRefund.IsFeeLike())
+	assert.True(t, BalanceTransactionReportingCategoryPayoutReversal.IsPayoutLike())
+
+	// Categories outside of any group
+	assert.False(t, BalanceTransactionReportingCategoryTransfer.IsChargeLike())
+	assert.False(t, BalanceTransactionReportingCategoryTransfer.IsFeeLike())
+	assert.False(t, BalanceTransactionReportingCategoryTransfer.IsPayoutLike())
+	assert.False(t, BalanceTransactionReportingCategory("unknown").IsChargeLike())
+}
+
+func TestBalanceTransactionType_Groups(t *testing.T) {
+	assert.True(t, BalanceTransactionTypePayment.IsChargeLike())
+	assert.True(t, BalanceTransactionTypeStripeFee.IsFeeLike())
+	assert.True(t, BalanceTransactionTypePayoutFailure.IsPayoutLike())
+	assert.False(t, BalanceTransactionTypePayout.IsChargeLike())
+	assert.False(t, BalanceTransactionTypeTopup.IsPayoutLike())
+}
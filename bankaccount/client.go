@@ -194,5 +194,5 @@ func (i *Iter) BankAccountList() *stripe.BankAccountList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
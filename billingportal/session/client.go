@@ -38,5 +38,5 @@ func (c Client) New(params *stripe.BillingPortalSessionParams) (*stripe.BillingP
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
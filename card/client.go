@@ -78,6 +78,9 @@ func (c Client) Get(id string, params *stripe.CardParams) (*stripe.Card, error)
 
 	card := &stripe.Card{}
 	err := c.B.Call(http.MethodGet, path, c.Key, params, card)
+	if err == nil && card.Deleted {
+		err = &stripe.DeletedObjectError{ID: card.ID, Object: "card"}
+	}
 	return card, err
 }
 
@@ -194,5 +197,5 @@ func (i *Iter) CardList() *stripe.CardList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
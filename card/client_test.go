@@ -1,10 +1,14 @@
 package card
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
 	_ "github.com/stripe/stripe-go/v72/testing"
 )
 
@@ -29,6 +33,21 @@ func TestCardGet(t *testing.T) {
 	assert.NotNil(t, card)
 }
 
+func TestCardGet_Deleted(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/customers/cus_123/sources/card_123", r.URL.Path)
+		w.Write([]byte(`{"id": "card_123", "object": "card", "deleted": true}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	card, err := c.Get("card_123", &stripe.CardParams{Customer: stripe.String("cus_123")})
+	assert.True(t, errors.Is(err, stripe.ErrDeletedObject))
+	assert.NotNil(t, card)
+	assert.True(t, card.Deleted)
+}
+
 func TestCardGet_RequiresParams(t *testing.T) {
 	_, err := Get("card_123", nil)
 	assert.Error(t, err, "params should not be nil")
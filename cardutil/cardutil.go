@@ -0,0 +1,188 @@
+// Package cardutil provides card number and expiry checks for tooling and for
+// generating test data.
+//
+// It isn't meant for handling real card numbers in production: collect card
+// details with Stripe.js or the mobile SDKs so that they never reach your
+// servers.
+package cardutil
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public variables
+//
+
+// This block represents the list of errors that could be returned by the
+// validation functions in this package.
+var (
+	ErrExpired           = errors.New("card has expired")
+	ErrInvalidCharacters = errors.New("card number contains invalid characters")
+	ErrInvalidChecksum   = errors.New("card number failed the Luhn check")
+	ErrInvalidExpiry     = errors.New("card expiry month or year is invalid")
+	ErrInvalidLength     = errors.New("card number has an invalid length for its brand")
+)
+
+//
+// Public functions
+//
+
+// Brand detects the brand of a card number from its issuer identification
+// number (IIN) prefix. CardBrandUnknown is returned when no known range
+// matches.
+func Brand(number string) stripe.CardBrand {
+	number = normalize(number)
+	for _, r := range brandRanges {
+		if len(number) < len(r.low) {
+			continue
+		}
+		prefix := number[:len(r.low)]
+		if prefix >= r.low && prefix <= r.high {
+			return r.brand
+		}
+	}
+	return stripe.CardBrandUnknown
+}
+
+// CheckDigit returns the digit which, appended to the given partial number,
+// makes it pass the Luhn check. It's useful for generating test card numbers.
+func CheckDigit(partial string) (int, error) {
+	partial = normalize(partial)
+	if !isDigits(partial) {
+		return 0, ErrInvalidCharacters
+	}
+	return (10 - luhnSum(partial+"0")%10) % 10, nil
+}
+
+// Luhn reports whether a card number passes the Luhn checksum. Spaces and
+// dashes are ignored.
+func Luhn(number string) bool {
+	number = normalize(number)
+	return isDigits(number) && luhnSum(number)%10 == 0
+}
+
+// ValidateExpiry checks that a card expiring at the end of the given month
+// hasn't expired at the time now. Two-digit years are interpreted as being in
+// the 2000s.
+func ValidateExpiry(month, year int, now time.Time) error {
+	if month < 1 || month > 12 || year < 0 {
+		return ErrInvalidExpiry
+	}
+	if year < 100 {
+		year += 2000
+	}
+
+	// Cards are valid until the last moment of their expiry month
+	expiresAt := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, now.Location())
+	if !now.Before(expiresAt) {
+		return ErrExpired
+	}
+	return nil
+}
+
+// ValidateNumber checks that a card number contains only digits, has a length
+// allowed for its brand and passes the Luhn check. Spaces and dashes are
+// ignored.
+func ValidateNumber(number string) error {
+	number = normalize(number)
+	if !isDigits(number) {
+		return ErrInvalidCharacters
+	}
+
+	if !containsInt(brandLengths[Brand(number)], len(number)) {
+		return ErrInvalidLength
+	}
+
+	if luhnSum(number)%10 != 0 {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+//
+// Private types
+//
+
+// brandRange is an inclusive range of IIN prefixes of the same length.
+type brandRange struct {
+	brand stripe.CardBrand
+	low   string
+	high  string
+}
+
+//
+// Private variables
+//
+
+// brandRanges lists the IIN ranges of each brand. Ranges don't overlap, so
+// their order doesn't matter.
+var brandRanges = []brandRange{
+	{stripe.CardBrandAmex, "34", "34"},
+	{stripe.CardBrandAmex, "37", "37"},
+	{stripe.CardBrandDinersClub, "300", "305"},
+	{stripe.CardBrandDinersClub, "36", "36"},
+	{stripe.CardBrandDinersClub, "38", "39"},
+	{stripe.CardBrandDiscover, "6011", "6011"},
+	{stripe.CardBrandDiscover, "644", "649"},
+	{stripe.CardBrandDiscover, "65", "65"},
+	{stripe.CardBrandJCB, "3528", "3589"},
+	{stripe.CardBrandMasterCard, "2221", "2720"},
+	{stripe.CardBrandMasterCard, "51", "55"},
+	{stripe.CardBrandUnionPay, "62", "62"},
+	{stripe.CardBrandUnionPay, "81", "81"},
+	{stripe.CardBrandVisa, "4", "4"},
+}
+
+var brandLengths = map[stripe.CardBrand][]int{
+	stripe.CardBrandAmex:       {15},
+	stripe.CardBrandDinersClub: {14, 16, 17, 18, 19},
+	stripe.CardBrandDiscover:   {16, 17, 18, 19},
+	stripe.CardBrandJCB:        {16, 17, 18, 19},
+	stripe.CardBrandMasterCard: {16},
+	stripe.CardBrandUnionPay:   {16, 17, 18, 19},
+	stripe.CardBrandUnknown:    {12, 13, 14, 15, 16, 17, 18, 19},
+	stripe.CardBrandVisa:       {13, 16, 19},
+}
+
+var separators = strings.NewReplacer(" ", "", "-", "")
+
+//
+// Private functions
+//
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+func luhnSum(number string) int {
+	sum := 0
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if (len(number)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum
+}
+
+func normalize(number string) string {
+	return separators.Replace(number)
+}
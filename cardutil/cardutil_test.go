@@ -0,0 +1,64 @@
+package cardutil
+
+import (
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+func TestBrand(t *testing.T) {
+	testCases := map[string]stripe.CardBrand{
+		"4242424242424242": stripe.CardBrandVisa,
+		"5555555555554444": stripe.CardBrandMasterCard,
+		"2223003122003222": stripe.CardBrandMasterCard,
+		"378282246310005":  stripe.CardBrandAmex,
+		"6011111111111117": stripe.CardBrandDiscover,
+		"3056930009020004": stripe.CardBrandDinersClub,
+		"36227206271667":   stripe.CardBrandDinersClub,
+		"3566002020360505": stripe.CardBrandJCB,
+		"6200000000000005": stripe.CardBrandUnionPay,
+		"9999999999999995": stripe.CardBrandUnknown,
+		"":                 stripe.CardBrandUnknown,
+	}
+	for number, brand := range testCases {
+		assert.Equal(t, brand, Brand(number), number)
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	d, err := CheckDigit("424242424242424")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, d)
+
+	_, err = CheckDigit("4242x")
+	assert.Equal(t, ErrInvalidCharacters, err)
+}
+
+func TestLuhn(t *testing.T) {
+	assert.True(t, Luhn("4242 4242 4242 4242"))
+	assert.True(t, Luhn("3782-822463-10005"))
+	assert.False(t, Luhn("4242424242424241"))
+	assert.False(t, Luhn("4242abcd"))
+	assert.False(t, Luhn(""))
+}
+
+func TestValidateExpiry(t *testing.T) {
+	now := time.Date(2022, time.March, 31, 23, 59, 0, 0, time.UTC)
+
+	assert.NoError(t, ValidateExpiry(3, 2022, now))
+	assert.NoError(t, ValidateExpiry(1, 23, now))
+	assert.Equal(t, ErrExpired, ValidateExpiry(2, 2022, now))
+	assert.Equal(t, ErrExpired, ValidateExpiry(3, 2022, now.Add(time.Minute)))
+	assert.Equal(t, ErrInvalidExpiry, ValidateExpiry(13, 2022, now))
+	assert.Equal(t, ErrInvalidExpiry, ValidateExpiry(0, 2022, now))
+}
+
+func TestValidateNumber(t *testing.T) {
+	assert.NoError(t, ValidateNumber("4242 4242 4242 4242"))
+	assert.NoError(t, ValidateNumber("378282246310005"))
+	assert.Equal(t, ErrInvalidCharacters, ValidateNumber("4242-4242-4242-424x"))
+	assert.Equal(t, ErrInvalidLength, ValidateNumber("37828224631000"))
+	assert.Equal(t, ErrInvalidChecksum, ValidateNumber("4242424242424241"))
+}
@@ -63,5 +63,5 @@ func (c Client) Update(params *stripe.CashBalanceParams) (*stripe.CashBalance, e
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
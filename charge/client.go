@@ -150,5 +150,5 @@ func (i *SearchIter) ChargeSearchResult() *stripe.ChargeSearchResult {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
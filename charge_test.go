@@ -68,3 +68,66 @@ func TestChargeParams_AppendTo(t *testing.T) {
 		assert.Equal(t, []string{"card"}, body.Get("source[object]"))
 	}
 }
+
+func TestCaptureParams_AppendTo(t *testing.T) {
+	params := &CaptureParams{
+		Amount:                    Int64(500),
+		ApplicationFeeAmount:      Int64(50),
+		StatementDescriptorSuffix: String("ORDER 123"),
+		TransferData: &ChargeTransferDataParams{
+			Amount: Int64(400),
+		},
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"500"}, body.Get("amount"))
+	assert.Equal(t, []string{"50"}, body.Get("application_fee_amount"))
+	assert.Equal(t, []string{"ORDER 123"}, body.Get("statement_descriptor_suffix"))
+	assert.Equal(t, []string{"400"}, body.Get("transfer_data[amount]"))
+}
+
+func TestChargePaymentMethodDetails_UnmarshalJSON_BNPL(t *testing.T) {
+	data := []byte(`{
+		"affirm": {"transaction_id": "AFF123"},
+		"afterpay_clearpay": {"reference": "order_123"},
+		"klarna": {"payment_method_category": "pay_in_installments", "preferred_locale": "en-GB"}
+	}`)
+
+	var v ChargePaymentMethodDetails
+	err := json.Unmarshal(data, &v)
+	assert.NoError(t, err)
+	assert.Equal(t, "AFF123", v.Affirm.TransactionID)
+	assert.Equal(t, "order_123", v.AfterpayClearpay.Reference)
+	assert.Equal(t, ChargePaymentMethodDetailsKlarnaPaymentMethodCategoryPayInInstallments, v.Klarna.PaymentMethodCategory)
+	assert.Equal(t, "en-GB", v.Klarna.PreferredLocale)
+}
+
+func TestChargePaymentMethodDetails_UnmarshalJSON_ThreeDSecure(t *testing.T) {
+	data := []byte(`{
+		"card": {
+			"network": "visa",
+			"three_d_secure": {
+				"authentication_flow": "challenge",
+				"electronic_commerce_indicator": "05",
+				"exemption_indicator": "none",
+				"exemption_indicator_applied": false,
+				"result": "authenticated",
+				"transaction_id": "ds_123",
+				"version": "2.2.0"
+			}
+		}
+	}`)
+
+	var v ChargePaymentMethodDetails
+	err := json.Unmarshal(data, &v)
+	assert.NoError(t, err)
+	assert.Equal(t, PaymentMethodCardNetworkVisa, v.Card.Network)
+
+	threeDSecure := v.Card.ThreeDSecure
+	assert.Equal(t, ChargePaymentMethodDetailsCardThreeDSecureAuthenticationFlowChallenge, threeDSecure.AuthenticationFlow)
+	assert.Equal(t, ChargePaymentMethodDetailsCardThreeDSecureElectronicCommerceIndicator05, threeDSecure.ElectronicCommerceIndicator)
+	assert.Equal(t, ChargePaymentMethodDetailsCardThreeDSecureExemptionIndicatorNone, threeDSecure.ExemptionIndicator)
+	assert.Equal(t, ChargePaymentMethodDetailsCardThreeDSecureResultAuthenticated, threeDSecure.Result)
+	assert.Equal(t, "ds_123", threeDSecure.TransactionID)
+}
@@ -130,5 +130,5 @@ func (c Client) ListLineItems(id string, listParams *stripe.CheckoutSessionListL
 type LineItemIter = lineitem.Iter
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,94 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+func TestCheckoutSessionPaymentMethodOptionsParams_AppendTo(t *testing.T) {
+	params := &CheckoutSessionParams{
+		PaymentMethodOptions: &CheckoutSessionPaymentMethodOptionsParams{
+			Affirm: &CheckoutSessionPaymentMethodOptionsAffirmParams{
+				SetupFutureUsage: String(string(CheckoutSessionPaymentMethodOptionsAffirmSetupFutureUsageNone)),
+			},
+			AfterpayClearpay: &CheckoutSessionPaymentMethodOptionsAfterpayClearpayParams{
+				SetupFutureUsage: String(string(CheckoutSessionPaymentMethodOptionsAfterpayClearpaySetupFutureUsageNone)),
+			},
+			Klarna: &CheckoutSessionPaymentMethodOptionsKlarnaParams{
+				SetupFutureUsage: String(string(CheckoutSessionPaymentMethodOptionsKlarnaSetupFutureUsageNone)),
+			},
+		},
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"none"}, body.Get("payment_method_options[affirm][setup_future_usage]"))
+	assert.Equal(t, []string{"none"}, body.Get("payment_method_options[afterpay_clearpay][setup_future_usage]"))
+	assert.Equal(t, []string{"none"}, body.Get("payment_method_options[klarna][setup_future_usage]"))
+}
+
+func TestCheckoutSessionSubscriptionDataParams_AppendTo(t *testing.T) {
+	params := &CheckoutSessionParams{
+		PaymentMethodCollection: String(string(CheckoutSessionPaymentMethodCollectionIfRequired)),
+		SubscriptionData: &CheckoutSessionSubscriptionDataParams{
+			TrialPeriodDays: Int64(30),
+			TrialSettings: &CheckoutSessionSubscriptionDataTrialSettingsParams{
+				EndBehavior: &CheckoutSessionSubscriptionDataTrialSettingsEndBehaviorParams{
+					MissingPaymentMethod: String(string(SubscriptionTrialSettingsEndBehaviorMissingPaymentMethodCancel)),
+				},
+			},
+		},
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"if_required"}, body.Get("payment_method_collection"))
+	assert.Equal(t, []string{"30"}, body.Get("subscription_data[trial_period_days]"))
+	assert.Equal(t, []string{"cancel"}, body.Get("subscription_data[trial_settings][end_behavior][missing_payment_method]"))
+}
+
+func TestCheckoutSessionParams_AppendTo_Connect(t *testing.T) {
+	{
+		params := &CheckoutSessionParams{
+			Mode: String(string(CheckoutSessionModePayment)),
+			PaymentIntentData: &CheckoutSessionPaymentIntentDataParams{
+				ApplicationFeeAmount: Int64(123),
+				OnBehalfOf:           String("acct_123"),
+				TransferData: &CheckoutSessionPaymentIntentDataTransferDataParams{
+					Amount:      Int64(877),
+					Destination: String("acct_123"),
+				},
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"123"}, body.Get("payment_intent_data[application_fee_amount]"))
+		assert.Equal(t, []string{"acct_123"}, body.Get("payment_intent_data[on_behalf_of]"))
+		assert.Equal(t, []string{"877"}, body.Get("payment_intent_data[transfer_data][amount]"))
+		assert.Equal(t, []string{"acct_123"}, body.Get("payment_intent_data[transfer_data][destination]"))
+	}
+
+	{
+		params := &CheckoutSessionParams{
+			Mode: String(string(CheckoutSessionModeSubscription)),
+			SubscriptionData: &CheckoutSessionSubscriptionDataParams{
+				ApplicationFeePercent: Float64(10),
+				OnBehalfOf:            String("acct_123"),
+				TransferData: &CheckoutSessionSubscriptionDataTransferDataParams{
+					AmountPercent: Float64(90),
+					Destination:   String("acct_123"),
+				},
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"10.0000"}, body.Get("subscription_data[application_fee_percent]"))
+		assert.Equal(t, []string{"acct_123"}, body.Get("subscription_data[on_behalf_of]"))
+		assert.Equal(t, []string{"90.0000"}, body.Get("subscription_data[transfer_data][amount_percent]"))
+		assert.Equal(t, []string{"acct_123"}, body.Get("subscription_data[transfer_data][destination]"))
+	}
+}
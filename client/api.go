@@ -28,10 +28,13 @@ import (
 	"github.com/stripe/stripe-go/v72/creditnote"
 	"github.com/stripe/stripe-go/v72/customer"
 	"github.com/stripe/stripe-go/v72/customerbalancetransaction"
+	"github.com/stripe/stripe-go/v72/customercashbalancetransaction"
 	"github.com/stripe/stripe-go/v72/discount"
 	"github.com/stripe/stripe-go/v72/dispute"
 	"github.com/stripe/stripe-go/v72/ephemeralkey"
 	"github.com/stripe/stripe-go/v72/event"
+	"github.com/stripe/stripe-go/v72/exchangerate"
+	"github.com/stripe/stripe-go/v72/externalaccount"
 	"github.com/stripe/stripe-go/v72/fee"
 	"github.com/stripe/stripe-go/v72/feerefund"
 	"github.com/stripe/stripe-go/v72/file"
@@ -46,6 +49,9 @@ import (
 	issuingcard "github.com/stripe/stripe-go/v72/issuing/card"
 	issuingcardholder "github.com/stripe/stripe-go/v72/issuing/cardholder"
 	issuingdispute "github.com/stripe/stripe-go/v72/issuing/dispute"
+	issuingpersonalizationdesign "github.com/stripe/stripe-go/v72/issuing/personalizationdesign"
+	issuingphysicalbundle "github.com/stripe/stripe-go/v72/issuing/physicalbundle"
+	issuingtoken "github.com/stripe/stripe-go/v72/issuing/token"
 	issuingtransaction "github.com/stripe/stripe-go/v72/issuing/transaction"
 	"github.com/stripe/stripe-go/v72/loginlink"
 	"github.com/stripe/stripe-go/v72/mandate"
@@ -55,6 +61,8 @@ import (
 	"github.com/stripe/stripe-go/v72/paymentintent"
 	"github.com/stripe/stripe-go/v72/paymentlink"
 	"github.com/stripe/stripe-go/v72/paymentmethod"
+	"github.com/stripe/stripe-go/v72/paymentmethodconfiguration"
+	"github.com/stripe/stripe-go/v72/paymentmethoddomain"
 	"github.com/stripe/stripe-go/v72/paymentsource"
 	"github.com/stripe/stripe-go/v72/payout"
 	"github.com/stripe/stripe-go/v72/person"
@@ -66,6 +74,7 @@ import (
 	radarearlyfraudwarning "github.com/stripe/stripe-go/v72/radar/earlyfraudwarning"
 	radarvaluelist "github.com/stripe/stripe-go/v72/radar/valuelist"
 	radarvaluelistitem "github.com/stripe/stripe-go/v72/radar/valuelistitem"
+	"github.com/stripe/stripe-go/v72/rawrequest"
 	"github.com/stripe/stripe-go/v72/refund"
 	reportingreportrun "github.com/stripe/stripe-go/v72/reporting/reportrun"
 	reportingreporttype "github.com/stripe/stripe-go/v72/reporting/reporttype"
@@ -81,6 +90,8 @@ import (
 	"github.com/stripe/stripe-go/v72/sub"
 	"github.com/stripe/stripe-go/v72/subitem"
 	"github.com/stripe/stripe-go/v72/subschedule"
+	taxcalculation "github.com/stripe/stripe-go/v72/tax/calculation"
+	taxtransaction "github.com/stripe/stripe-go/v72/tax/transaction"
 	"github.com/stripe/stripe-go/v72/taxcode"
 	"github.com/stripe/stripe-go/v72/taxid"
 	"github.com/stripe/stripe-go/v72/taxrate"
@@ -88,6 +99,8 @@ import (
 	terminalconnectiontoken "github.com/stripe/stripe-go/v72/terminal/connectiontoken"
 	terminallocation "github.com/stripe/stripe-go/v72/terminal/location"
 	terminalreader "github.com/stripe/stripe-go/v72/terminal/reader"
+	testhelpersissuingcard "github.com/stripe/stripe-go/v72/testhelpers/issuing/card"
+	testhelpersissuingpersonalizationdesign "github.com/stripe/stripe-go/v72/testhelpers/issuing/personalizationdesign"
 	testhelpersrefund "github.com/stripe/stripe-go/v72/testhelpers/refund"
 	testhelpersterminalreader "github.com/stripe/stripe-go/v72/testhelpers/terminal/reader"
 	testhelperstestclock "github.com/stripe/stripe-go/v72/testhelpers/testclock"
@@ -152,6 +165,8 @@ type API struct {
 	CreditNotes *creditnote.Client
 	// CustomerBalanceTransactions is the client used to invoke /customers/{customer}/balance_transactions APIs.
 	CustomerBalanceTransactions *customerbalancetransaction.Client
+	// CustomerCashBalanceTransactions is the client used to invoke /customers/{customer}/cash_balance_transactions APIs.
+	CustomerCashBalanceTransactions *customercashbalancetransaction.Client
 	// Customers is the client used to invoke /customers APIs.
 	Customers *customer.Client
 	// Discounts is the client used to invoke discount related APIs.
@@ -162,6 +177,10 @@ type API struct {
 	EphemeralKeys *ephemeralkey.Client
 	// Events is the client used to invoke /events APIs.
 	Events *event.Client
+	// ExchangeRates is the client used to invoke /exchange_rates APIs.
+	ExchangeRates *exchangerate.Client
+	// ExternalAccounts is the client used to invoke /accounts/{account}/external_accounts APIs.
+	ExternalAccounts *externalaccount.Client
 	// FeeRefunds is the client used to invoke /application_fees/{id}/refunds APIs.
 	FeeRefunds *feerefund.Client
 	// Fees is the client used to invoke /application_fees APIs.
@@ -190,6 +209,12 @@ type API struct {
 	IssuingCards *issuingcard.Client
 	// IssuingDisputes is the client used to invoke /issuing/disputes APIs.
 	IssuingDisputes *issuingdispute.Client
+	// IssuingPersonalizationDesigns is the client used to invoke /issuing/personalization_designs APIs.
+	IssuingPersonalizationDesigns *issuingpersonalizationdesign.Client
+	// IssuingPhysicalBundles is the client used to invoke /issuing/physical_bundles APIs.
+	IssuingPhysicalBundles *issuingphysicalbundle.Client
+	// IssuingTokens is the client used to invoke /issuing/tokens APIs.
+	IssuingTokens *issuingtoken.Client
 	// IssuingTransactions is the client used to invoke /issuing/transactions APIs.
 	IssuingTransactions *issuingtransaction.Client
 	// LoginLinks is the client used to invoke /accounts/{account}/login_links APIs.
@@ -208,6 +233,10 @@ type API struct {
 	PaymentLinks *paymentlink.Client
 	// PaymentMethods is the client used to invoke /payment_methods APIs.
 	PaymentMethods *paymentmethod.Client
+	// PaymentMethodConfigurations is the client used to invoke /payment_method_configurations APIs.
+	PaymentMethodConfigurations *paymentmethodconfiguration.Client
+	// PaymentMethodDomains is the client used to invoke /payment_method_domains APIs.
+	PaymentMethodDomains *paymentmethoddomain.Client
 	// PaymentSource is the client used to invoke /customers/{customer}/sources APIs.
 	PaymentSource *paymentsource.Client
 	// Payouts is the client used to invoke /payouts APIs.
@@ -224,6 +253,9 @@ type API struct {
 	PromotionCodes *promotioncode.Client
 	// Quotes is the client used to invoke /quotes APIs.
 	Quotes *quote.Client
+	// RawRequests is the client used to invoke API endpoints that the library
+	// doesn't have types for yet.
+	RawRequests *rawrequest.Client
 	// RadarEarlyFraudWarnings is the client used to invoke /radar/early_fraud_warnings APIs.
 	RadarEarlyFraudWarnings *radarearlyfraudwarning.Client
 	// RadarValueListItems is the client used to invoke /radar/value_list_items APIs.
@@ -260,12 +292,16 @@ type API struct {
 	Subscriptions *sub.Client
 	// SubscriptionSchedules is the client used to invoke /subscription_schedules APIs.
 	SubscriptionSchedules *subschedule.Client
+	// TaxCalculations is the client used to invoke /tax/calculations APIs.
+	TaxCalculations *taxcalculation.Client
 	// TaxCodes is the client used to invoke /tax_codes APIs.
 	TaxCodes *taxcode.Client
 	// TaxIDs is the client used to invoke /customers/{customer}/tax_ids APIs.
 	TaxIDs *taxid.Client
 	// TaxRates is the client used to invoke /tax_rates APIs.
 	TaxRates *taxrate.Client
+	// TaxTransactions is the client used to invoke /tax/transactions APIs.
+	TaxTransactions *taxtransaction.Client
 	// TerminalConfigurations is the client used to invoke /terminal/configurations APIs.
 	TerminalConfigurations *terminalconfiguration.Client
 	// TerminalConnectionTokens is the client used to invoke /terminal/connection_tokens APIs.
@@ -274,6 +310,10 @@ type API struct {
 	TerminalLocations *terminallocation.Client
 	// TerminalReaders is the client used to invoke /terminal/readers APIs.
 	TerminalReaders *terminalreader.Client
+	// TestHelpersIssuingCards is the client used to invoke /issuing/cards APIs.
+	TestHelpersIssuingCards *testhelpersissuingcard.Client
+	// TestHelpersIssuingPersonalizationDesigns is the client used to invoke /issuing/personalization_designs APIs.
+	TestHelpersIssuingPersonalizationDesigns *testhelpersissuingpersonalizationdesign.Client
 	// TestHelpersRefunds is the client used to invoke /refunds APIs.
 	TestHelpersRefunds *testhelpersrefund.Client
 	// TestHelpersTerminalReaders is the client used to invoke /terminal/readers APIs.
@@ -352,11 +392,14 @@ func (a *API) Init(key string, backends *stripe.Backends) {
 	a.Coupons = &coupon.Client{B: backends.API, Key: key}
 	a.CreditNotes = &creditnote.Client{B: backends.API, Key: key}
 	a.CustomerBalanceTransactions = &customerbalancetransaction.Client{B: backends.API, Key: key}
+	a.CustomerCashBalanceTransactions = &customercashbalancetransaction.Client{B: backends.API, Key: key}
 	a.Customers = &customer.Client{B: backends.API, Key: key}
 	a.Discounts = &discount.Client{B: backends.API, Key: key}
-	a.Disputes = &dispute.Client{B: backends.API, Key: key}
+	a.Disputes = &dispute.Client{B: backends.API, FilesBackend: backends.Uploads, Key: key}
 	a.EphemeralKeys = &ephemeralkey.Client{B: backends.API, Key: key}
 	a.Events = &event.Client{B: backends.API, Key: key}
+	a.ExchangeRates = &exchangerate.Client{B: backends.API, Key: key}
+	a.ExternalAccounts = &externalaccount.Client{B: backends.API, Key: key}
 	a.FeeRefunds = &feerefund.Client{B: backends.API, Key: key}
 	a.Fees = &fee.Client{B: backends.API, Key: key}
 	a.FileLinks = &filelink.Client{B: backends.API, Key: key}
@@ -371,6 +414,9 @@ func (a *API) Init(key string, backends *stripe.Backends) {
 	a.IssuingCardholders = &issuingcardholder.Client{B: backends.API, Key: key}
 	a.IssuingCards = &issuingcard.Client{B: backends.API, Key: key}
 	a.IssuingDisputes = &issuingdispute.Client{B: backends.API, Key: key}
+	a.IssuingPersonalizationDesigns = &issuingpersonalizationdesign.Client{B: backends.API, Key: key}
+	a.IssuingPhysicalBundles = &issuingphysicalbundle.Client{B: backends.API, Key: key}
+	a.IssuingTokens = &issuingtoken.Client{B: backends.API, Key: key}
 	a.IssuingTransactions = &issuingtransaction.Client{B: backends.API, Key: key}
 	a.LoginLinks = &loginlink.Client{B: backends.API, Key: key}
 	a.Mandates = &mandate.Client{B: backends.API, Key: key}
@@ -380,6 +426,8 @@ func (a *API) Init(key string, backends *stripe.Backends) {
 	a.PaymentIntents = &paymentintent.Client{B: backends.API, Key: key}
 	a.PaymentLinks = &paymentlink.Client{B: backends.API, Key: key}
 	a.PaymentMethods = &paymentmethod.Client{B: backends.API, Key: key}
+	a.PaymentMethodConfigurations = &paymentmethodconfiguration.Client{B: backends.API, Key: key}
+	a.PaymentMethodDomains = &paymentmethoddomain.Client{B: backends.API, Key: key}
 	a.PaymentSource = &paymentsource.Client{B: backends.API, Key: key}
 	a.Payouts = &payout.Client{B: backends.API, Key: key}
 	a.Persons = &person.Client{B: backends.API, Key: key}
@@ -388,11 +436,12 @@ func (a *API) Init(key string, backends *stripe.Backends) {
 	a.Products = &product.Client{B: backends.API, Key: key}
 	a.PromotionCodes = &promotioncode.Client{B: backends.API, Key: key}
 	a.Quotes = &quote.Client{B: backends.API, PDFBackend: backends.Uploads, Key: key}
+	a.RawRequests = &rawrequest.Client{B: backends.API, Key: key}
 	a.RadarEarlyFraudWarnings = &radarearlyfraudwarning.Client{B: backends.API, Key: key}
 	a.RadarValueListItems = &radarvaluelistitem.Client{B: backends.API, Key: key}
 	a.RadarValueLists = &radarvaluelist.Client{B: backends.API, Key: key}
 	a.Refunds = &refund.Client{B: backends.API, Key: key}
-	a.ReportRuns = &reportingreportrun.Client{B: backends.API, Key: key}
+	a.ReportRuns = &reportingreportrun.Client{B: backends.API, FilesBackend: backends.Uploads, Key: key}
 	a.ReportTypes = &reportingreporttype.Client{B: backends.API, Key: key}
 	a.Reversals = &reversal.Client{B: backends.API, Key: key}
 	a.Reviews = &review.Client{B: backends.API, Key: key}
@@ -406,13 +455,17 @@ func (a *API) Init(key string, backends *stripe.Backends) {
 	a.SubscriptionItems = &subitem.Client{B: backends.API, Key: key}
 	a.Subscriptions = &sub.Client{B: backends.API, Key: key}
 	a.SubscriptionSchedules = &subschedule.Client{B: backends.API, Key: key}
+	a.TaxCalculations = &taxcalculation.Client{B: backends.API, Key: key}
 	a.TaxCodes = &taxcode.Client{B: backends.API, Key: key}
 	a.TaxIDs = &taxid.Client{B: backends.API, Key: key}
 	a.TaxRates = &taxrate.Client{B: backends.API, Key: key}
+	a.TaxTransactions = &taxtransaction.Client{B: backends.API, Key: key}
 	a.TerminalConfigurations = &terminalconfiguration.Client{B: backends.API, Key: key}
 	a.TerminalConnectionTokens = &terminalconnectiontoken.Client{B: backends.API, Key: key}
 	a.TerminalLocations = &terminallocation.Client{B: backends.API, Key: key}
 	a.TerminalReaders = &terminalreader.Client{B: backends.API, Key: key}
+	a.TestHelpersIssuingCards = &testhelpersissuingcard.Client{B: backends.API, Key: key}
+	a.TestHelpersIssuingPersonalizationDesigns = &testhelpersissuingpersonalizationdesign.Client{B: backends.API, Key: key}
 	a.TestHelpersRefunds = &testhelpersrefund.Client{B: backends.API, Key: key}
 	a.TestHelpersTerminalReaders = &testhelpersterminalreader.Client{B: backends.API, Key: key}
 	a.TestHelpersTestClocks = &testhelperstestclock.Client{B: backends.API, Key: key}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"context"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+// Retrieve fetches the resource identified by the given ID, inferring its
+// type from the ID's prefix. See stripe.Retrieve for details.
+func (a *API) Retrieve(ctx context.Context, id string) (interface{}, error) {
+	return stripe.Retrieve(ctx, a.Events.B, a.Events.Key, id)
+}
@@ -0,0 +1,301 @@
+// Package connectfees calculates application fees and the resulting net
+// amounts for Connect charges, and reconciles them against balance
+// transactions.
+package connectfees
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public types
+//
+
+// ChargeType is the way funds flow between a platform and a connected account.
+type ChargeType string
+
+// List of values that ChargeType can take.
+const (
+	// ChargeTypeDestination is a charge created on the platform with
+	// `transfer_data[destination]`. The platform pays Stripe's processing fee
+	// and the connected account receives the amount less the application fee.
+	ChargeTypeDestination ChargeType = "destination"
+
+	// ChargeTypeDirect is a charge created on the connected account. The
+	// connected account pays Stripe's processing fee as well as the
+	// application fee.
+	ChargeTypeDirect ChargeType = "direct"
+)
+
+// Fee is a fee made of a percentage of the charged amount plus a fixed amount.
+type Fee struct {
+	// Percent is the percentage of the amount charged, e.g. 2.9 for 2.9%.
+	Percent float64
+
+	// Fixed is an amount added to the percentage, in the smallest currency
+	// unit, e.g. 30 for $0.30.
+	Fixed int64
+}
+
+// Params are the inputs to Calculate.
+type Params struct {
+	// Amount is the amount charged to the customer, in the smallest currency
+	// unit.
+	Amount int64
+
+	// ApplicationFee describes the fee collected by the platform.
+	ApplicationFee Fee
+
+	// ChargeType determines which account pays the processing fee. Defaults
+	// to ChargeTypeDestination.
+	ChargeType ChargeType
+
+	// Currency is the currency of the charge. It determines how calculated
+	// fees are rounded.
+	Currency stripe.Currency
+
+	// ProcessingFee describes the fee Stripe charges to process the payment.
+	ProcessingFee Fee
+}
+
+// Result is the outcome of Calculate. All amounts are in the smallest unit of
+// the charge's currency.
+type Result struct {
+	// Amount is the amount charged to the customer.
+	Amount int64
+
+	// ApplicationFeeAmount is the value to send as `application_fee_amount`.
+	ApplicationFeeAmount int64
+
+	// ChargeType is the charge type the result was calculated for.
+	ChargeType ChargeType
+
+	// Currency is the currency of the charge.
+	Currency stripe.Currency
+
+	// Net is the amount expected to reach the connected account.
+	Net int64
+
+	// PlatformNet is the amount expected to remain with the platform after
+	// Stripe's processing fee for destination charges. It may be negative if
+	// the application fee doesn't cover the processing fee.
+	PlatformNet int64
+
+	// ProcessingFeeAmount is the expected Stripe processing fee.
+	ProcessingFeeAmount int64
+}
+
+// Discrepancy describes a value in a balance transaction that differs from
+// the one expected by a Result.
+type Discrepancy struct {
+	// Field is the name of the balance transaction value that differs, like
+	// `net` or `fee_details[stripe_fee]`.
+	Field string
+
+	// Expected is the value calculated by Calculate.
+	Expected int64
+
+	// Actual is the value found on the balance transaction.
+	Actual int64
+}
+
+// ReconcileError is returned by Reconcile when a balance transaction doesn't
+// match the expected result.
+type ReconcileError struct {
+	// BalanceTransaction is the ID of the reconciled balance transaction.
+	BalanceTransaction string
+
+	// Discrepancies lists every value that differs.
+	Discrepancies []*Discrepancy
+}
+
+// Error serializes the error object to a string.
+func (e *ReconcileError) Error() string {
+	msg := fmt.Sprintf("balance transaction %s doesn't match:", e.BalanceTransaction)
+	for _, d := range e.Discrepancies {
+		msg += fmt.Sprintf(" %s is %d, expected %d;", d.Field, d.Actual, d.Expected)
+	}
+	return msg[:len(msg)-1]
+}
+
+//
+// Public functions
+//
+
+// Calculate computes the application fee and the expected net amounts of a
+// charge. Each fee is the percentage of the amount rounded half away from
+// zero, plus its fixed component, and never exceeds the amount charged.
+//
+// Amounts are always in the currency's smallest unit, so zero-decimal
+// currencies like JPY need no special handling. ISK and UGX are represented
+// with two decimals for backwards compatibility but must be whole units, so
+// their fees are rounded to a multiple of 100.
+func Calculate(params *Params) (*Result, error) {
+	if params == nil {
+		return nil, errors.New("params must be set")
+	}
+	if params.Amount < 0 {
+		return nil, errors.New("amount must not be negative")
+	}
+	if params.Currency == "" {
+		return nil, errors.New("currency must be set")
+	}
+
+	chargeType := params.ChargeType
+	if chargeType == "" {
+		chargeType = ChargeTypeDestination
+	}
+	if chargeType != ChargeTypeDestination && chargeType != ChargeTypeDirect {
+		return nil, fmt.Errorf("unknown charge type: %s", chargeType)
+	}
+
+	applicationFee := feeAmount(params.Amount, params.Currency, params.ApplicationFee)
+	processingFee := feeAmount(params.Amount, params.Currency, params.ProcessingFee)
+
+	result := &Result{
+		Amount:               params.Amount,
+		ApplicationFeeAmount: applicationFee,
+		ChargeType:           chargeType,
+		Currency:             params.Currency,
+		ProcessingFeeAmount:  processingFee,
+	}
+
+	switch chargeType {
+	case ChargeTypeDestination:
+		result.Net = params.Amount - applicationFee
+		result.PlatformNet = applicationFee - processingFee
+	case ChargeTypeDirect:
+		result.Net = params.Amount - applicationFee - processingFee
+		result.PlatformNet = applicationFee
+	}
+
+	return result, nil
+}
+
+// IsZeroDecimal reports whether amounts in the currency are expressed in whole
+// units rather than in a smaller unit like cents.
+func IsZeroDecimal(currency stripe.Currency) bool {
+	_, ok := zeroDecimalCurrencies[currency]
+	return ok
+}
+
+// Reconcile compares the expected result of a charge with the balance
+// transaction it produced and returns a *ReconcileError listing every value
+// that differs.
+//
+// For a direct charge, txn is the charge's balance transaction on the
+// connected account, whose `net` should match Result.Net. For a destination
+// charge, it's the balance transaction on the platform, whose `net` is the
+// amount charged less the processing fee. Transactions in a different
+// currency than the charge, for example after a conversion to the account's
+// settlement currency, can't be reconciled and return an error.
+func Reconcile(expected *Result, txn *stripe.BalanceTransaction) error {
+	if expected == nil || txn == nil {
+		return errors.New("expected result and balance transaction must be set")
+	}
+	if txn.Currency != expected.Currency {
+		return fmt.Errorf("balance transaction %s is in %s, expected %s",
+			txn.ID, txn.Currency, expected.Currency)
+	}
+
+	var applicationFee, stripeFee int64
+	for _, fee := range txn.FeeDetails {
+		switch fee.Type {
+		case feeTypeApplicationFee:
+			applicationFee += fee.Amount
+		case feeTypeStripeFee:
+			stripeFee += fee.Amount
+		}
+	}
+
+	expectedNet := expected.Net
+	expectedApplicationFee := expected.ApplicationFeeAmount
+	if expected.ChargeType == ChargeTypeDestination {
+		// The application fee is collected with a separate transfer on
+		// destination charges, so it isn't part of the charge's fees.
+		expectedNet = expected.Amount - expected.ProcessingFeeAmount
+		expectedApplicationFee = 0
+	}
+
+	var discrepancies []*Discrepancy
+	check := func(field string, expected, actual int64) {
+		if expected != actual {
+			discrepancies = append(discrepancies, &Discrepancy{
+				Field:    field,
+				Expected: expected,
+				Actual:   actual,
+			})
+		}
+	}
+	check("amount", expected.Amount, txn.Amount)
+	check("fee_details[application_fee]", expectedApplicationFee, applicationFee)
+	check("fee_details[stripe_fee]", expected.ProcessingFeeAmount, stripeFee)
+	check("net", expectedNet, txn.Net)
+
+	if len(discrepancies) > 0 {
+		return &ReconcileError{BalanceTransaction: txn.ID, Discrepancies: discrepancies}
+	}
+	return nil
+}
+
+//
+// Private constants
+//
+
+const (
+	feeTypeApplicationFee = "application_fee"
+	feeTypeStripeFee      = "stripe_fee"
+)
+
+//
+// Private variables
+//
+
+// wholeUnitCurrencies are represented with two decimals but only accept
+// amounts that are a multiple of 100.
+var wholeUnitCurrencies = map[stripe.Currency]struct{}{
+	stripe.CurrencyISK: {},
+	stripe.CurrencyUGX: {},
+}
+
+var zeroDecimalCurrencies = map[stripe.Currency]struct{}{
+	stripe.CurrencyBIF: {},
+	stripe.CurrencyCLP: {},
+	stripe.CurrencyDJF: {},
+	stripe.CurrencyGNF: {},
+	stripe.CurrencyJPY: {},
+	stripe.CurrencyKMF: {},
+	stripe.CurrencyKRW: {},
+	stripe.CurrencyMGA: {},
+	stripe.CurrencyPYG: {},
+	stripe.CurrencyRWF: {},
+	stripe.CurrencyVND: {},
+	stripe.CurrencyVUV: {},
+	stripe.CurrencyXAF: {},
+	stripe.CurrencyXOF: {},
+	stripe.CurrencyXPF: {},
+}
+
+//
+// Private functions
+//
+
+func feeAmount(amount int64, currency stripe.Currency, fee Fee) int64 {
+	unit := 1.0
+	if _, ok := wholeUnitCurrencies[currency]; ok {
+		unit = 100
+	}
+
+	v := int64(math.Round(float64(amount)*fee.Percent/100/unit)*unit) + fee.Fixed
+	if v < 0 {
+		return 0
+	}
+	if v > amount {
+		return amount
+	}
+	return v
+}
@@ -0,0 +1,150 @@
+package connectfees
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+var testProcessingFee = Fee{Percent: 2.9, Fixed: 30}
+
+func TestCalculate_Destination(t *testing.T) {
+	result, err := Calculate(&Params{
+		Amount:         10000,
+		ApplicationFee: Fee{Percent: 10, Fixed: 50},
+		Currency:       stripe.CurrencyUSD,
+		ProcessingFee:  testProcessingFee,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ChargeTypeDestination, result.ChargeType)
+	assert.Equal(t, int64(1050), result.ApplicationFeeAmount)
+	assert.Equal(t, int64(320), result.ProcessingFeeAmount)
+	assert.Equal(t, int64(8950), result.Net)
+	assert.Equal(t, int64(730), result.PlatformNet)
+}
+
+func TestCalculate_Direct(t *testing.T) {
+	result, err := Calculate(&Params{
+		Amount:         1999,
+		ApplicationFee: Fee{Percent: 5},
+		ChargeType:     ChargeTypeDirect,
+		Currency:       stripe.CurrencyEUR,
+		ProcessingFee:  testProcessingFee,
+	})
+	assert.NoError(t, err)
+	// 99.95 and 57.971 rounded
+	assert.Equal(t, int64(100), result.ApplicationFeeAmount)
+	assert.Equal(t, int64(88), result.ProcessingFeeAmount)
+	assert.Equal(t, int64(1811), result.Net)
+	assert.Equal(t, int64(100), result.PlatformNet)
+}
+
+func TestCalculate_Rounding(t *testing.T) {
+	// Zero-decimal currencies are already in whole units
+	result, err := Calculate(&Params{
+		Amount:         1234,
+		ApplicationFee: Fee{Percent: 3.6},
+		Currency:       stripe.CurrencyJPY,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(44), result.ApplicationFeeAmount)
+
+	// ISK fees are rounded to whole krónur
+	result, err = Calculate(&Params{
+		Amount:         123400,
+		ApplicationFee: Fee{Percent: 3.6},
+		Currency:       stripe.CurrencyISK,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4400), result.ApplicationFeeAmount)
+
+	// Fees never exceed the amount
+	result, err = Calculate(&Params{
+		Amount:         20,
+		ApplicationFee: Fee{Fixed: 50},
+		Currency:       stripe.CurrencyUSD,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), result.ApplicationFeeAmount)
+	assert.Equal(t, int64(0), result.Net)
+}
+
+func TestCalculate_Invalid(t *testing.T) {
+	_, err := Calculate(nil)
+	assert.Error(t, err)
+
+	_, err = Calculate(&Params{Amount: 100})
+	assert.EqualError(t, err, "currency must be set")
+
+	_, err = Calculate(&Params{Amount: 100, Currency: stripe.CurrencyUSD, ChargeType: "separate"})
+	assert.EqualError(t, err, "unknown charge type: separate")
+}
+
+func TestIsZeroDecimal(t *testing.T) {
+	assert.True(t, IsZeroDecimal(stripe.CurrencyJPY))
+	assert.False(t, IsZeroDecimal(stripe.CurrencyUSD))
+	assert.False(t, IsZeroDecimal(stripe.CurrencyISK))
+}
+
+func TestReconcile(t *testing.T) {
+	result, err := Calculate(&Params{
+		Amount:         10000,
+		ApplicationFee: Fee{Percent: 10},
+		ChargeType:     ChargeTypeDirect,
+		Currency:       stripe.CurrencyUSD,
+		ProcessingFee:  testProcessingFee,
+	})
+	assert.NoError(t, err)
+
+	txn := &stripe.BalanceTransaction{
+		ID:       "txn_123",
+		Amount:   10000,
+		Currency: stripe.CurrencyUSD,
+		Fee:      1320,
+		FeeDetails: []*stripe.BalanceTransactionFee{
+			{Amount: 1000, Type: "application_fee"},
+			{Amount: 320, Type: "stripe_fee"},
+		},
+		Net: 8680,
+	}
+	assert.NoError(t, Reconcile(result, txn))
+
+	txn.FeeDetails[1].Amount = 350
+	txn.Net = 8650
+	err = Reconcile(result, txn)
+	assert.Error(t, err)
+
+	reconcileErr, ok := err.(*ReconcileError)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(reconcileErr.Discrepancies))
+	assert.Equal(t, "fee_details[stripe_fee]", reconcileErr.Discrepancies[0].Field)
+	assert.Equal(t, int64(320), reconcileErr.Discrepancies[0].Expected)
+	assert.Equal(t, int64(350), reconcileErr.Discrepancies[0].Actual)
+	assert.Equal(t, "balance transaction txn_123 doesn't match: "+
+		"fee_details[stripe_fee] is 350, expected 320; net is 8650, expected 8680", err.Error())
+}
+
+func TestReconcile_Destination(t *testing.T) {
+	result, err := Calculate(&Params{
+		Amount:         10000,
+		ApplicationFee: Fee{Percent: 10},
+		Currency:       stripe.CurrencyUSD,
+		ProcessingFee:  testProcessingFee,
+	})
+	assert.NoError(t, err)
+
+	txn := &stripe.BalanceTransaction{
+		ID:       "txn_123",
+		Amount:   10000,
+		Currency: stripe.CurrencyUSD,
+		FeeDetails: []*stripe.BalanceTransactionFee{
+			{Amount: 320, Type: "stripe_fee"},
+		},
+		Net: 9680,
+	}
+	assert.NoError(t, Reconcile(result, txn))
+
+	txn.Currency = stripe.CurrencyEUR
+	assert.EqualError(t, Reconcile(result, txn), "balance transaction txn_123 is in eur, expected usd")
+}
@@ -0,0 +1,137 @@
+package stripe
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+//
+// Public types
+//
+
+// ContextIter iterates over the elements returned from paginated list API
+// calls like Iter, but reports errors directly from Next and takes a context
+// that's used for every page it fetches:
+//
+//	it := stripe.NewContextIter(customer.List(params).Iter)
+//	for {
+//		ok, err := it.Next(ctx)
+//		if err != nil {
+//			return err
+//		}
+//		if !ok {
+//			break
+//		}
+//		c := it.Current().(*stripe.Customer)
+//		// ...
+//	}
+//
+// Unlike with Iter, there's no separate error to remember to check once the
+// loop ends, and a canceled context stops iteration right away, even in the
+// middle of a page.
+//
+// Iterators are not thread-safe, so they should not be consumed across
+// multiple goroutines.
+type ContextIter struct {
+	err     error
+	iter    *Iter
+	started bool
+}
+
+// Current returns the most recent item visited by a call to Next.
+func (it *ContextIter) Current() interface{} {
+	return it.iter.cur
+}
+
+// List returns the current list object which the iterator is currently using.
+// List objects will change as new API calls are made to continue pagination.
+func (it *ContextIter) List() ListContainer {
+	return it.iter.list
+}
+
+// Meta returns the list metadata. It's nil until the first page has been
+// fetched.
+func (it *ContextIter) Meta() *ListMeta {
+	return it.iter.meta
+}
+
+// Progress returns information about the pages fetched so far.
+func (it *ContextIter) Progress() PageProgress {
+	return it.iter.progress
+}
+
+// Next advances the iterator to the next item in the list, which will then be
+// available through the Current method, fetching a new page with the given
+// context if needed. It returns false when the iterator stops at the end of
+// the list, or an error if a page couldn't be fetched or the context is done.
+// Once an error is returned, every subsequent call returns it too.
+func (it *ContextIter) Next(ctx context.Context) (bool, error) {
+	if it.err != nil {
+		return false, it.err
+	}
+	if err := ctx.Err(); err != nil {
+		it.err = err
+		return false, err
+	}
+
+	i := it.iter
+	if !it.started {
+		it.started = true
+		i.listParams.Context = ctx
+		i.getPage()
+	} else if len(i.values) == 0 && i.err == nil && i.meta.HasMore && !i.listParams.Single {
+		i.listParams.Context = ctx
+		i.getNextPage()
+	}
+
+	if len(i.values) == 0 {
+		it.err = i.err
+		return false, i.err
+	}
+	i.cur = i.values[0]
+	i.values = i.values[1:]
+	return true, nil
+}
+
+//
+// Public functions
+//
+
+// GetContextIter returns a new ContextIter for a given query and its options.
+// No request is made until the first call to Next.
+func GetContextIter(container ListParamsContainer, query Query) *ContextIter {
+	var listParams *ListParams
+	formValues := &form.Values{}
+
+	if container != nil {
+		reflectValue := reflect.ValueOf(container)
+
+		// See the comment on Call in stripe.go.
+		if reflectValue.Kind() == reflect.Ptr && !reflectValue.IsNil() {
+			listParams = container.GetListParams()
+			form.AppendTo(formValues, container)
+		}
+	}
+
+	if listParams == nil {
+		listParams = &ListParams{}
+	}
+	return &ContextIter{
+		iter: &Iter{
+			formValues: formValues,
+			listParams: *listParams,
+			query:      query,
+		},
+	}
+}
+
+// NewContextIter returns a ContextIter that continues iterating where the
+// given Iter, usually one returned by the List function of a resource
+// package, left off. Pages after the current one are fetched with the context
+// given to Next. If the Iter already failed to fetch its first page, the error
+// is returned by the first call to Next.
+func NewContextIter(it *Iter) *ContextIter {
+	return &ContextIter{iter: it, started: true}
+}
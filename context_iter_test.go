@@ -0,0 +1,129 @@
+package stripe
+
+import (
+	"context"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+func TestContextIterTwoPages(t *testing.T) {
+	tq := testQuery{
+		{[]interface{}{&item{"x"}}, &ListMeta{HasMore: true}, nil},
+		{[]interface{}{2}, &ListMeta{HasMore: false}, nil},
+	}
+	want := []interface{}{&item{"x"}, 2}
+	it := GetContextIter(nil, tq.query)
+	g, gerr := collectContext(context.Background(), it)
+	assert.Equal(t, 0, len(tq))
+	assert.Equal(t, want, g)
+	assert.NoError(t, gerr)
+}
+
+func TestContextIterLazy(t *testing.T) {
+	tq := testQuery{{nil, &ListMeta{}, nil}}
+	it := GetContextIter(nil, tq.query)
+	assert.Equal(t, 1, len(tq))
+	assert.Nil(t, it.Meta())
+
+	ok, err := it.Next(context.Background())
+	assert.False(t, ok)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(tq))
+}
+
+func TestContextIterPage2Err(t *testing.T) {
+	tq := testQuery{
+		{[]interface{}{&item{"x"}}, &ListMeta{HasMore: true}, nil},
+		{[]interface{}{2}, &ListMeta{}, errTest},
+	}
+	want := []interface{}{&item{"x"}, 2}
+	g, gerr := collectContext(context.Background(), GetContextIter(nil, tq.query))
+	assert.Equal(t, want, g)
+	assert.Equal(t, errTest, gerr)
+}
+
+func TestContextIterUsesContext(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "page")
+
+	var got []context.Context
+	metas := []*ListMeta{{HasMore: true}, {}}
+	query := func(p *Params, _ *form.Values) ([]interface{}, ListContainer, error) {
+		got = append(got, p.Context)
+		meta := metas[0]
+		metas = metas[1:]
+		return []interface{}{&item{"x"}}, meta, nil
+	}
+
+	_, err := collectContext(ctx, GetContextIter(nil, query))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(got))
+	for _, c := range got {
+		assert.Equal(t, "page", c.Value(ctxKey{}))
+	}
+}
+
+func TestContextIterCanceled(t *testing.T) {
+	tq := testQuery{
+		{[]interface{}{&item{"x"}, &item{"y"}}, &ListMeta{HasMore: true}, nil},
+	}
+	it := GetContextIter(nil, tq.query)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ok, err := it.Next(ctx)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	cancel()
+	ok, err = it.Next(ctx)
+	assert.False(t, ok)
+	assert.Equal(t, context.Canceled, err)
+
+	// The error sticks even if a fresh context is used
+	ok, err = it.Next(context.Background())
+	assert.False(t, ok)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestNewContextIter(t *testing.T) {
+	tq := testQuery{
+		{[]interface{}{&item{"x"}}, &ListMeta{HasMore: true}, nil},
+		{[]interface{}{2}, &ListMeta{}, nil},
+	}
+	it := NewContextIter(GetIter(nil, tq.query))
+	assert.Equal(t, 1, len(tq))
+
+	g, gerr := collectContext(context.Background(), it)
+	assert.Equal(t, 0, len(tq))
+	assert.Equal(t, []interface{}{&item{"x"}, 2}, g)
+	assert.NoError(t, gerr)
+}
+
+func TestNewContextIterFirstPageErr(t *testing.T) {
+	tq := testQuery{{nil, &ListMeta{}, errTest}}
+	it := NewContextIter(GetIter(nil, tq.query))
+
+	ok, err := it.Next(context.Background())
+	assert.False(t, ok)
+	assert.Equal(t, errTest, err)
+}
+
+//
+// ---
+//
+
+func collectContext(ctx context.Context, it *ContextIter) ([]interface{}, error) {
+	var g []interface{}
+	for {
+		ok, err := it.Next(ctx)
+		if err != nil {
+			return g, err
+		}
+		if !ok {
+			return g, nil
+		}
+		g = append(g, it.Current())
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
 )
 
 func TestCoupon_UnmarshalJSON(t *testing.T) {
@@ -27,3 +28,17 @@ func TestCoupon_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, "25OFF", v.ID)
 	}
 }
+
+func TestCouponParams_AppendTo(t *testing.T) {
+	params := &CouponParams{
+		AmountOff: Int64(1000),
+		Currency:  String(string(CurrencyUSD)),
+		CurrencyOptions: map[string]*CouponCurrencyOptionsParams{
+			"eur": {AmountOff: Int64(900)},
+		},
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"900"}, body.Get("currency_options[eur][amount_off]"))
+}
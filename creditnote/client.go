@@ -200,5 +200,5 @@ func (i *LineItemIter) CreditNoteLineItemList() *stripe.CreditNoteLineItemList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
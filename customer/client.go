@@ -42,6 +42,9 @@ func (c Client) Get(id string, params *stripe.CustomerParams) (*stripe.Customer,
 	path := stripe.FormatURLPath("/v1/customers/%s", id)
 	customer := &stripe.Customer{}
 	err := c.B.Call(http.MethodGet, path, c.Key, params, customer)
+	if err == nil && customer.Deleted {
+		err = &stripe.DeletedObjectError{ID: customer.ID, Object: "customer"}
+	}
 	return customer, err
 }
 
@@ -72,11 +75,17 @@ func (c Client) Del(id string, params *stripe.CustomerParams) (*stripe.Customer,
 }
 
 // CreateFundingInstructions is the method for the `POST /v1/customers/{customer}/funding_instructions` API.
+//
+// Deprecated: the response is decoded into a Customer, which leaves it
+// empty. Use NewFundingInstructions instead.
 func CreateFundingInstructions(id string, params *stripe.CustomerCreateFundingInstructionsParams) (*stripe.Customer, error) {
 	return getC().CreateFundingInstructions(id, params)
 }
 
 // CreateFundingInstructions is the method for the `POST /v1/customers/{customer}/funding_instructions` API.
+//
+// Deprecated: the response is decoded into a Customer, which leaves it
+// empty. Use NewFundingInstructions instead.
 func (c Client) CreateFundingInstructions(id string, params *stripe.CustomerCreateFundingInstructionsParams) (*stripe.Customer, error) {
 	path := stripe.FormatURLPath("/v1/customers/%s/funding_instructions", id)
 	customer := &stripe.Customer{}
@@ -84,6 +93,21 @@ func (c Client) CreateFundingInstructions(id string, params *stripe.CustomerCrea
 	return customer, err
 }
 
+// NewFundingInstructions retrieves or creates the funding instructions that a
+// customer uses to fund their cash balance with a bank transfer.
+func NewFundingInstructions(id string, params *stripe.CustomerCreateFundingInstructionsParams) (*stripe.FundingInstructions, error) {
+	return getC().NewFundingInstructions(id, params)
+}
+
+// NewFundingInstructions retrieves or creates the funding instructions that a
+// customer uses to fund their cash balance with a bank transfer.
+func (c Client) NewFundingInstructions(id string, params *stripe.CustomerCreateFundingInstructionsParams) (*stripe.FundingInstructions, error) {
+	path := stripe.FormatURLPath("/v1/customers/%s/funding_instructions", id)
+	fundinginstructions := &stripe.FundingInstructions{}
+	err := c.B.Call(http.MethodPost, path, c.Key, params, fundinginstructions)
+	return fundinginstructions, err
+}
+
 // RetrievePaymentMethod is the method for the `GET /v1/customers/{customer}/payment_methods/{payment_method}` API.
 func RetrievePaymentMethod(id string, params *stripe.CustomerRetrievePaymentMethodParams) (*stripe.Customer, error) {
 	return getC().RetrievePaymentMethod(id, params)
@@ -223,5 +247,5 @@ func (i *SearchIter) CustomerSearchResult() *stripe.CustomerSearchResult {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -1,10 +1,14 @@
 package customer
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
 	_ "github.com/stripe/stripe-go/v72/testing"
 )
 
@@ -20,6 +24,22 @@ func TestCustomerGet(t *testing.T) {
 	assert.NotNil(t, customer)
 }
 
+func TestCustomerGet_Deleted(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/customers/cus_123", r.URL.Path)
+		w.Write([]byte(`{"id": "cus_123", "object": "customer", "deleted": true}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	customer, err := c.Get("cus_123", nil)
+	assert.True(t, errors.Is(err, stripe.ErrDeletedObject))
+	assert.Equal(t, &stripe.DeletedObjectError{ID: "cus_123", Object: "customer"}, err)
+	assert.True(t, customer.Deleted)
+	assert.Equal(t, "cus_123", customer.ID)
+}
+
 func TestCustomerList(t *testing.T) {
 	i := List(&stripe.CustomerListParams{})
 
@@ -69,3 +89,40 @@ func TestCustomerListPaymentMethods(t *testing.T) {
 	assert.NotNil(t, i.PaymentMethod())
 	assert.NotNil(t, i.PaymentMethodList())
 }
+
+func TestCustomerNewFundingInstructions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/customers/cus_123/funding_instructions", r.URL.Path)
+		w.Write([]byte(`{
+			"object": "funding_instructions",
+			"bank_transfer": {
+				"country": "DE",
+				"financial_addresses": [
+					{
+						"iban": {"account_holder_name": "Stripe Technology Europe Limited", "bic": "SXPYDEHH", "country": "DE", "iban": "DE00000000000000000000"},
+						"supported_networks": ["sepa"],
+						"type": "iban"
+					}
+				],
+				"type": "eu_bank_transfer"
+			},
+			"currency": "eur",
+			"funding_type": "bank_transfer"
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	instructions, err := c.NewFundingInstructions("cus_123", &stripe.CustomerCreateFundingInstructionsParams{
+		BankTransfer: &stripe.CustomerCreateFundingInstructionsBankTransferParams{
+			Type: stripe.String(string(stripe.FundingInstructionsBankTransferTypeEUBankTransfer)),
+		},
+		Currency:    stripe.String(string(stripe.CurrencyEUR)),
+		FundingType: stripe.String(string(stripe.FundingInstructionsFundingTypeBankTransfer)),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.FundingInstructionsFundingTypeBankTransfer, instructions.FundingType)
+	assert.Equal(t, 1, len(instructions.BankTransfer.FinancialAddresses))
+	assert.Equal(t, "SXPYDEHH", instructions.BankTransfer.FinancialAddresses[0].IBAN.BIC)
+}
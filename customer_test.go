@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
 )
 
 func TestCustomer_UnmarshalJSON(t *testing.T) {
@@ -27,3 +28,42 @@ func TestCustomer_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, "cus_123", v.ID)
 	}
 }
+
+func TestCustomer_UnmarshalJSON_TaxSettings(t *testing.T) {
+	data := []byte(`{
+		"id": "cus_123",
+		"preferred_locales": ["fr-FR", "en"],
+		"tax": {
+			"automatic_tax": "supported",
+			"ip_address": "192.0.2.1",
+			"location": {"country": "FR", "source": "ip_address"}
+		},
+		"tax_exempt": "reverse"
+	}`)
+
+	var v Customer
+	err := json.Unmarshal(data, &v)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fr-FR", "en"}, v.PreferredLocales)
+	assert.Equal(t, CustomerTaxAutomaticTaxSupported, v.Tax.AutomaticTax)
+	assert.Equal(t, "192.0.2.1", v.Tax.IPAddress)
+	assert.Equal(t, CustomerTaxLocationSourceIPAddress, v.Tax.Location.Source)
+	assert.Equal(t, CustomerTaxExemptReverse, v.TaxExempt)
+}
+
+func TestCustomerParams_AppendTo_TaxSettings(t *testing.T) {
+	params := &CustomerParams{
+		PreferredLocales: StringSlice([]string{"fr-FR", "en"}),
+		Tax: &CustomerTaxParams{
+			IPAddress: String("192.0.2.1"),
+		},
+		TaxExempt: String(string(CustomerTaxExemptExempt)),
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"fr-FR"}, body.Get("preferred_locales[0]"))
+	assert.Equal(t, []string{"en"}, body.Get("preferred_locales[1]"))
+	assert.Equal(t, []string{"192.0.2.1"}, body.Get("tax[ip_address]"))
+	assert.Equal(t, []string{"exempt"}, body.Get("tax_exempt"))
+}
@@ -143,5 +143,5 @@ func (i *Iter) CustomerBalanceTransactionList() *stripe.CustomerBalanceTransacti
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,74 @@
+package customercashbalancetransaction
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestCustomerCashBalanceTransactionGet(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/customers/cus_123/cash_balance_transactions/ccsbtxn_123", r.URL.Path)
+		w.Write([]byte(`{
+			"id": "ccsbtxn_123",
+			"object": "customer_cash_balance_transaction",
+			"currency": "eur",
+			"customer": "cus_123",
+			"ending_balance": 5000,
+			"funded": {
+				"bank_transfer": {
+					"eu_bank_transfer": {"bic": "BANKDEFFXXX", "iban_last4": "3000", "sender_name": "Jenny Rosen"},
+					"reference": "REF-123",
+					"type": "eu_bank_transfer"
+				}
+			},
+			"net_amount": 5000,
+			"type": "funded"
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	txn, err := c.Get("ccsbtxn_123", &stripe.CustomerCashBalanceTransactionParams{
+		Customer: stripe.String("cus_123"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.CustomerCashBalanceTransactionTypeFunded, txn.Type)
+	assert.Equal(t, "cus_123", txn.Customer.ID)
+	assert.Equal(t, int64(5000), txn.NetAmount)
+	assert.Equal(t, "3000", txn.Funded.BankTransfer.EUBankTransfer.IBANLast4)
+}
+
+func TestCustomerCashBalanceTransactionGet_NilParams(t *testing.T) {
+	_, err := Get("ccsbtxn_123", nil)
+	assert.Error(t, err)
+}
+
+func TestCustomerCashBalanceTransactionList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/customers/cus_123/cash_balance_transactions", r.URL.Path)
+		w.Write([]byte(`{
+			"object": "list",
+			"has_more": false,
+			"data": [
+				{"id": "ccsbtxn_123", "object": "customer_cash_balance_transaction", "net_amount": -1000, "type": "applied_to_payment", "applied_to_payment": {"payment_intent": "pi_123"}}
+			]
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	i := c.List(&stripe.CustomerCashBalanceTransactionListParams{
+		Customer: stripe.String("cus_123"),
+	})
+
+	assert.True(t, i.Next())
+	assert.Equal(t, "pi_123", i.CustomerCashBalanceTransaction().AppliedToPayment.PaymentIntent.ID)
+	assert.NotNil(t, i.CustomerCashBalanceTransactionList())
+	assert.False(t, i.Next())
+	assert.Nil(t, i.Err())
+}
@@ -0,0 +1,182 @@
+// Package descriptorutil provides offline validation for the statement
+// descriptors of charges and PaymentIntents, so that descriptors Stripe would
+// reject can fail before a request is sent.
+//
+// Validation only covers the rules that can be checked without knowing the
+// account: a descriptor that passes may still be rejected by Stripe, for
+// example if it doesn't relate to the account's business.
+package descriptorutil
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public types
+//
+
+// Reason describes why a statement descriptor failed validation.
+type Reason string
+
+// List of values that Reason can take.
+const (
+	ReasonInvalidCharacter Reason = "invalid_character"
+	ReasonNoLetter         Reason = "no_letter"
+	ReasonTooLong          Reason = "too_long"
+	ReasonTooShort         Reason = "too_short"
+)
+
+// ValidationError is returned when a statement descriptor or suffix is
+// invalid. Field is the name of the parameter the value was given for, like
+// `statement_descriptor_suffix`.
+type ValidationError struct {
+	Field  string
+	Reason Reason
+	Value  string
+}
+
+// Error serializes the error object to a string.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s %q: %s", e.Field, e.Value, e.Reason)
+}
+
+//
+// Public constants
+//
+
+const (
+	// MaxLength is the maximum number of characters of a complete statement
+	// descriptor, including a prefix and its suffix.
+	MaxLength = 22
+
+	// MinLength is the minimum number of characters of a statement
+	// descriptor that isn't a suffix.
+	MinLength = 5
+)
+
+//
+// Public functions
+//
+
+// ValidateChargeParams checks the statement descriptor and suffix of params,
+// if they're set, and returns a *ValidationError describing the first problem
+// found. The suffix is checked without a prefix; use
+// ValidateStatementDescriptorSuffix to also check its combined length.
+func ValidateChargeParams(params *stripe.ChargeParams) error {
+	if params == nil {
+		return nil
+	}
+	return validateParams(params.StatementDescriptor, params.StatementDescriptorSuffix)
+}
+
+// ValidatePaymentIntentParams checks the statement descriptor and suffix of
+// params, if they're set, and returns a *ValidationError describing the first
+// problem found. The suffix is checked without a prefix; use
+// ValidateStatementDescriptorSuffix to also check its combined length.
+func ValidatePaymentIntentParams(params *stripe.PaymentIntentParams) error {
+	if params == nil {
+		return nil
+	}
+	return validateParams(params.StatementDescriptor, params.StatementDescriptorSuffix)
+}
+
+// ValidateStatementDescriptor checks a complete statement descriptor and
+// returns a *ValidationError describing the first problem found. It must be
+// 5 to 22 characters long, contain at least one letter, and only contain
+// Latin characters other than `<`, `>`, `\`, `'`, `"` and `*`.
+func ValidateStatementDescriptor(value string) error {
+	return validate("statement_descriptor", value, utf8.RuneCountInString(value), MinLength, true)
+}
+
+// ValidateStatementDescriptorSuffix checks a statement descriptor suffix and
+// returns a *ValidationError describing the first problem found. Stripe joins
+// the suffix to the account's prefix as `PREFIX* SUFFIX`, which must be at
+// most 22 characters long and contain at least one letter.
+//
+// prefix is the account's shortened descriptor. If it's empty, only the
+// suffix's own length and characters are checked, since a prefix with letters
+// makes a suffix without any valid.
+func ValidateStatementDescriptorSuffix(prefix, suffix string) error {
+	length := utf8.RuneCountInString(suffix)
+	if prefix != "" {
+		length += utf8.RuneCountInString(prefix + prefixSeparator)
+	}
+	return validate("statement_descriptor_suffix", suffix, length, 1, prefix != "" && !hasLetter(prefix))
+}
+
+//
+// Private constants
+//
+
+// prefixSeparator is inserted by Stripe between a prefix and its suffix.
+const prefixSeparator = "* "
+
+//
+// Private functions
+//
+
+func hasLetter(value string) bool {
+	for _, r := range value {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidRune reports whether r can appear in a statement descriptor.
+func isValidRune(r rune) bool {
+	switch r {
+	case '<', '>', '\\', '\'', '"', '*':
+		return false
+	}
+	if r < utf8.RuneSelf {
+		return ' ' <= r && r <= '~'
+	}
+	return unicode.Is(unicode.Latin, r)
+}
+
+// validate checks the characters of value and the length of the complete
+// descriptor it's part of. A letter is only required when needsLetter is true.
+func validate(field, value string, length, minLength int, needsLetter bool) error {
+	reason := Reason("")
+	switch {
+	case utf8.RuneCountInString(value) < minLength || length < minLength:
+		reason = ReasonTooShort
+	case length > MaxLength:
+		reason = ReasonTooLong
+	default:
+		for _, r := range value {
+			if !isValidRune(r) {
+				reason = ReasonInvalidCharacter
+				break
+			}
+		}
+		if reason == "" && needsLetter && !hasLetter(value) {
+			reason = ReasonNoLetter
+		}
+	}
+
+	if reason != "" {
+		return &ValidationError{Field: field, Reason: reason, Value: value}
+	}
+	return nil
+}
+
+func validateParams(descriptor, suffix *string) error {
+	if descriptor != nil {
+		if err := ValidateStatementDescriptor(*descriptor); err != nil {
+			return err
+		}
+	}
+	if suffix != nil {
+		if err := ValidateStatementDescriptorSuffix("", *suffix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package descriptorutil
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+func TestValidateStatementDescriptor_Valid(t *testing.T) {
+	for _, value := range []string{
+		"ROCKET RIDES",
+		"Rocket Rides 123",
+		"CAFÉ DU MONDE",
+		"ABCDE",
+		"ROCKET RIDES 123456789",
+	} {
+		assert.NoError(t, ValidateStatementDescriptor(value), value)
+	}
+}
+
+func TestValidateStatementDescriptor_Invalid(t *testing.T) {
+	testCases := []struct {
+		value  string
+		reason Reason
+	}{
+		{"", ReasonTooShort},
+		{"ABCD", ReasonTooShort},
+		{"ROCKET RIDES INCORPORATED", ReasonTooLong},
+		{"ROCKET <RIDES>", ReasonInvalidCharacter},
+		{"ROCKET* RIDES", ReasonInvalidCharacter},
+		{"BOB'S RIDES", ReasonInvalidCharacter},
+		{"ロケットライド", ReasonInvalidCharacter},
+		{"12345678", ReasonNoLetter},
+	}
+	for _, tc := range testCases {
+		err := ValidateStatementDescriptor(tc.value)
+		assert.Error(t, err, tc.value)
+
+		validationErr, ok := err.(*ValidationError)
+		assert.True(t, ok)
+		assert.Equal(t, "statement_descriptor", validationErr.Field)
+		assert.Equal(t, tc.reason, validationErr.Reason, tc.value)
+		assert.Equal(t, tc.value, validationErr.Value)
+	}
+}
+
+func TestValidateStatementDescriptorSuffix(t *testing.T) {
+	testCases := []struct {
+		prefix string
+		suffix string
+		reason Reason
+	}{
+		{"", "ORDER 123", ""},
+		{"", "123", ""},
+		{"ROCKET", "ORDER 1234567", ""},
+		{"ROCKET", "123", ""},
+		{"", "", ReasonTooShort},
+		{"", "ORDER NUMBER 123456789012", ReasonTooLong},
+		{"ROCKET", "ORDER 123456789", ReasonTooLong},
+		{"", "ORDER \"123\"", ReasonInvalidCharacter},
+		{"12345", "678", ReasonNoLetter},
+	}
+	for _, tc := range testCases {
+		err := ValidateStatementDescriptorSuffix(tc.prefix, tc.suffix)
+		if tc.reason == "" {
+			assert.NoError(t, err, "%s %s", tc.prefix, tc.suffix)
+			continue
+		}
+
+		validationErr, ok := err.(*ValidationError)
+		assert.True(t, ok, "%s %s", tc.prefix, tc.suffix)
+		assert.Equal(t, "statement_descriptor_suffix", validationErr.Field)
+		assert.Equal(t, tc.reason, validationErr.Reason, "%s %s", tc.prefix, tc.suffix)
+	}
+}
+
+func TestValidateChargeParams(t *testing.T) {
+	assert.NoError(t, ValidateChargeParams(nil))
+	assert.NoError(t, ValidateChargeParams(&stripe.ChargeParams{}))
+	assert.NoError(t, ValidateChargeParams(&stripe.ChargeParams{
+		StatementDescriptorSuffix: stripe.String("ORDER 123"),
+	}))
+
+	err := ValidateChargeParams(&stripe.ChargeParams{
+		StatementDescriptor: stripe.String("ABC"),
+	})
+	assert.Equal(t, &ValidationError{Field: "statement_descriptor", Reason: ReasonTooShort, Value: "ABC"}, err)
+	assert.Equal(t, `invalid statement_descriptor "ABC": too_short`, err.Error())
+}
+
+func TestValidatePaymentIntentParams(t *testing.T) {
+	assert.NoError(t, ValidatePaymentIntentParams(nil))
+	assert.NoError(t, ValidatePaymentIntentParams(&stripe.PaymentIntentParams{
+		StatementDescriptor: stripe.String("ROCKET RIDES"),
+	}))
+
+	err := ValidatePaymentIntentParams(&stripe.PaymentIntentParams{
+		StatementDescriptorSuffix: stripe.String("ORDER <123>"),
+	})
+	assert.Equal(t, &ValidationError{Field: "statement_descriptor_suffix", Reason: ReasonInvalidCharacter, Value: "ORDER <123>"}, err)
+}
@@ -46,5 +46,5 @@ func (c Client) DelSub(id string, params *stripe.DiscountParams) (*stripe.Discou
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
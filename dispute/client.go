@@ -18,6 +18,9 @@ import (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// FilesBackend is the backend evidence files are uploaded to by
+	// UpdateEvidence. Defaults to the global UploadsBackend.
+	FilesBackend stripe.Backend
 }
 
 // Get returns the details of a dispute.
@@ -99,5 +102,5 @@ func (i *Iter) DisputeList() *stripe.DisputeList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.GetKey()}
 }
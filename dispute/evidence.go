@@ -0,0 +1,111 @@
+package dispute
+
+import (
+	"fmt"
+	"io"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/file"
+)
+
+// EvidenceFile is a file to upload as a piece of dispute evidence.
+type EvidenceFile struct {
+	// Filename is the name the file is uploaded with. Only its base name is
+	// sent.
+	Filename string
+
+	// Reader is read for the contents of the file. Readers that implement
+	// io.Seeker, like an *os.File, are streamed and can be retried. See
+	// file.New.
+	Reader io.Reader
+}
+
+// EvidenceFiles are the files to upload for the evidence fields of a dispute
+// that take a file. Fields left nil are left unchanged.
+type EvidenceFiles struct {
+	CancellationPolicy           *EvidenceFile
+	CustomerCommunication        *EvidenceFile
+	CustomerSignature            *EvidenceFile
+	DuplicateChargeDocumentation *EvidenceFile
+	Receipt                      *EvidenceFile
+	RefundPolicy                 *EvidenceFile
+	ServiceDocumentation         *EvidenceFile
+	ShippingDocumentation        *EvidenceFile
+	UncategorizedFile            *EvidenceFile
+}
+
+// UpdateEvidence uploads evidence files and updates a dispute with them, along
+// with the rest of params. See Client.UpdateEvidence.
+func UpdateEvidence(id string, params *stripe.DisputeParams, files *EvidenceFiles) (*stripe.Dispute, error) {
+	return getC().UpdateEvidence(id, params, files)
+}
+
+// UpdateEvidence uploads evidence files to the files API and updates a dispute
+// with the resulting file IDs, along with the rest of params. params may be
+// nil, and isn't modified.
+//
+// Files are uploaded one at a time before the dispute is updated. If an
+// upload fails, the dispute isn't updated, and files that were already
+// uploaded are left unused.
+func (c Client) UpdateEvidence(id string, params *stripe.DisputeParams, files *EvidenceFiles) (*stripe.Dispute, error) {
+	p := stripe.DisputeParams{}
+	if params != nil {
+		p = *params
+	}
+	evidence := stripe.DisputeEvidenceParams{}
+	if p.Evidence != nil {
+		evidence = *p.Evidence
+	}
+	p.Evidence = &evidence
+
+	if files != nil {
+		uploads := []struct {
+			field string
+			file  *EvidenceFile
+			id    **string
+		}{
+			{"cancellation_policy", files.CancellationPolicy, &evidence.CancellationPolicy},
+			{"customer_communication", files.CustomerCommunication, &evidence.CustomerCommunication},
+			{"customer_signature", files.CustomerSignature, &evidence.CustomerSignature},
+			{"duplicate_charge_documentation", files.DuplicateChargeDocumentation, &evidence.DuplicateChargeDocumentation},
+			{"receipt", files.Receipt, &evidence.Receipt},
+			{"refund_policy", files.RefundPolicy, &evidence.RefundPolicy},
+			{"service_documentation", files.ServiceDocumentation, &evidence.ServiceDocumentation},
+			{"shipping_documentation", files.ShippingDocumentation, &evidence.ShippingDocumentation},
+			{"uncategorized_file", files.UncategorizedFile, &evidence.UncategorizedFile},
+		}
+
+		fileClient := c.filesClient()
+		for _, upload := range uploads {
+			if upload.file == nil {
+				continue
+			}
+
+			// Uploads share the dispute's context and account, but not its
+			// idempotency key
+			f, err := fileClient.New(&stripe.FileParams{
+				Params: stripe.Params{
+					Context:       p.Context,
+					StripeAccount: p.StripeAccount,
+				},
+				FileReader: upload.file.Reader,
+				Filename:   stripe.String(upload.file.Filename),
+				Purpose:    stripe.String(string(stripe.FilePurposeDisputeEvidence)),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error uploading evidence %s: %w", upload.field, err)
+			}
+			*upload.id = stripe.String(f.ID)
+		}
+	}
+
+	return c.Update(id, &p)
+}
+
+func (c Client) filesClient() file.Client {
+	b := c.FilesBackend
+	if b == nil {
+		b = stripe.GetBackend(stripe.UploadsBackend)
+	}
+	return file.Client{B: b, Key: c.Key}
+}
@@ -0,0 +1,65 @@
+package dispute
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestDisputeUpdateEvidence(t *testing.T) {
+	var uploads []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "acct_123", r.Header.Get("Stripe-Account"))
+
+		switch r.URL.Path {
+		case "/v1/files":
+			f, header, err := r.FormFile("file")
+			assert.NoError(t, err)
+			f.Close()
+			assert.Equal(t, string(stripe.FilePurposeDisputeEvidence), r.FormValue("purpose"))
+
+			uploads = append(uploads, header.Filename)
+			fmt.Fprintf(w, `{"id":"file_%d","object":"file"}`, len(uploads))
+
+		case "/v1/disputes/dp_123":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "file_1", r.PostForm.Get("evidence[customer_communication]"))
+			assert.Equal(t, "file_2", r.PostForm.Get("evidence[receipt]"))
+			assert.Equal(t, "Shoes", r.PostForm.Get("evidence[product_description]"))
+			assert.Equal(t, "true", r.PostForm.Get("submit"))
+			w.Write([]byte(`{"id":"dp_123","object":"dispute"}`))
+
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	backend := stripetest.NewBackend(ts.URL)
+	c := Client{B: backend, FilesBackend: backend, Key: "sk_test_123"}
+
+	params := &stripe.DisputeParams{
+		Evidence: &stripe.DisputeEvidenceParams{
+			ProductDescription: stripe.String("Shoes"),
+		},
+		Submit: stripe.Bool(true),
+	}
+	params.SetStripeAccount("acct_123")
+
+	dispute, err := c.UpdateEvidence("dp_123", params, &EvidenceFiles{
+		CustomerCommunication: &EvidenceFile{Filename: "emails.txt", Reader: strings.NewReader("Thanks!")},
+		Receipt:               &EvidenceFile{Filename: "receipt.pdf", Reader: strings.NewReader("%PDF")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "dp_123", dispute.ID)
+	assert.Equal(t, []string{"emails.txt", "receipt.pdf"}, uploads)
+
+	// The caller's params aren't modified
+	assert.Nil(t, params.Evidence.Receipt)
+}
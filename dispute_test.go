@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
 )
 
 func TestDispute_UnmarshalJSON(t *testing.T) {
@@ -27,3 +28,52 @@ func TestDispute_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, "dp_123", v.ID)
 	}
 }
+
+func TestDisputeEvidenceParams_AppendTo(t *testing.T) {
+	params := &DisputeParams{
+		Evidence: &DisputeEvidenceParams{
+			CustomerSignature:      String("file_123"),
+			ProductDescription:     String("A widget"),
+			Receipt:                String("file_456"),
+			ShippingDocumentation:  String("file_789"),
+			ShippingTrackingNumber: String("1Z999"),
+		},
+		Submit: Bool(false),
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"file_123"}, body.Get("evidence[customer_signature]"))
+	assert.Equal(t, []string{"A widget"}, body.Get("evidence[product_description]"))
+	assert.Equal(t, []string{"file_456"}, body.Get("evidence[receipt]"))
+	assert.Equal(t, []string{"file_789"}, body.Get("evidence[shipping_documentation]"))
+	assert.Equal(t, []string{"1Z999"}, body.Get("evidence[shipping_tracking_number]"))
+	assert.Equal(t, []string{"false"}, body.Get("submit"))
+}
+
+func TestDispute_UnmarshalEvidence(t *testing.T) {
+	data := []byte(`{
+		"id": "dp_123",
+		"evidence": {
+			"customer_name": "Jane Doe",
+			"receipt": "file_123",
+			"shipping_documentation": {"id": "file_456", "object": "file"}
+		},
+		"evidence_details": {
+			"due_by": 1600000000,
+			"has_evidence": true,
+			"submission_count": 1
+		}
+	}`)
+
+	var v Dispute
+	err := json.Unmarshal(data, &v)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", v.Evidence.CustomerName)
+	assert.Equal(t, "file_123", v.Evidence.Receipt.ID)
+	assert.Equal(t, "file_456", v.Evidence.ShippingDocumentation.ID)
+	assert.Equal(t, int64(1600000000), v.EvidenceDetails.DueBy)
+	assert.True(t, v.EvidenceDetails.HasEvidence)
+	assert.Equal(t, int64(1), v.EvidenceDetails.SubmissionCount)
+}
@@ -61,5 +61,5 @@ func (c Client) Del(id string, params *stripe.EphemeralKeyParams) (*stripe.Ephem
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -1,6 +1,11 @@
 package stripe
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
 
 // ErrorType is the list of allowed values for the error's type.
 type ErrorType string
@@ -229,6 +234,30 @@ func (e *Error) Unwrap() error {
 	return e.Err
 }
 
+// ErrDeletedObject is matched with errors.Is by the *DeletedObjectError
+// returned when a deleted object is retrieved.
+var ErrDeletedObject = errors.New("object has been deleted")
+
+// DeletedObjectError is returned by the Get methods of the customer, plan,
+// product and card clients when the API responds with the stub of a deleted
+// object, which only has an ID and `deleted: true`. The stub is still
+// returned along with the error.
+type DeletedObjectError struct {
+	ID     string
+	Object string
+}
+
+// Error serializes the error object to a string.
+func (e *DeletedObjectError) Error() string {
+	return fmt.Sprintf("%s %s has been deleted", e.Object, e.ID)
+}
+
+// Is reports whether target is ErrDeletedObject, so that errors.Is can be
+// used to check for deleted objects without errors.As.
+func (e *DeletedObjectError) Is(target error) bool {
+	return target == ErrDeletedObject
+}
+
 // APIConnectionError is a failure to connect to the Stripe API.
 type APIConnectionError struct {
 	stripeErr *Error
@@ -319,6 +348,40 @@ func (e *IdempotencyError) Error() string {
 	return e.stripeErr.Error()
 }
 
+// IsCardError reports whether err is, or wraps, an error returned because a
+// card couldn't be charged. Its CardError, including the issuer's
+// DeclineCode, can be retrieved with errors.As.
+func IsCardError(err error) bool {
+	var cardErr *CardError
+	return errors.As(err, &cardErr)
+}
+
+// IsIdempotencyError reports whether err is, or wraps, an error returned
+// because an Idempotency-Key was reused for a different request.
+func IsIdempotencyError(err error) bool {
+	var idempotencyErr *IdempotencyError
+	return errors.As(err, &idempotencyErr)
+}
+
+// IsInvalidRequestError reports whether err is, or wraps, an error returned
+// because a request had invalid parameters.
+func IsInvalidRequestError(err error) bool {
+	var invalidRequestErr *InvalidRequestError
+	return errors.As(err, &invalidRequestErr)
+}
+
+// IsRateLimitError reports whether err is, or wraps, an error returned
+// because too many requests were made too quickly. Stripe reports these with
+// a 429 status code, usually along with an `invalid_request_error` type, so
+// the status code is checked as well as the type.
+func IsRateLimitError(err error) bool {
+	var stripeErr *Error
+	if !errors.As(err, &stripeErr) {
+		return false
+	}
+	return stripeErr.HTTPStatusCode == http.StatusTooManyRequests || stripeErr.Type == ErrorTypeRateLimit
+}
+
 // redact returns a copy of the error object with sensitive fields replaced with
 // a placeholder value.
 func (e *Error) redact() *Error {
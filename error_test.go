@@ -42,6 +42,32 @@ func TestErrorResponse(t *testing.T) {
 	assert.True(t, errors.As(err, &invalidRequestErr))
 }
 
+func TestErrorPredicates(t *testing.T) {
+	cardErr := &Error{Type: ErrorTypeCard, DeclineCode: DeclineCodeInsufficientFunds}
+	cardErr.Err = &CardError{stripeErr: cardErr, DeclineCode: cardErr.DeclineCode}
+	wrapped := fmt.Errorf("charging customer: %w", cardErr)
+
+	assert.True(t, IsCardError(wrapped))
+	assert.False(t, IsInvalidRequestError(wrapped))
+	assert.False(t, IsRateLimitError(wrapped))
+
+	var typedErr *CardError
+	assert.True(t, errors.As(wrapped, &typedErr))
+	assert.Equal(t, DeclineCodeInsufficientFunds, typedErr.DeclineCode)
+
+	rateLimitErr := &Error{Type: ErrorTypeInvalidRequest, HTTPStatusCode: http.StatusTooManyRequests}
+	rateLimitErr.Err = &InvalidRequestError{stripeErr: rateLimitErr}
+	assert.True(t, IsRateLimitError(rateLimitErr))
+	assert.True(t, IsInvalidRequestError(rateLimitErr))
+
+	idempotencyErr := &Error{Type: ErrorTypeIdempotency}
+	idempotencyErr.Err = &IdempotencyError{stripeErr: idempotencyErr}
+	assert.True(t, IsIdempotencyError(idempotencyErr))
+
+	assert.False(t, IsCardError(errors.New("foo")))
+	assert.False(t, IsRateLimitError(nil))
+}
+
 func TestErrorRedact(t *testing.T) {
 	pi := &PaymentIntent{Amount: int64(400), ClientSecret: "foo"}
 	si := &SetupIntent{Description: "keepme", ClientSecret: "foo"}
@@ -88,3 +114,16 @@ func TestErrorRedact(t *testing.T) {
 		assert.Equal(t, "REDACTED", redacted.SetupIntent.ClientSecret)
 	})
 }
+
+func TestDeletedObjectError(t *testing.T) {
+	var err error = &DeletedObjectError{ID: "cus_123", Object: "customer"}
+	assert.Equal(t, "customer cus_123 has been deleted", err.Error())
+	assert.True(t, errors.Is(err, ErrDeletedObject))
+	assert.True(t, errors.Is(fmt.Errorf("wrapped: %w", err), ErrDeletedObject))
+
+	var deletedErr *DeletedObjectError
+	assert.True(t, errors.As(err, &deletedErr))
+	assert.Equal(t, "cus_123", deletedErr.ID)
+
+	assert.False(t, errors.Is(errors.New("other"), ErrDeletedObject))
+}
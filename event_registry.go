@@ -0,0 +1,233 @@
+package stripe
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+//
+// Public types
+//
+
+// EventObjectDecoder decodes the raw JSON of an event's data object into a
+// concrete value.
+type EventObjectDecoder func(raw []byte) (interface{}, error)
+
+//
+// Public functions
+//
+
+// GetObject decodes the event's data object into the concrete type from this
+// package that corresponds to it, such as *Invoice for an `invoice.paid`
+// event.
+//
+// A decoder registered for the event's type with RegisterEventTypeDecoder is
+// used first, followed by one registered for the object's name with
+// RegisterEventObjectDecoder, and finally the decoders built into this
+// package. If none apply, for example because the event is for a resource
+// that this version of the library doesn't know about yet, the raw
+// map[string]interface{} from Data.Object is returned.
+func (e *Event) GetObject() (interface{}, error) {
+	if e.Data == nil {
+		return nil, nil
+	}
+
+	decoder := lookupEventObjectDecoder(e.Type, e.objectName())
+	if decoder == nil {
+		return e.Data.Object, nil
+	}
+
+	return decoder(e.Data.Raw)
+}
+
+// RegisterEventObjectDecoder registers a decoder for event data objects with
+// the given `object` name (e.g. "invoice" or "issuing.card"). It replaces any
+// decoder previously registered for the same name, including those built into
+// this package, which makes it possible to decode objects from beta or preview
+// APIs into application-defined types.
+//
+// RegisterEventObjectDecoder is safe to call concurrently with GetObject,
+// but decoders are usually registered once during initialization.
+func RegisterEventObjectDecoder(object string, decoder EventObjectDecoder) {
+	eventRegistry.mu.Lock()
+	defer eventRegistry.mu.Unlock()
+
+	eventRegistry.objects[object] = decoder
+}
+
+// RegisterEventTypeDecoder registers a decoder for the data object of events
+// with the given type (e.g. "customer.subscription.updated"). Decoders
+// registered by event type take precedence over those registered by object
+// name.
+func RegisterEventTypeDecoder(eventType string, decoder EventObjectDecoder) {
+	eventRegistry.mu.Lock()
+	defer eventRegistry.mu.Unlock()
+
+	eventRegistry.types[eventType] = decoder
+}
+
+//
+// Private types
+//
+
+type eventObjectRegistry struct {
+	mu      sync.RWMutex
+	objects map[string]EventObjectDecoder
+	types   map[string]EventObjectDecoder
+}
+
+//
+// Private variables
+//
+
+var eventRegistry = eventObjectRegistry{
+	objects: make(map[string]EventObjectDecoder),
+	types:   make(map[string]EventObjectDecoder),
+}
+
+// objectConstructors maps the `object` name of API resources to a function
+// allocating the corresponding type. It's used to decode event data objects
+// and by Retrieve.
+var objectConstructors = map[string]func() interface{}{
+	"account":                             func() interface{} { return &Account{} },
+	"apple_pay_domain":                    func() interface{} { return &ApplePayDomain{} },
+	"application":                         func() interface{} { return &Application{} },
+	"application_fee":                     func() interface{} { return &ApplicationFee{} },
+	"apps.secret":                         func() interface{} { return &AppsSecret{} },
+	"balance":                             func() interface{} { return &Balance{} },
+	"balance_transaction":                 func() interface{} { return &BalanceTransaction{} },
+	"bank_account":                        func() interface{} { return &BankAccount{} },
+	"billing_portal.configuration":        func() interface{} { return &BillingPortalConfiguration{} },
+	"billing_portal.session":              func() interface{} { return &BillingPortalSession{} },
+	"capability":                          func() interface{} { return &Capability{} },
+	"card":                                func() interface{} { return &Card{} },
+	"cash_balance":                        func() interface{} { return &CashBalance{} },
+	"charge":                              func() interface{} { return &Charge{} },
+	"checkout.session":                    func() interface{} { return &CheckoutSession{} },
+	"connect_collection_transfer":         func() interface{} { return &ConnectCollectionTransfer{} },
+	"country_spec":                        func() interface{} { return &CountrySpec{} },
+	"coupon":                              func() interface{} { return &Coupon{} },
+	"credit_note":                         func() interface{} { return &CreditNote{} },
+	"credit_note_line_item":               func() interface{} { return &CreditNoteLineItem{} },
+	"customer":                            func() interface{} { return &Customer{} },
+	"customer_balance_transaction":        func() interface{} { return &CustomerBalanceTransaction{} },
+	"customer_cash_balance_transaction":   func() interface{} { return &CustomerCashBalanceTransaction{} },
+	"discount":                            func() interface{} { return &Discount{} },
+	"dispute":                             func() interface{} { return &Dispute{} },
+	"ephemeral_key":                       func() interface{} { return &EphemeralKey{} },
+	"event":                               func() interface{} { return &Event{} },
+	"exchange_rate":                       func() interface{} { return &ExchangeRate{} },
+	"fee_refund":                          func() interface{} { return &FeeRefund{} },
+	"file":                                func() interface{} { return &File{} },
+	"file_link":                           func() interface{} { return &FileLink{} },
+	"financial_connections.account":       func() interface{} { return &FinancialConnectionsAccount{} },
+	"financial_connections.account_owner": func() interface{} { return &FinancialConnectionsAccountOwner{} },
+	"financial_connections.account_ownership": func() interface{} { return &FinancialConnectionsAccountOwnership{} },
+	"financial_connections.session":           func() interface{} { return &FinancialConnectionsSession{} },
+	"identity.verification_report":            func() interface{} { return &IdentityVerificationReport{} },
+	"identity.verification_session":           func() interface{} { return &IdentityVerificationSession{} },
+	"invoice":                                 func() interface{} { return &Invoice{} },
+	"invoiceitem":                             func() interface{} { return &InvoiceItem{} },
+	"issuing.authorization":                   func() interface{} { return &IssuingAuthorization{} },
+	"issuing.card":                            func() interface{} { return &IssuingCard{} },
+	"issuing.cardholder":                      func() interface{} { return &IssuingCardholder{} },
+	"issuing.dispute":                         func() interface{} { return &IssuingDispute{} },
+	"issuing.personalization_design":          func() interface{} { return &IssuingPersonalizationDesign{} },
+	"issuing.physical_bundle":                 func() interface{} { return &IssuingPhysicalBundle{} },
+	"issuing.token":                           func() interface{} { return &IssuingToken{} },
+	"issuing.transaction":                     func() interface{} { return &IssuingTransaction{} },
+	"item":                                    func() interface{} { return &LineItem{} },
+	"line_item":                               func() interface{} { return &InvoiceLine{} },
+	"mandate":                                 func() interface{} { return &Mandate{} },
+	"order":                                   func() interface{} { return &Order{} },
+	"order_return":                            func() interface{} { return &OrderReturn{} },
+	"payment_intent":                          func() interface{} { return &PaymentIntent{} },
+	"payment_link":                            func() interface{} { return &PaymentLink{} },
+	"payment_method":                          func() interface{} { return &PaymentMethod{} },
+	"payment_method_configuration":            func() interface{} { return &PaymentMethodConfiguration{} },
+	"payment_method_domain":                   func() interface{} { return &PaymentMethodDomain{} },
+	"payout":                                  func() interface{} { return &Payout{} },
+	"person":                                  func() interface{} { return &Person{} },
+	"plan":                                    func() interface{} { return &Plan{} },
+	"price":                                   func() interface{} { return &Price{} },
+	"product":                                 func() interface{} { return &Product{} },
+	"promotion_code":                          func() interface{} { return &PromotionCode{} },
+	"quote":                                   func() interface{} { return &Quote{} },
+	"radar.early_fraud_warning":               func() interface{} { return &RadarEarlyFraudWarning{} },
+	"radar.value_list":                        func() interface{} { return &RadarValueList{} },
+	"radar.value_list_item":                   func() interface{} { return &RadarValueListItem{} },
+	"refund":                                  func() interface{} { return &Refund{} },
+	"reporting.report_run":                    func() interface{} { return &ReportRun{} },
+	"reporting.report_type":                   func() interface{} { return &ReportType{} },
+	"review":                                  func() interface{} { return &Review{} },
+	"scheduled_query_run":                     func() interface{} { return &SigmaScheduledQueryRun{} },
+	"setup_attempt":                           func() interface{} { return &SetupAttempt{} },
+	"setup_intent":                            func() interface{} { return &SetupIntent{} },
+	"shipping_rate":                           func() interface{} { return &ShippingRate{} },
+	"sku":                                     func() interface{} { return &SKU{} },
+	"source":                                  func() interface{} { return &Source{} },
+	"source_transaction":                      func() interface{} { return &SourceTransaction{} },
+	"subscription":                            func() interface{} { return &Subscription{} },
+	"subscription_item":                       func() interface{} { return &SubscriptionItem{} },
+	"subscription_schedule":                   func() interface{} { return &SubscriptionSchedule{} },
+	"tax.calculation":                         func() interface{} { return &TaxCalculation{} },
+	"tax.calculation_line_item":               func() interface{} { return &TaxCalculationLineItem{} },
+	"tax.transaction":                         func() interface{} { return &TaxTransaction{} },
+	"tax.transaction_line_item":               func() interface{} { return &TaxTransactionLineItem{} },
+	"tax_code":                                func() interface{} { return &TaxCode{} },
+	"tax_id":                                  func() interface{} { return &TaxID{} },
+	"tax_rate":                                func() interface{} { return &TaxRate{} },
+	"terminal.configuration":                  func() interface{} { return &TerminalConfiguration{} },
+	"terminal.location":                       func() interface{} { return &TerminalLocation{} },
+	"terminal.reader":                         func() interface{} { return &TerminalReader{} },
+	"test_helpers.test_clock":                 func() interface{} { return &TestHelpersTestClock{} },
+	"token":                                   func() interface{} { return &Token{} },
+	"topup":                                   func() interface{} { return &Topup{} },
+	"transfer":                                func() interface{} { return &Transfer{} },
+	"transfer_reversal":                       func() interface{} { return &Reversal{} },
+	"treasury.credit_reversal":                func() interface{} { return &TreasuryCreditReversal{} },
+	"treasury.debit_reversal":                 func() interface{} { return &TreasuryDebitReversal{} },
+	"treasury.financial_account":              func() interface{} { return &TreasuryFinancialAccount{} },
+	"treasury.inbound_transfer":               func() interface{} { return &TreasuryInboundTransfer{} },
+	"treasury.outbound_payment":               func() interface{} { return &TreasuryOutboundPayment{} },
+	"treasury.outbound_transfer":              func() interface{} { return &TreasuryOutboundTransfer{} },
+	"treasury.received_credit":                func() interface{} { return &TreasuryReceivedCredit{} },
+	"treasury.received_debit":                 func() interface{} { return &TreasuryReceivedDebit{} },
+	"treasury.transaction":                    func() interface{} { return &TreasuryTransaction{} },
+	"treasury.transaction_entry":              func() interface{} { return &TreasuryTransactionEntry{} },
+	"usage_record":                            func() interface{} { return &UsageRecord{} },
+	"usage_record_summary":                    func() interface{} { return &UsageRecordSummary{} },
+	"webhook_endpoint":                        func() interface{} { return &WebhookEndpoint{} },
+}
+
+//
+// Private functions
+//
+
+func lookupEventObjectDecoder(eventType, object string) EventObjectDecoder {
+	eventRegistry.mu.RLock()
+	defer eventRegistry.mu.RUnlock()
+
+	if decoder, ok := eventRegistry.types[eventType]; ok {
+		return decoder
+	}
+	if decoder, ok := eventRegistry.objects[object]; ok {
+		return decoder
+	}
+	if newObject, ok := objectConstructors[object]; ok {
+		return func(raw []byte) (interface{}, error) {
+			v := newObject()
+			if err := json.Unmarshal(raw, v); err != nil {
+				return nil, err
+			}
+			return v, nil
+		}
+	}
+	return nil
+}
+
+// objectName returns the `object` name of the event's data object.
+func (e *Event) objectName() string {
+	object, _ := e.Data.Object["object"].(string)
+	return object
+}
@@ -1,6 +1,13 @@
 package stripe
 
 import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
@@ -53,3 +60,110 @@ func TestGetObjectValue(t *testing.T) {
 		event.GetObjectValue("top_level_key", "bad_key")
 	})
 }
+
+func TestEventGetObject(t *testing.T) {
+	var event Event
+	err := json.Unmarshal([]byte(`{
+		"id": "evt_123",
+		"type": "invoice.paid",
+		"data": {"object": {"id": "in_123", "object": "invoice", "amount_paid": 100}}
+	}`), &event)
+	assert.NoError(t, err)
+
+	obj, err := event.GetObject()
+	assert.NoError(t, err)
+
+	invoice, ok := obj.(*Invoice)
+	assert.True(t, ok)
+	assert.Equal(t, "in_123", invoice.ID)
+	assert.Equal(t, int64(100), invoice.AmountPaid)
+}
+
+func TestEventGetObject_Unknown(t *testing.T) {
+	var event Event
+	err := json.Unmarshal([]byte(`{
+		"type": "preview.thing.created",
+		"data": {"object": {"id": "pt_123", "object": "preview.thing"}}
+	}`), &event)
+	assert.NoError(t, err)
+
+	obj, err := event.GetObject()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "pt_123", "object": "preview.thing"}, obj)
+}
+
+func TestEventGetObject_Registered(t *testing.T) {
+	type previewThing struct {
+		ID string `json:"id"`
+	}
+
+	RegisterEventObjectDecoder("preview.thing", func(raw []byte) (interface{}, error) {
+		v := &previewThing{}
+		err := json.Unmarshal(raw, v)
+		return v, err
+	})
+	RegisterEventTypeDecoder("preview.thing.deleted", func(raw []byte) (interface{}, error) {
+		return "deleted", nil
+	})
+	defer func() {
+		delete(eventRegistry.objects, "preview.thing")
+		delete(eventRegistry.types, "preview.thing.deleted")
+	}()
+
+	event := &Event{Type: "preview.thing.created", Data: &EventData{
+		Object: map[string]interface{}{"object": "preview.thing"},
+		Raw:    []byte(`{"id":"pt_123","object":"preview.thing"}`),
+	}}
+	obj, err := event.GetObject()
+	assert.NoError(t, err)
+	assert.Equal(t, &previewThing{ID: "pt_123"}, obj)
+
+	event.Type = "preview.thing.deleted"
+	obj, err = event.GetObject()
+	assert.NoError(t, err)
+	assert.Equal(t, "deleted", obj)
+}
+
+// TestObjectConstructors_Complete checks that every resource type in this
+// package, which are the structs with both an ID and an Object field, can be
+// decoded from events. Polymorphic types like PaymentSource, which decode
+// `object` into their Type, are decoded through their concrete types.
+func TestObjectConstructors_Complete(t *testing.T) {
+	registered := make(map[string]bool)
+	for _, newObject := range objectConstructors {
+		registered[reflect.TypeOf(newObject()).Elem().Name()] = true
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	assert.NoError(t, err)
+
+	for _, file := range pkgs["stripe"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			structType, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+
+			var hasID, hasObject bool
+			for _, field := range structType.Fields.List {
+				if field.Tag == nil || len(field.Names) != 1 {
+					continue
+				}
+				name, tag := field.Names[0].Name, field.Tag.Value
+				hasID = hasID || (name == "ID" && strings.Contains(tag, `json:"id"`))
+				hasObject = hasObject || (name == "Object" && strings.Contains(tag, `json:"object"`))
+			}
+			if hasID && hasObject && !registered[spec.Name.Name] {
+				t.Errorf("%s has an object name but isn't in objectConstructors", spec.Name.Name)
+			}
+			return false
+		})
+	}
+}
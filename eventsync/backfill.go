@@ -0,0 +1,221 @@
+package eventsync
+
+import (
+	"context"
+	"errors"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/event"
+)
+
+//
+// Public types
+//
+
+// Backfiller pages through the /v1/events API from a stored cursor and
+// dispatches every event that hasn't been processed yet to a Router.
+//
+// Events are dispatched oldest first, and the cursor is saved after each
+// event that's handled successfully, so a failed run can be resumed by
+// calling Run again. The API doesn't say how events created within the same
+// second are ordered, so every event created in the cursor's second is
+// dispatched again by the next run, and Events should be set to skip those
+// that were already handled.
+type Backfiller struct {
+	// B is the backend used to list events. Defaults to the global API
+	// backend.
+	B stripe.Backend
+
+	// Cursors persists the position of the last handled event. If nil, Run
+	// considers every event still available through the API.
+	Cursors CursorStore
+
+	// Events records which events have already been handled, usually shared
+	// with a webhook endpoint. If nil, events are not deduplicated beyond the
+	// cursor, so events created in the same second as the cursor's event are
+	// dispatched again.
+	Events EventStore
+
+	// Key is the API key used to list events. Defaults to stripe.GetKey().
+	Key string
+
+	// Router receives every event that hasn't been processed yet.
+	Router *Router
+
+	// Types optionally restricts the backfill to the given event types. It
+	// accepts the same values as the `types` parameter of the list events API.
+	Types []string
+}
+
+//
+// Public functions
+//
+
+// Run lists every event created since the stored cursor and dispatches the
+// ones that haven't been processed yet. It returns the number of events that
+// were dispatched.
+//
+// Run stops at the first error returned by a handler or store. Because the
+// cursor is saved after each successful event, calling Run again resumes from
+// the event that failed.
+func (b *Backfiller) Run(ctx context.Context) (int, error) {
+	if b.Router == nil {
+		return 0, errors.New("eventsync: Backfiller requires a Router")
+	}
+
+	var cursor *Cursor
+	if b.Cursors != nil {
+		var err error
+		cursor, err = b.Cursors.LoadCursor(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	dispatched := 0
+	err := b.eachSince(ctx, cursor, func(e *stripe.Event) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		handled, err := b.handle(ctx, e)
+		if err != nil {
+			return err
+		}
+		if handled {
+			dispatched++
+		}
+
+		if b.Cursors != nil {
+			return b.Cursors.SaveCursor(ctx, &Cursor{Created: e.Created, ID: e.ID})
+		}
+		return nil
+	})
+	return dispatched, err
+}
+
+//
+// Private functions
+//
+
+// handle dispatches a single event unless it was already processed. It
+// returns whether the event was dispatched.
+func (b *Backfiller) handle(ctx context.Context, e *stripe.Event) (bool, error) {
+	if b.Events != nil {
+		processed, err := b.Events.HasProcessed(ctx, e.ID)
+		if err != nil {
+			return false, err
+		}
+		if processed {
+			return false, nil
+		}
+	}
+
+	if err := b.Router.Dispatch(ctx, e); err != nil {
+		return false, err
+	}
+
+	if b.Events != nil {
+		if err := b.Events.MarkProcessed(ctx, e.ID); err != nil {
+			return true, err
+		}
+	}
+
+	return true, nil
+}
+
+// eachSince calls f with every event created in the cursor's second or
+// later, from oldest to newest, until f returns an error.
+//
+// The API lists events newest first, and only lists them oldest first going
+// back from a known event with `ending_before`. That event is the newest one
+// created before the cursor's second, so that the events after it are listed
+// once without ever holding more than a page of them.
+func (b *Backfiller) eachSince(ctx context.Context, cursor *Cursor, f func(*stripe.Event) error) error {
+	backend := b.B
+	if backend == nil {
+		backend = stripe.GetBackend(stripe.APIBackend)
+	}
+	key := b.Key
+	if key == "" {
+		key = stripe.GetKey()
+	}
+	client := event.Client{B: backend, Key: key}
+
+	var start *stripe.Event
+	if cursor != nil {
+		params := b.listParams(ctx, nil)
+		params.CreatedRange = &stripe.RangeQueryParams{LesserThan: cursor.Created}
+		params.Limit = stripe.Int64(1)
+		params.Single = true
+		i := client.List(params)
+		if i.Next() {
+			start = i.Event()
+		}
+		if err := i.Err(); err != nil {
+			return err
+		}
+	}
+
+	// Without an older event to start from, like on the first run, the
+	// oldest page of events has to be found by paging through them newest
+	// first. Only that page is kept, and the events after it are then listed
+	// from its newest event.
+	if start == nil {
+		var page []*stripe.Event
+		params := b.listParams(ctx, cursor)
+		params.Single = true
+		for {
+			i := client.List(params)
+			var next []*stripe.Event
+			for i.Next() {
+				next = append(next, i.Event())
+			}
+			if err := i.Err(); err != nil {
+				return err
+			}
+			if len(next) > 0 {
+				page = next
+			}
+			if len(next) == 0 || !i.Meta().HasMore {
+				break
+			}
+			params.StartingAfter = stripe.String(next[len(next)-1].ID)
+		}
+		if len(page) == 0 {
+			return nil
+		}
+
+		for n := len(page) - 1; n >= 0; n-- {
+			if err := f(page[n]); err != nil {
+				return err
+			}
+		}
+		start = page[0]
+	}
+
+	params := b.listParams(ctx, cursor)
+	params.EndingBefore = stripe.String(start.ID)
+	i := client.List(params)
+	for i.Next() {
+		if err := f(i.Event()); err != nil {
+			return err
+		}
+	}
+	return i.Err()
+}
+
+// listParams returns the parameters to list the events created at or after
+// the cursor.
+func (b *Backfiller) listParams(ctx context.Context, cursor *Cursor) *stripe.EventListParams {
+	params := &stripe.EventListParams{}
+	params.Context = ctx
+	params.Limit = stripe.Int64(100)
+	if cursor != nil {
+		params.CreatedRange = &stripe.RangeQueryParams{GreaterThanOrEqual: cursor.Created}
+	}
+	if len(b.Types) > 0 {
+		params.Types = stripe.StringSlice(b.Types)
+	}
+	return params
+}
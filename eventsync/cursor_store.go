@@ -0,0 +1,168 @@
+package eventsync
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+//
+// Public types
+//
+
+// FileCursorStore is a CursorStore that persists the cursor as JSON in a file
+// on the local filesystem. Writes go to a temporary file that's then renamed
+// over the original so that a crash never leaves a partially written cursor.
+type FileCursorStore struct {
+	// Path is the path of the file holding the cursor.
+	Path string
+}
+
+// LoadCursor reads the cursor from the file, returning nil if the file
+// doesn't exist yet.
+func (s *FileCursorStore) LoadCursor(ctx context.Context) (*Cursor, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := &Cursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("eventsync: couldn't decode cursor in %s: %v", s.Path, err)
+	}
+	return cursor, nil
+}
+
+// SaveCursor writes the cursor to the file.
+func (s *FileCursorStore) SaveCursor(ctx context.Context, cursor *Cursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// SQLCursorStore is a CursorStore that persists cursors in a SQL table, which
+// is useful when several processes share a database. Any driver supported by
+// database/sql may be used.
+//
+// The table must exist and have the following shape (adjusting types for the
+// database in use):
+//
+//	CREATE TABLE stripe_event_cursors (
+//	    name     VARCHAR(255) PRIMARY KEY,
+//	    created  BIGINT NOT NULL,
+//	    event_id VARCHAR(255) NOT NULL
+//	);
+type SQLCursorStore struct {
+	// DB is the database holding the cursors table.
+	DB *sql.DB
+
+	// Name identifies this cursor, allowing several pollers to share a
+	// table. Defaults to "default".
+	Name string
+
+	// Placeholder returns the bind parameter for the n-th argument of a
+	// query, starting at 1. Defaults to `?`, as used by MySQL and SQLite.
+	// For PostgreSQL, use a function returning `$1`, `$2`, and so on.
+	Placeholder func(n int) string
+
+	// Table is the name of the cursors table. Defaults to
+	// "stripe_event_cursors".
+	Table string
+}
+
+// LoadCursor reads the cursor from the table, returning nil if none has been
+// saved yet.
+func (s *SQLCursorStore) LoadCursor(ctx context.Context) (*Cursor, error) {
+	query := fmt.Sprintf("SELECT created, event_id FROM %s WHERE name = %s",
+		s.table(), s.placeholder(1))
+
+	cursor := &Cursor{}
+	err := s.DB.QueryRowContext(ctx, query, s.name()).Scan(&cursor.Created, &cursor.ID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// SaveCursor writes the cursor to the table, inserting a row if this is the
+// first time it's saved.
+func (s *SQLCursorStore) SaveCursor(ctx context.Context, cursor *Cursor) error {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	update := fmt.Sprintf("UPDATE %s SET created = %s, event_id = %s WHERE name = %s",
+		s.table(), s.placeholder(1), s.placeholder(2), s.placeholder(3))
+	res, err := tx.ExecContext(ctx, update, cursor.Created, cursor.ID, s.name())
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		insert := fmt.Sprintf("INSERT INTO %s (name, created, event_id) VALUES (%s, %s, %s)",
+			s.table(), s.placeholder(1), s.placeholder(2), s.placeholder(3))
+		if _, err := tx.ExecContext(ctx, insert, s.name(), cursor.Created, cursor.ID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+//
+// Private functions
+//
+
+func (s *SQLCursorStore) name() string {
+	if s.Name == "" {
+		return "default"
+	}
+	return s.Name
+}
+
+func (s *SQLCursorStore) placeholder(n int) string {
+	if s.Placeholder == nil {
+		return "?"
+	}
+	return s.Placeholder(n)
+}
+
+func (s *SQLCursorStore) table() string {
+	if s.Table == "" {
+		return "stripe_event_cursors"
+	}
+	return s.Table
+}
@@ -0,0 +1,181 @@
+// Package eventsync provides facilities for reconciling events delivered by
+// Stripe with an application's own records.
+//
+// Webhooks are the primary way that Stripe notifies an integration of
+// changes, but deliveries can be missed during an outage or deploy. The
+// Backfiller in this package pages through the /v1/events API starting from a
+// stored cursor, skips events that were already handled (for example by a
+// webhook endpoint sharing the same EventStore), and dispatches the rest to
+// the same Router that webhook handlers use.
+//
+// For environments that can't accept inbound webhooks at all, a Poller runs
+// the same process continuously at a configurable interval, persisting its
+// position through a CursorStore.
+package eventsync
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public types
+//
+
+// Cursor marks the position of the last event that was successfully handled.
+// Events are ordered by their creation time only: the ID identifies the event,
+// but events created within the same second aren't ordered by it.
+type Cursor struct {
+	Created int64  `json:"created"`
+	ID      string `json:"id"`
+}
+
+// CursorStore persists the cursor of a Backfiller or Poller between runs.
+type CursorStore interface {
+	// LoadCursor returns the last saved cursor, or nil if none has been saved
+	// yet.
+	LoadCursor(ctx context.Context) (*Cursor, error)
+
+	// SaveCursor persists the given cursor.
+	SaveCursor(ctx context.Context, cursor *Cursor) error
+}
+
+// EventStore records which events have already been handled so that an
+// event received both through a webhook and through a backfill is only
+// processed once.
+type EventStore interface {
+	// HasProcessed reports whether the event with the given ID has already
+	// been handled.
+	HasProcessed(ctx context.Context, id string) (bool, error)
+
+	// MarkProcessed records that the event with the given ID was handled.
+	MarkProcessed(ctx context.Context, id string) error
+}
+
+// Handler is a function that handles a single event.
+type Handler func(ctx context.Context, event *stripe.Event) error
+
+// Router dispatches events to handlers based on their type. It's meant to be
+// shared between webhook endpoints and Backfillers so that events are handled
+// identically no matter which way they were received.
+//
+// A Router is safe for concurrent use.
+type Router struct {
+	fallback Handler
+	handlers map[string][]Handler
+	mu       sync.RWMutex
+}
+
+// NewRouter returns a new, empty Router.
+func NewRouter() *Router {
+	return &Router{handlers: make(map[string][]Handler)}
+}
+
+// Handle registers a handler for the given event type. The type may end in a
+// `*` wildcard (e.g. `charge.*`) to match a whole family of events, or be
+// just `*` to match all events. Multiple handlers may be registered for the
+// same type, in which case they're invoked in order of registration.
+func (r *Router) Handle(eventType string, h Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers[eventType] = append(r.handlers[eventType], h)
+}
+
+// HandleFallback registers a handler that's invoked for events that don't
+// match any other registered handler.
+func (r *Router) HandleFallback(h Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fallback = h
+}
+
+// Dispatch invokes every handler matching the event's type. Handlers are
+// invoked in order and dispatch stops at the first error, which is returned.
+func (r *Router) Dispatch(ctx context.Context, event *stripe.Event) error {
+	handlers := r.match(event.Type)
+	for _, h := range handlers {
+		if err := h(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Matches reports whether any handler, including the fallback, would be
+// invoked for the given event type.
+func (r *Router) Matches(eventType string) bool {
+	return len(r.match(eventType)) > 0
+}
+
+// MemoryEventStore is an EventStore that keeps processed event IDs in memory.
+// It's mostly useful for testing, or for processes that run a webhook
+// endpoint and a Backfiller side by side. A MemoryEventStore is safe for
+// concurrent use.
+type MemoryEventStore struct {
+	mu        sync.Mutex
+	processed map[string]struct{}
+}
+
+// NewMemoryEventStore returns a new, empty MemoryEventStore.
+func NewMemoryEventStore() *MemoryEventStore {
+	return &MemoryEventStore{processed: make(map[string]struct{})}
+}
+
+// HasProcessed reports whether the event with the given ID has been marked
+// as processed.
+func (s *MemoryEventStore) HasProcessed(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.processed[id]
+	return ok, nil
+}
+
+// MarkProcessed records that the event with the given ID was handled.
+func (s *MemoryEventStore) MarkProcessed(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.processed[id] = struct{}{}
+	return nil
+}
+
+//
+// Private functions
+//
+
+func (r *Router) match(eventType string) []Handler {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matched []Handler
+	matched = append(matched, r.handlers[eventType]...)
+
+	// Wildcard handlers run after exact matches, with the most specific
+	// pattern first. Sort so that the order doesn't depend on map iteration.
+	var patterns []string
+	for pattern := range r.handlers {
+		if pattern != eventType && strings.HasSuffix(pattern, "*") &&
+			strings.HasPrefix(eventType, strings.TrimSuffix(pattern, "*")) {
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		return len(patterns[i]) > len(patterns[j])
+	})
+	for _, pattern := range patterns {
+		matched = append(matched, r.handlers[pattern]...)
+	}
+
+	if len(matched) == 0 && r.fallback != nil {
+		matched = append(matched, r.fallback)
+	}
+
+	return matched
+}
@@ -0,0 +1,260 @@
+package eventsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+type memoryCursorStore struct {
+	cursor *Cursor
+}
+
+func (s *memoryCursorStore) LoadCursor(ctx context.Context) (*Cursor, error) {
+	return s.cursor, nil
+}
+
+func (s *memoryCursorStore) SaveCursor(ctx context.Context, cursor *Cursor) error {
+	s.cursor = cursor
+	return nil
+}
+
+func newTestBackend(handler http.HandlerFunc) (stripe.Backend, *httptest.Server) {
+	testServer := httptest.NewServer(handler)
+
+	backend := stripetest.NewBackend(testServer.URL)
+	return backend, testServer
+}
+
+// fakeEvents serves the list events API from a fixed list of events, which
+// are listed in the given order as the newest first.
+type fakeEvents struct {
+	t        *testing.T
+	events   []*stripe.Event
+	pageSize int
+	queries  []url.Values
+}
+
+func (f *fakeEvents) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	f.queries = append(f.queries, query)
+
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit == 0 || limit > f.pageSize {
+		limit = f.pageSize
+	}
+	gte, _ := strconv.ParseInt(query.Get("created[gte]"), 10, 64)
+	lt, _ := strconv.ParseInt(query.Get("created[lt]"), 10, 64)
+
+	matches := func(e *stripe.Event) bool {
+		return e.Created >= gte && (lt == 0 || e.Created < lt)
+	}
+	var listed []*stripe.Event
+	for _, e := range f.events {
+		if matches(e) {
+			listed = append(listed, e)
+		}
+	}
+
+	// A cursor is found by its place in the full list, like the API finds
+	// cursors that don't match the filters.
+	position := func(id string) int {
+		n := 0
+		for _, e := range f.events {
+			if e.ID == id {
+				return n
+			}
+			if matches(e) {
+				n++
+			}
+		}
+		f.t.Fatalf("unexpected cursor %s", id)
+		return 0
+	}
+
+	// Pages go toward older events from starting_after, and toward newer
+	// ones from ending_before.
+	page := &stripe.EventList{}
+	if id := query.Get("ending_before"); id != "" {
+		end := position(id)
+		start := end - limit
+		if start < 0 {
+			start = 0
+		}
+		page.Data = listed[start:end]
+		page.HasMore = start > 0
+	} else {
+		start := 0
+		if id := query.Get("starting_after"); id != "" {
+			start = position(id) + 1
+		}
+		end := start + limit
+		if end > len(listed) {
+			end = len(listed)
+		}
+		page.Data = listed[start:end]
+		page.HasMore = end < len(listed)
+	}
+	json.NewEncoder(w).Encode(page)
+}
+
+func TestBackfillerRun(t *testing.T) {
+	events := &fakeEvents{t: t, pageSize: 100, events: []*stripe.Event{
+		{ID: "evt_3", Type: "charge.refunded", Created: 300},
+		{ID: "evt_2", Type: "charge.succeeded", Created: 200},
+		{ID: "evt_1", Type: "charge.succeeded", Created: 100},
+		{ID: "evt_0", Type: "charge.succeeded", Created: 50},
+	}}
+	backend, testServer := newTestBackend(events.ServeHTTP)
+	defer testServer.Close()
+
+	store := NewMemoryEventStore()
+	store.MarkProcessed(context.Background(), "evt_1")
+	store.MarkProcessed(context.Background(), "evt_2")
+	cursors := &memoryCursorStore{cursor: &Cursor{Created: 100, ID: "evt_1"}}
+
+	var handled []string
+	router := NewRouter()
+	router.Handle("charge.*", func(ctx context.Context, e *stripe.Event) error {
+		handled = append(handled, e.ID)
+		return nil
+	})
+
+	b := &Backfiller{B: backend, Cursors: cursors, Events: store, Router: router}
+	n, err := b.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"evt_3"}, handled)
+	assert.Equal(t, &Cursor{Created: 300, ID: "evt_3"}, cursors.cursor)
+
+	// The events are listed from the newest one created before the cursor
+	assert.Equal(t, 2, len(events.queries))
+	assert.Equal(t, "100", events.queries[0].Get("created[lt]"))
+	assert.Equal(t, "100", events.queries[1].Get("created[gte]"))
+	assert.Equal(t, "evt_0", events.queries[1].Get("ending_before"))
+
+	processed, err := store.HasProcessed(context.Background(), "evt_3")
+	assert.NoError(t, err)
+	assert.True(t, processed)
+}
+
+func TestBackfillerRun_HandlerError(t *testing.T) {
+	events := &fakeEvents{t: t, pageSize: 100, events: []*stripe.Event{
+		{ID: "evt_2", Type: "charge.succeeded", Created: 200},
+		{ID: "evt_1", Type: "charge.succeeded", Created: 100},
+	}}
+	backend, testServer := newTestBackend(events.ServeHTTP)
+	defer testServer.Close()
+
+	cursors := &memoryCursorStore{}
+	router := NewRouter()
+	router.Handle("charge.succeeded", func(ctx context.Context, e *stripe.Event) error {
+		if e.ID == "evt_2" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	b := &Backfiller{B: backend, Cursors: cursors, Router: router}
+	n, err := b.Run(context.Background())
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, 1, n)
+
+	// The cursor points at the last event that succeeded so that the next run
+	// picks up from the failure.
+	assert.Equal(t, &Cursor{Created: 100, ID: "evt_1"}, cursors.cursor)
+}
+
+func TestBackfillerRun_SameSecond(t *testing.T) {
+	// The events created in the same second aren't listed in the order of
+	// their IDs, and pages hold two events so that listing spans pages.
+	events := &fakeEvents{t: t, pageSize: 2, events: []*stripe.Event{
+		{ID: "evt_3", Type: "charge.succeeded", Created: 300},
+		{ID: "evt_2b", Type: "charge.succeeded", Created: 200},
+		{ID: "evt_2c", Type: "charge.succeeded", Created: 200},
+		{ID: "evt_2a", Type: "charge.succeeded", Created: 200},
+		{ID: "evt_1", Type: "charge.succeeded", Created: 100},
+	}}
+	backend, testServer := newTestBackend(events.ServeHTTP)
+	defer testServer.Close()
+
+	var handled []string
+	router := NewRouter()
+	router.Handle("*", func(ctx context.Context, e *stripe.Event) error {
+		handled = append(handled, e.ID)
+		return nil
+	})
+
+	// A previous run handled the events up to evt_2c, but not evt_2b, which
+	// was created in the same second and listed after it.
+	store := NewMemoryEventStore()
+	for _, id := range []string{"evt_1", "evt_2a", "evt_2c"} {
+		store.MarkProcessed(context.Background(), id)
+	}
+	cursors := &memoryCursorStore{cursor: &Cursor{Created: 200, ID: "evt_2c"}}
+
+	b := &Backfiller{B: backend, Cursors: cursors, Events: store, Router: router}
+	n, err := b.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.ElementsMatch(t, []string{"evt_2b", "evt_3"}, handled)
+	assert.Equal(t, "evt_3", handled[len(handled)-1])
+	assert.Equal(t, &Cursor{Created: 300, ID: "evt_3"}, cursors.cursor)
+
+	// The events are listed once, oldest first from evt_1
+	for _, query := range events.queries {
+		assert.Equal(t, "", query.Get("starting_after"))
+	}
+
+	// Without a cursor or stored events, every event is dispatched
+	events.queries = nil
+	handled = nil
+	b = &Backfiller{B: backend, Router: router}
+	n, err = b.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, []string{"evt_1", "evt_2a", "evt_2c", "evt_2b", "evt_3"}, handled)
+
+	// The three pages are listed newest first to find the oldest one, and
+	// then the two pages after it are listed oldest first.
+	assert.Equal(t, 5, len(events.queries))
+}
+
+func TestRouterDispatch(t *testing.T) {
+	var calls []string
+	record := func(name string) Handler {
+		return func(ctx context.Context, e *stripe.Event) error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+
+	router := NewRouter()
+	router.Handle("*", record("all"))
+	router.Handle("invoice.paid", record("exact"))
+	router.Handle("invoice.*", record("invoice"))
+	router.HandleFallback(record("fallback"))
+
+	err := router.Dispatch(context.Background(), &stripe.Event{Type: "invoice.paid"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"exact", "invoice", "all"}, calls)
+
+	calls = nil
+	router = NewRouter()
+	router.Handle("invoice.*", record("invoice"))
+	router.HandleFallback(record("fallback"))
+
+	err = router.Dispatch(context.Background(), &stripe.Event{Type: "charge.succeeded"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fallback"}, calls)
+	assert.True(t, router.Matches("invoice.created"))
+}
@@ -0,0 +1,122 @@
+package eventsync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public constants
+//
+
+// DefaultPollInterval is the interval at which a Poller lists new events if
+// none is configured.
+const DefaultPollInterval = 30 * time.Second
+
+//
+// Public types
+//
+
+// Poller is a long-running alternative to webhooks for environments that
+// can't accept inbound requests from Stripe. It lists new events at a regular
+// interval and dispatches them to a Router.
+//
+// Delivery is at least once: the cursor only advances after an event's
+// handlers succeed, so an event whose handler fails (or that was being
+// handled when the process stopped) is delivered again on the next poll.
+// Handlers should therefore be idempotent, or an EventStore should be
+// configured.
+type Poller struct {
+	// B is the backend used to list events. Defaults to the global API
+	// backend.
+	B stripe.Backend
+
+	// Cursors persists the position of the last handled event so that polling
+	// resumes where it left off after a restart. See FileCursorStore and
+	// SQLCursorStore, or provide a custom implementation.
+	//
+	// If no cursor has been saved yet, the first poll dispatches every event
+	// that Stripe still retains, which goes back about 30 days. To only
+	// dispatch events created from now on, save a cursor with the current
+	// time first:
+	//
+	//	err := cursors.SaveCursor(ctx, &eventsync.Cursor{Created: time.Now().Unix()})
+	Cursors CursorStore
+
+	// Events optionally records which events have already been handled.
+	Events EventStore
+
+	// Interval is the time to wait between polls. Defaults to
+	// DefaultPollInterval.
+	Interval time.Duration
+
+	// Key is the API key used to list events. Defaults to stripe.GetKey().
+	Key string
+
+	// OnError is invoked with any error that occurs during a poll. Polling
+	// continues after an error unless the Poller's context is done. If nil,
+	// errors are logged through the backend's default logger.
+	OnError func(err error)
+
+	// Router receives new events.
+	Router *Router
+
+	// Types optionally restricts polling to the given event types.
+	Types []string
+}
+
+// Run polls for events until the given context is done, and then returns the
+// context's error. The first poll happens immediately.
+func (p *Poller) Run(ctx context.Context) error {
+	if p.Router == nil {
+		return errors.New("eventsync: Poller requires a Router")
+	}
+	if p.Cursors == nil {
+		return errors.New("eventsync: Poller requires a CursorStore")
+	}
+
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	backfiller := &Backfiller{
+		B:       p.B,
+		Cursors: p.Cursors,
+		Events:  p.Events,
+		Key:     p.Key,
+		Router:  p.Router,
+		Types:   p.Types,
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := backfiller.Run(ctx); err != nil && ctx.Err() == nil {
+			p.handleError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+//
+// Private functions
+//
+
+func (p *Poller) handleError(err error) {
+	if p.OnError != nil {
+		p.OnError(err)
+		return
+	}
+
+	stripe.GetDefaultLeveledLogger().Errorf("eventsync: poll failed: %v", err)
+}
@@ -0,0 +1,234 @@
+package eventsync
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+func TestFileCursorStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eventsync")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := &FileCursorStore{Path: filepath.Join(dir, "cursor.json")}
+
+	cursor, err := store.LoadCursor(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	err = store.SaveCursor(context.Background(), &Cursor{Created: 123, ID: "evt_123"})
+	assert.NoError(t, err)
+
+	cursor, err = store.LoadCursor(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &Cursor{Created: 123, ID: "evt_123"}, cursor)
+}
+
+func TestSQLCursorStore(t *testing.T) {
+	db := &fakeDB{cursors: make(map[string]Cursor)}
+	store := &SQLCursorStore{DB: sql.OpenDB(db)}
+	defer store.DB.Close()
+
+	cursor, err := store.LoadCursor(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	// The first save inserts the row, and later ones update it
+	err = store.SaveCursor(context.Background(), &Cursor{Created: 123, ID: "evt_123"})
+	assert.NoError(t, err)
+	assert.Equal(t, Cursor{Created: 123, ID: "evt_123"}, db.cursors["default"])
+	assert.Equal(t, []string{
+		"UPDATE stripe_event_cursors SET created = ?, event_id = ? WHERE name = ?",
+		"INSERT INTO stripe_event_cursors (name, created, event_id) VALUES (?, ?, ?)",
+	}, db.execs)
+
+	db.execs = nil
+	err = store.SaveCursor(context.Background(), &Cursor{Created: 456, ID: "evt_456"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(db.execs))
+
+	cursor, err = store.LoadCursor(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &Cursor{Created: 456, ID: "evt_456"}, cursor)
+	assert.Equal(t, 1, len(db.cursors))
+
+	// Errors of the database are returned as is
+	db.err = errors.New("connection reset")
+	_, err = store.LoadCursor(context.Background())
+	assert.Equal(t, db.err, err)
+	err = store.SaveCursor(context.Background(), &Cursor{Created: 789, ID: "evt_789"})
+	assert.Equal(t, db.err, err)
+	assert.Equal(t, Cursor{Created: 456, ID: "evt_456"}, db.cursors["default"])
+}
+
+func TestSQLCursorStore_Options(t *testing.T) {
+	db := &fakeDB{cursors: make(map[string]Cursor)}
+	store := &SQLCursorStore{
+		DB:          sql.OpenDB(db),
+		Name:        "invoices",
+		Placeholder: func(n int) string { return fmt.Sprintf("$%d", n) },
+		Table:       "cursors",
+	}
+	defer store.DB.Close()
+
+	err := store.SaveCursor(context.Background(), &Cursor{Created: 123, ID: "evt_123"})
+	assert.NoError(t, err)
+	assert.Equal(t, Cursor{Created: 123, ID: "evt_123"}, db.cursors["invoices"])
+	assert.Equal(t, "UPDATE cursors SET created = $1, event_id = $2 WHERE name = $3", db.execs[0])
+}
+
+func TestPollerRun(t *testing.T) {
+	var types []string
+	backend, testServer := newTestBackend(func(w http.ResponseWriter, r *http.Request) {
+		types = r.URL.Query()["types[0]"]
+		w.Write([]byte(`{"object":"list","has_more":false,"data":[
+			{"id":"evt_1","type":"invoice.paid","created":100}
+		]}`))
+	})
+	defer testServer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var handled []string
+	router := NewRouter()
+	router.Handle("invoice.paid", func(ctx context.Context, e *stripe.Event) error {
+		handled = append(handled, e.ID)
+		cancel()
+		return nil
+	})
+
+	cursors := &memoryCursorStore{}
+	p := &Poller{
+		B:        backend,
+		Cursors:  cursors,
+		Events:   NewMemoryEventStore(),
+		Interval: time.Millisecond,
+		Router:   router,
+		Types:    []string{"invoice.paid"},
+	}
+	err := p.Run(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []string{"evt_1"}, handled)
+	assert.Equal(t, []string{"invoice.paid"}, types)
+	assert.Equal(t, &Cursor{Created: 100, ID: "evt_1"}, cursors.cursor)
+}
+
+// fakeDB is a database/sql driver keeping the rows of a cursors table in
+// memory. It only understands the queries of SQLCursorStore.
+type fakeDB struct {
+	cursors map[string]Cursor
+	err     error
+	execs   []string
+}
+
+func (db *fakeDB) Connect(ctx context.Context) (driver.Conn, error) {
+	return &fakeConn{db: db}, nil
+}
+
+func (db *fakeDB) Driver() driver.Driver {
+	return nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	if c.db.err != nil {
+		return nil, c.db.err
+	}
+	return c, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Commit() error {
+	return nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	if c.db.err != nil {
+		return nil, c.db.err
+	}
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeConn) Rollback() error {
+	return nil
+}
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.execs = append(s.db.execs, s.query)
+	switch {
+	case strings.HasPrefix(s.query, "UPDATE "):
+		name := args[2].(string)
+		if _, ok := s.db.cursors[name]; !ok {
+			return driver.RowsAffected(0), nil
+		}
+		s.db.cursors[name] = Cursor{Created: args[0].(int64), ID: args[1].(string)}
+		return driver.RowsAffected(1), nil
+	case strings.HasPrefix(s.query, "INSERT "):
+		s.db.cursors[args[0].(string)] = Cursor{Created: args[1].(int64), ID: args[2].(string)}
+		return driver.RowsAffected(1), nil
+	}
+	return nil, fmt.Errorf("unexpected query %q", s.query)
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	rows := &fakeRows{}
+	if cursor, ok := s.db.cursors[args[0].(string)]; ok {
+		rows.values = [][]driver.Value{{cursor.Created, cursor.ID}}
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"created", "event_id"}
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
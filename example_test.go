@@ -11,7 +11,7 @@ import (
 )
 
 func ExampleCharge_new() {
-	stripe.Key = "sk_key"
+	stripe.SetKey("sk_key")
 
 	params := &stripe.ChargeParams{
 		Amount:   stripe.Int64(1000),
@@ -30,7 +30,7 @@ func ExampleCharge_new() {
 }
 
 func ExampleCharge_get() {
-	stripe.Key = "sk_key"
+	stripe.SetKey("sk_key")
 
 	params := &stripe.ChargeParams{}
 	params.AddExpand("customer")
@@ -51,7 +51,7 @@ func ExampleCharge_get() {
 }
 
 func ExampleInvoice_update() {
-	stripe.Key = "sk_key"
+	stripe.SetKey("sk_key")
 
 	params := &stripe.InvoiceParams{
 		Description: stripe.String("updated description"),
@@ -67,7 +67,7 @@ func ExampleInvoice_update() {
 }
 
 func ExampleCustomer_delete() {
-	stripe.Key = "sk_key"
+	stripe.SetKey("sk_key")
 
 	customerDel, err := customer.Del("cus_example_id", nil)
 
@@ -81,7 +81,7 @@ func ExampleCustomer_delete() {
 }
 
 func ExamplePlan_list() {
-	stripe.Key = "sk_key"
+	stripe.SetKey("sk_key")
 
 	params := &stripe.PlanListParams{}
 	params.Filters.AddFilter("limit", "", "3")
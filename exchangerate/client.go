@@ -0,0 +1,77 @@
+//
+//
+// File generated from our OpenAPI spec
+//
+//
+
+// Package exchangerate provides the /exchange_rates APIs
+package exchangerate
+
+import (
+	"net/http"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+// Client is used to invoke /exchange_rates APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Get returns the details of an exchange rate.
+func Get(id string, params *stripe.ExchangeRateParams) (*stripe.ExchangeRate, error) {
+	return getC().Get(id, params)
+}
+
+// Get returns the details of an exchange rate.
+func (c Client) Get(id string, params *stripe.ExchangeRateParams) (*stripe.ExchangeRate, error) {
+	path := stripe.FormatURLPath("/v1/exchange_rates/%s", id)
+	exchangerate := &stripe.ExchangeRate{}
+	err := c.B.Call(http.MethodGet, path, c.Key, params, exchangerate)
+	return exchangerate, err
+}
+
+// List returns a list of exchange rates.
+func List(params *stripe.ExchangeRateListParams) *Iter {
+	return getC().List(params)
+}
+
+// List returns a list of exchange rates.
+func (c Client) List(listParams *stripe.ExchangeRateListParams) *Iter {
+	return &Iter{
+		Iter: stripe.GetIter(listParams, func(p *stripe.Params, b *form.Values) ([]interface{}, stripe.ListContainer, error) {
+			list := &stripe.ExchangeRateList{}
+			err := c.B.CallRaw(http.MethodGet, "/v1/exchange_rates", c.Key, b, p, list)
+
+			ret := make([]interface{}, len(list.Data))
+			for i, v := range list.Data {
+				ret[i] = v
+			}
+
+			return ret, list, err
+		}),
+	}
+}
+
+// Iter is an iterator for exchange rates.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ExchangeRate returns the exchange rate which the iterator is currently pointing to.
+func (i *Iter) ExchangeRate() *stripe.ExchangeRate {
+	return i.Current().(*stripe.ExchangeRate)
+}
+
+// ExchangeRateList returns the current list object which the iterator is
+// currently using. List objects will change as new API calls are made to
+// continue pagination.
+func (i *Iter) ExchangeRateList() *stripe.ExchangeRateList {
+	return i.List().(*stripe.ExchangeRateList)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
@@ -0,0 +1,25 @@
+package exchangerate
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	_ "github.com/stripe/stripe-go/v72/testing"
+)
+
+func TestExchangeRateGet(t *testing.T) {
+	rate, err := Get("usd", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, rate)
+}
+
+func TestExchangeRateList(t *testing.T) {
+	i := List(&stripe.ExchangeRateListParams{})
+
+	// Verify that we can get at least one rate
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.ExchangeRate())
+	assert.NotNil(t, i.ExchangeRateList())
+}
@@ -0,0 +1,264 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// When a field that can be expanded (like Charge.Customer) isn't expanded in
+// a response, it's decoded into a struct with only its ID set. The Fetch
+// methods in this file retrieve the full object on demand and decode it into
+// that same struct, so code paths that only occasionally need the details
+// don't have to expand everything up front. Once fetched, the object is
+// cached in place and further calls to Fetch don't make a request.
+//
+// For example:
+//
+//	if err := charge.Customer.Fetch(ctx, backend, key); err != nil {
+//		return err
+//	}
+//	fmt.Println(charge.Customer.Email)
+
+//
+// Public functions
+//
+
+// Fetch retrieves the full Account if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Account) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "account", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Account holds the full object rather than
+// just its ID.
+func (v *Account) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full BalanceTransaction if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *BalanceTransaction) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "balance_transaction", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the BalanceTransaction holds the full object rather than
+// just its ID.
+func (v *BalanceTransaction) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Charge if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Charge) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "charge", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Charge holds the full object rather than
+// just its ID.
+func (v *Charge) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Customer if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Customer) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "customer", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Customer holds the full object rather than
+// just its ID.
+func (v *Customer) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Invoice if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Invoice) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "invoice", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Invoice holds the full object rather than
+// just its ID.
+func (v *Invoice) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full PaymentIntent if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *PaymentIntent) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "payment_intent", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the PaymentIntent holds the full object rather than
+// just its ID.
+func (v *PaymentIntent) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full PaymentMethod if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *PaymentMethod) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "payment_method", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the PaymentMethod holds the full object rather than
+// just its ID.
+func (v *PaymentMethod) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Payout if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Payout) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "payout", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Payout holds the full object rather than
+// just its ID.
+func (v *Payout) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Price if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Price) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "price", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Price holds the full object rather than
+// just its ID.
+func (v *Price) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Product if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Product) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "product", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Product holds the full object rather than
+// just its ID.
+func (v *Product) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Refund if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Refund) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "refund", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Refund holds the full object rather than
+// just its ID.
+func (v *Refund) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full SetupIntent if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *SetupIntent) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "setup_intent", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the SetupIntent holds the full object rather than
+// just its ID.
+func (v *SetupIntent) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Subscription if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Subscription) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "subscription", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Subscription holds the full object rather than
+// just its ID.
+func (v *Subscription) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+// Fetch retrieves the full Transfer if only its ID is known, which is the case
+// when it was included in another resource without being expanded.
+func (v *Transfer) Fetch(ctx context.Context, b Backend, key string) error {
+	if v == nil {
+		return errNilExpandable
+	}
+	return fetchExpandable(ctx, b, key, "transfer", v.ID, v.Object, v)
+}
+
+// IsExpanded reports whether the Transfer holds the full object rather than
+// just its ID.
+func (v *Transfer) IsExpanded() bool {
+	return v != nil && v.Object != ""
+}
+
+//
+// Private variables
+//
+
+var errNilExpandable = errors.New("cannot fetch a nil object")
+
+//
+// Private functions
+//
+
+// fetchExpandable retrieves the object with the given ID into v, unless the
+// object is already expanded as indicated by its `object` field being set.
+func fetchExpandable(ctx context.Context, b Backend, key, object, id, currentObject string, v LastResponseSetter) error {
+	if currentObject != "" {
+		return nil
+	}
+	if id == "" {
+		return errors.New("cannot fetch a " + object + " without an ID")
+	}
+
+	for _, r := range retrievables {
+		if r.object == object {
+			params := &Params{Context: ctx}
+			return b.Call(http.MethodGet, FormatURLPath(r.path, id), key, params, v)
+		}
+	}
+	return errors.New("cannot fetch objects of type " + object)
+}
@@ -0,0 +1,74 @@
+package stripe
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestCustomerFetch(t *testing.T) {
+	requests := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/v1/customers/cus_123", r.URL.Path)
+		w.Write([]byte(`{"id":"cus_123","object":"customer","email":"jenny@example.com"}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(APIBackend, &BackendConfig{
+		LeveledLogger: nullLeveledLogger,
+		URL:           String(testServer.URL),
+	})
+
+	var charge Charge
+	err := json.Unmarshal([]byte(`{"id":"ch_123","object":"charge","customer":"cus_123"}`), &charge)
+	assert.NoError(t, err)
+	assert.False(t, charge.Customer.IsExpanded())
+
+	err = charge.Customer.Fetch(context.Background(), backend, "sk_test_123")
+	assert.NoError(t, err)
+	assert.True(t, charge.Customer.IsExpanded())
+	assert.Equal(t, "jenny@example.com", charge.Customer.Email)
+
+	// The fetched customer is cached
+	err = charge.Customer.Fetch(context.Background(), backend, "sk_test_123")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestFetch_Nil(t *testing.T) {
+	var customer *Customer
+	assert.False(t, customer.IsExpanded())
+	assert.Equal(t, errNilExpandable, customer.Fetch(context.Background(), nil, ""))
+}
+
+func TestInvoiceFetchSubscription(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/subscriptions/sub_123", r.URL.Path)
+		w.Write([]byte(`{"id":"sub_123","object":"subscription","status":"active"}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(APIBackend, &BackendConfig{
+		LeveledLogger: nullLeveledLogger,
+		URL:           String(testServer.URL),
+	})
+
+	var invoice Invoice
+	err := json.Unmarshal([]byte(`{"id":"in_123","object":"invoice","subscription":"sub_123"}`), &invoice)
+	assert.NoError(t, err)
+
+	sub, err := invoice.FetchSubscription(context.Background(), backend, "sk_test_123")
+	assert.NoError(t, err)
+	assert.Equal(t, SubscriptionStatusActive, sub.Status)
+	assert.Equal(t, invoice.Subscription, sub)
+
+	// Links that aren't set return nil without making a request
+	charge, err := invoice.FetchCharge(context.Background(), backend, "sk_test_123")
+	assert.NoError(t, err)
+	assert.Nil(t, charge)
+}
@@ -0,0 +1,80 @@
+// Package externalaccount provides the /accounts/{account}/external_accounts
+// APIs for listing both the bank accounts and cards of an account.
+package externalaccount
+
+import (
+	"fmt"
+	"net/http"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+// Client is used to invoke /accounts/{account}/external_accounts APIs.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// List returns a list of the external accounts of an account, which may be
+// either bank accounts or cards.
+func List(params *stripe.ExternalAccountListParams) *Iter {
+	return getC().List(params)
+}
+
+// List returns a list of the external accounts of an account, which may be
+// either bank accounts or cards.
+func (c Client) List(listParams *stripe.ExternalAccountListParams) *Iter {
+	var path string
+	var outerErr error
+
+	if listParams == nil {
+		outerErr = fmt.Errorf("params should not be nil")
+	} else if listParams.Account == nil {
+		outerErr = fmt.Errorf("Invalid external account params: Account needs to be set")
+	} else {
+		path = stripe.FormatURLPath("/v1/accounts/%s/external_accounts",
+			stripe.StringValue(listParams.Account))
+	}
+	return &Iter{
+		Iter: stripe.GetIter(listParams, func(p *stripe.Params, b *form.Values) ([]interface{}, stripe.ListContainer, error) {
+			list := &stripe.ExternalAccountList{}
+
+			if outerErr != nil {
+				return nil, list, outerErr
+			}
+
+			err := c.B.CallRaw(http.MethodGet, path, c.Key, b, p, list)
+
+			ret := make([]interface{}, len(list.Data))
+			for i, v := range list.Data {
+				ret[i] = v
+			}
+
+			return ret, list, err
+		}),
+	}
+}
+
+// Iter is an iterator for external accounts.
+type Iter struct {
+	*stripe.Iter
+}
+
+// ExternalAccount returns the external account which the iterator is
+// currently pointing to. Its BankAccount or Card is set depending on its
+// Type.
+func (i *Iter) ExternalAccount() *stripe.ExternalAccount {
+	return i.Current().(*stripe.ExternalAccount)
+}
+
+// ExternalAccountList returns the current list object which the iterator is
+// currently using. List objects will change as new API calls are made to
+// continue pagination.
+func (i *Iter) ExternalAccountList() *stripe.ExternalAccountList {
+	return i.List().(*stripe.ExternalAccountList)
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
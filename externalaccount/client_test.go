@@ -0,0 +1,49 @@
+package externalaccount
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestExternalAccountList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/accounts/acct_123/external_accounts", r.URL.Path)
+		assert.Equal(t, "", r.URL.Query().Get("object"))
+		w.Write([]byte(`{
+			"object": "list",
+			"has_more": false,
+			"data": [
+				{"id": "ba_123", "object": "bank_account", "last4": "6789"},
+				{"id": "card_123", "object": "card", "last4": "4242"}
+			]
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	i := c.List(&stripe.ExternalAccountListParams{Account: stripe.String("acct_123")})
+
+	assert.True(t, i.Next())
+	assert.Equal(t, stripe.ExternalAccountTypeBankAccount, i.ExternalAccount().Type)
+	assert.Equal(t, "6789", i.ExternalAccount().BankAccount.Last4)
+	assert.NotNil(t, i.ExternalAccountList())
+
+	assert.True(t, i.Next())
+	assert.Equal(t, stripe.ExternalAccountTypeCard, i.ExternalAccount().Type)
+	assert.Equal(t, "4242", i.ExternalAccount().Card.Last4)
+
+	assert.False(t, i.Next())
+	assert.Nil(t, i.Err())
+}
+
+func TestExternalAccountList_NoAccount(t *testing.T) {
+	i := List(&stripe.ExternalAccountListParams{})
+	assert.False(t, i.Next())
+	assert.Error(t, i.Err())
+}
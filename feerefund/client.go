@@ -133,5 +133,5 @@ func (i *Iter) FeeRefundList() *stripe.FeeRefundList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
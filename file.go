@@ -68,6 +68,10 @@ type FileParams struct {
 	Filename     *string
 	Purpose      *string
 	FileLinkData *FileFileLinkDataParams
+
+	// Progress, if set, is called as the file is uploaded with the number of
+	// bytes sent so far and the total size of the request.
+	Progress ProgressFunc
 }
 
 // This is an object representing a file hosted on Stripe's servers. The
@@ -8,8 +8,11 @@
 package file
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 
 	stripe "github.com/stripe/stripe-go/v72"
 	"github.com/stripe/stripe-go/v72/form"
@@ -34,13 +37,25 @@ func (c Client) New(params *stripe.FileParams) (*stripe.File, error) {
 		)
 	}
 
+	// Stream files that can be rewound for retries instead of copying them
+	// into memory first. Other files are streamed too, but can't be retried.
+	if params.FileReader != nil && params.Filename != nil {
+		if _, ok := params.FileReader.(io.ReadSeeker); ok {
+			if b, ok := c.B.(multipartReaderBackend); ok {
+				return c.newFromReader(b, params)
+			}
+		} else if b, ok := c.B.(multipartStreamBackend); ok {
+			return c.newFromStream(b, params)
+		}
+	}
+
 	bodyBuffer, boundary, err := params.GetBody()
 	if err != nil {
 		return nil, err
 	}
 
 	file := &stripe.File{}
-	err = c.B.CallMultipart(http.MethodPost, "/v1/files", c.Key, boundary, bodyBuffer, &params.Params, file)
+	err = c.B.CallMultipart(http.MethodPost, "/v1/files", c.Key, boundary, bodyBuffer, uploadParams(params), file)
 
 	return file, err
 }
@@ -58,6 +73,52 @@ func (c Client) Get(id string, params *stripe.FileParams) (*stripe.File, error)
 	return file, err
 }
 
+// Download streams the contents of a file into w. This works for any file the
+// key has access to, including the results of report runs, identity documents
+// and dispute evidence. It returns the file's details, which include its
+// filename and type.
+func Download(id string, w io.Writer, params *stripe.FileParams) (*stripe.File, error) {
+	return getC().Download(id, w, params)
+}
+
+// Download streams the contents of a file into w. This works for any file the
+// key has access to, including the results of report runs, identity documents
+// and dispute evidence. It returns the file's details, which include its
+// filename and type.
+func (c Client) Download(id string, w io.Writer, params *stripe.FileParams) (*stripe.File, error) {
+	// FileParams can't be form encoded because of its FileReader, so only its
+	// common parameters are sent.
+	commonParams := &stripe.Params{}
+	if params != nil {
+		commonParams.Context = params.Context
+		commonParams.Headers = params.Headers
+		commonParams.StripeAccount = params.StripeAccount
+	}
+
+	path := stripe.FormatURLPath("/v1/files/%s", id)
+	file := &stripe.File{}
+	if err := c.B.Call(http.MethodGet, path, c.Key, commonParams, file); err != nil {
+		return nil, err
+	}
+	if file.URL == "" {
+		return file, fmt.Errorf("file %s has no URL to download its contents from", id)
+	}
+
+	// The URL is absolute, but requests go through the backend so that they
+	// pick up its authentication, retries and logging.
+	u, err := url.Parse(file.URL)
+	if err != nil {
+		return file, err
+	}
+	path = u.EscapedPath()
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+
+	_, err = stripe.CallStreamingTo(c.B, http.MethodGet, path, c.Key, commonParams, w, nil)
+	return file, err
+}
+
 // List returns a list of files.
 func List(params *stripe.FileListParams) *Iter {
 	return getC().List(params)
@@ -97,6 +158,56 @@ func (i *Iter) FileList() *stripe.FileList {
 	return i.List().(*stripe.FileList)
 }
 
+// multipartReaderBackend is implemented by backends that can stream multipart
+// uploads from a reader, like stripe.BackendImplementation.
+type multipartReaderBackend interface {
+	CallMultipartReader(method, path, key, boundary string, body io.ReadSeeker, size int64, params *stripe.Params, v stripe.LastResponseSetter) error
+}
+
+// multipartStreamBackend is implemented by backends that can stream multipart
+// uploads from a reader that can only be read once, like
+// stripe.BackendImplementation.
+type multipartStreamBackend interface {
+	CallMultipartStream(method, path, key, boundary string, body io.Reader, size int64, params *stripe.Params, v stripe.LastResponseSetter) error
+}
+
+func (c Client) newFromStream(b multipartStreamBackend, params *stripe.FileParams) (*stripe.File, error) {
+	body, size, boundary, err := params.GetBodyStream()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	file := &stripe.File{}
+	err = b.CallMultipartStream(http.MethodPost, "/v1/files", c.Key, boundary, body, size, uploadParams(params), file)
+	return file, err
+}
+
+func (c Client) newFromReader(b multipartReaderBackend, params *stripe.FileParams) (*stripe.File, error) {
+	body, size, boundary, err := params.GetBodyReader()
+	if err != nil {
+		return nil, err
+	}
+
+	file := &stripe.File{}
+	err = b.CallMultipartReader(http.MethodPost, "/v1/files", c.Key, boundary, body, size, uploadParams(params), file)
+	return file, err
+}
+
+// uploadParams returns the common parameters to send along with an upload,
+// arranging for its progress to be reported if requested.
+func uploadParams(params *stripe.FileParams) *stripe.Params {
+	commonParams := params.Params
+	if params.Progress != nil {
+		ctx := commonParams.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		commonParams.Context = stripe.ContextWithUploadProgress(ctx, params.Progress)
+	}
+	return &commonParams
+}
+
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.UploadsBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.UploadsBackend), stripe.GetKey()}
 }
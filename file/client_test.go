@@ -12,11 +12,17 @@ package file
 //
 
 import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
 	_ "github.com/stripe/stripe-go/v72/testing"
 )
 
@@ -65,3 +71,124 @@ func TestFileNew(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, file)
 }
+
+func TestFileDownload(t *testing.T) {
+	var serverURL string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "acct_123", r.Header.Get("Stripe-Account"))
+
+		switch r.URL.Path {
+		case "/v1/files/file_123":
+			w.Write([]byte(`{"id":"file_123","object":"file","type":"csv","url":"` +
+				serverURL + `/v1/files/file_123/contents"}`))
+		case "/v1/files/file_123/contents":
+			w.Write([]byte("a,b\n1,2\n"))
+		default:
+			assert.Fail(t, "unexpected request to "+r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+	serverURL = ts.URL
+
+	backend := stripetest.NewBackend(ts.URL)
+	c := Client{B: backend, Key: "sk_test_123"}
+
+	params := &stripe.FileParams{}
+	params.SetStripeAccount("acct_123")
+
+	var buf bytes.Buffer
+	file, err := c.Download("file_123", &buf, params)
+	assert.NoError(t, err)
+	assert.Equal(t, "csv", file.Type)
+	assert.Equal(t, "a,b\n1,2\n", buf.String())
+}
+
+func TestFileNewProgress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"file_123","object":"file"}`))
+	}))
+	defer ts.Close()
+
+	backend := stripetest.NewBackend(ts.URL)
+	c := Client{B: backend, Key: "sk_test_123"}
+
+	var transferred, total int64
+	file, err := c.New(&stripe.FileParams{
+		Purpose:    stripe.String(string(stripe.FilePurposeDisputeEvidence)),
+		FileReader: strings.NewReader(strings.Repeat("x", 1000)),
+		Filename:   stripe.String("evidence.txt"),
+		Progress: func(t, n int64) {
+			transferred, total = t, n
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "file_123", file.ID)
+	assert.True(t, total > 1000)
+	assert.Equal(t, total, transferred)
+}
+
+func TestFileNewStream(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, []string{"chunked"}, r.TransferEncoding)
+
+		file, header, err := r.FormFile("file")
+		assert.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "evidence.txt", header.Filename)
+
+		w.Write([]byte(`{"id":"file_123","object":"file"}`))
+	}))
+	defer ts.Close()
+
+	backend := stripetest.NewBackend(ts.URL)
+	c := Client{B: backend, Key: "sk_test_123"}
+
+	var transferred, total int64
+	file, err := c.New(&stripe.FileParams{
+		Purpose: stripe.String(string(stripe.FilePurposeDisputeEvidence)),
+		// The reader can't be seeked, so it's streamed without retries, and
+		// its size isn't known
+		FileReader: ioutil.NopCloser(bytes.NewBufferString(strings.Repeat("x", 1000))),
+		Filename:   stripe.String("evidence.txt"),
+		Progress: func(t, n int64) {
+			transferred, total = t, n
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "file_123", file.ID)
+	assert.Equal(t, int64(-1), total)
+	assert.True(t, transferred > 1000)
+}
+
+func TestFileNewStream_ContentLength(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.TransferEncoding)
+		assert.True(t, r.ContentLength > 1000)
+
+		file, header, err := r.FormFile("file")
+		assert.NoError(t, err)
+		defer file.Close()
+		assert.Equal(t, "evidence.txt", header.Filename)
+
+		w.Write([]byte(`{"id":"file_123","object":"file"}`))
+	}))
+	defer ts.Close()
+
+	backend := stripetest.NewBackend(ts.URL)
+	c := Client{B: backend, Key: "sk_test_123"}
+
+	var transferred, total int64
+	file, err := c.New(&stripe.FileParams{
+		Purpose: stripe.String(string(stripe.FilePurposeDisputeEvidence)),
+		// A bytes.Buffer can't be seeked, but it reports its size
+		FileReader: bytes.NewBufferString(strings.Repeat("x", 1000)),
+		Filename:   stripe.String("evidence.txt"),
+		Progress: func(t, n int64) {
+			transferred, total = t, n
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "file_123", file.ID)
+	assert.True(t, total > 1000)
+	assert.Equal(t, total, transferred)
+}
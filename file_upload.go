@@ -0,0 +1,247 @@
+package stripe
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"path/filepath"
+
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+//
+// Public functions
+//
+
+// GetBodyReader is like GetBody, but returns a multipart form payload that
+// reads the file's contents from FileReader as it's sent instead of copying
+// them into memory, along with the payload's size in bytes. FileReader must
+// be an io.ReadSeeker, like an *os.File, so that the upload can be restarted
+// from the beginning if it needs to be retried. Its contents are read from
+// its current position.
+func (f *FileParams) GetBodyReader() (io.ReadSeeker, int64, string, error) {
+	file, ok := f.FileReader.(io.ReadSeeker)
+	if !ok || f.Filename == nil {
+		return nil, 0, "", errors.New("FileReader must be an io.ReadSeeker and Filename must be set")
+	}
+
+	fileStart, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	fileEnd, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if _, err := file.Seek(fileStart, io.SeekStart); err != nil {
+		return nil, 0, "", err
+	}
+
+	// The multipart writer is used to produce everything that comes before
+	// and after the file's contents, which are spliced in when the body is
+	// read.
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+
+	if f.Purpose != nil {
+		if err := writer.WriteField("purpose", StringValue(f.Purpose)); err != nil {
+			return nil, 0, "", err
+		}
+	}
+	if _, err := writer.CreateFormFile("file", filepath.Base(StringValue(f.Filename))); err != nil {
+		return nil, 0, "", err
+	}
+	header := append([]byte(nil), buf.Bytes()...)
+	buf.Reset()
+
+	if f.FileLinkData != nil {
+		values := &form.Values{}
+		form.AppendToPrefixed(values, f.FileLinkData, []string{"file_link_data"})
+
+		params, err := url.ParseQuery(values.Encode())
+		if err != nil {
+			return nil, 0, "", err
+		}
+		for key, values := range params {
+			if err := writer.WriteField(key, values[0]); err != nil {
+				return nil, 0, "", err
+			}
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, 0, "", err
+	}
+
+	body := &multipartBody{
+		header:    header,
+		file:      file,
+		fileStart: fileStart,
+		fileSize:  fileEnd - fileStart,
+		footer:    buf.Bytes(),
+	}
+	return body, body.size(), writer.Boundary(), nil
+}
+
+// GetBodyStream is like GetBodyReader, but works with a FileReader that
+// can't be seeked, like the body of a response being proxied to Stripe. The
+// payload is produced as it's read, so it can only be read once, and uploads
+// made from it can't be retried. Closing the payload stops reading from
+// FileReader.
+//
+// The payload's size is only known if FileReader reports how many bytes it
+// has left with a Len method, like a *bytes.Buffer. Otherwise, it's -1.
+func (f *FileParams) GetBodyStream() (io.ReadCloser, int64, string, error) {
+	if f.FileReader == nil || f.Filename == nil {
+		return nil, 0, "", errors.New("FileReader and Filename must be set")
+	}
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	// The size must be computed before FileReader starts being read
+	size, err := f.bodyStreamSize(writer.Boundary())
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	go func() {
+		pw.CloseWithError(f.writeBodyStream(writer))
+	}()
+	return pr, size, writer.Boundary(), nil
+}
+
+//
+// Private types
+//
+
+// multipartBody is an io.ReadSeeker over a multipart form payload made of a
+// header, the contents of a file and a footer, where the file is only read as
+// needed.
+type multipartBody struct {
+	header    []byte
+	file      io.ReadSeeker
+	fileStart int64
+	fileSize  int64
+	footer    []byte
+	pos       int64
+}
+
+func (b *multipartBody) Read(p []byte) (int, error) {
+	headerEnd := int64(len(b.header))
+	fileEnd := headerEnd + b.fileSize
+
+	switch {
+	case b.pos < headerEnd:
+		n := copy(p, b.header[b.pos:])
+		b.pos += int64(n)
+		return n, nil
+
+	case b.pos < fileEnd:
+		if _, err := b.file.Seek(b.fileStart+b.pos-headerEnd, io.SeekStart); err != nil {
+			return 0, err
+		}
+		if remaining := fileEnd - b.pos; int64(len(p)) > remaining {
+			p = p[:remaining]
+		}
+		n, err := b.file.Read(p)
+		b.pos += int64(n)
+		if err == io.EOF {
+			if n == 0 {
+				return 0, fmt.Errorf("file ended after %v of %v bytes", b.pos-headerEnd, b.fileSize)
+			}
+			err = nil
+		}
+		return n, err
+
+	case b.pos < b.size():
+		n := copy(p, b.footer[b.pos-fileEnd:])
+		b.pos += int64(n)
+		return n, nil
+	}
+
+	return 0, io.EOF
+}
+
+func (b *multipartBody) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = b.pos + offset
+	case io.SeekEnd:
+		pos = b.size() + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if pos < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	b.pos = pos
+	return pos, nil
+}
+
+// bodyStreamSize returns the size of the payload that GetBodyStream produces
+// with the given boundary, or -1 if FileReader's size isn't known.
+func (f *FileParams) bodyStreamSize(boundary string) (int64, error) {
+	sized, ok := f.FileReader.(interface{ Len() int })
+	if !ok {
+		return -1, nil
+	}
+
+	// Everything but the file's contents is written to find out its size
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return 0, err
+	}
+	empty := *f
+	empty.FileReader = &bytes.Buffer{}
+	if err := empty.writeBodyStream(writer); err != nil {
+		return 0, err
+	}
+	return int64(buf.Len()) + int64(sized.Len()), nil
+}
+
+// writeBodyStream writes the multipart form payload of GetBodyStream, in
+// the same order as GetBody.
+func (f *FileParams) writeBodyStream(writer *multipart.Writer) error {
+	if f.Purpose != nil {
+		if err := writer.WriteField("purpose", StringValue(f.Purpose)); err != nil {
+			return err
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", filepath.Base(StringValue(f.Filename)))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, f.FileReader); err != nil {
+		return err
+	}
+
+	if f.FileLinkData != nil {
+		values := &form.Values{}
+		form.AppendToPrefixed(values, f.FileLinkData, []string{"file_link_data"})
+
+		params, err := url.ParseQuery(values.Encode())
+		if err != nil {
+			return err
+		}
+		for key, values := range params {
+			if err := writer.WriteField(key, values[0]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return writer.Close()
+}
+
+func (b *multipartBody) size() int64 {
+	return int64(len(b.header)) + b.fileSize + int64(len(b.footer))
+}
@@ -0,0 +1,228 @@
+package stripe
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestFileParams_GetBodyReader(t *testing.T) {
+	f, err := os.Open("file/test_data.pdf")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	contents, err := ioutil.ReadAll(f)
+	assert.NoError(t, err)
+	_, err = f.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+
+	p := &FileParams{
+		FileReader: f,
+		Filename:   String(f.Name()),
+		Purpose:    String(string(FilePurposeDisputeEvidence)),
+		FileLinkData: &FileFileLinkDataParams{
+			Create: Bool(true),
+		},
+	}
+
+	body, size, boundary, err := p.GetBodyReader()
+	assert.NoError(t, err)
+
+	// The body can be read more than once by seeking back to its start,
+	// producing the same payload each time
+	for i := 0; i < 2; i++ {
+		_, err = body.Seek(0, io.SeekStart)
+		assert.NoError(t, err)
+
+		data, err := ioutil.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, size, int64(len(data)))
+
+		reader := multipart.NewReader(strings.NewReader(string(data)), boundary)
+		parts := map[string]string{}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			value, err := ioutil.ReadAll(part)
+			assert.NoError(t, err)
+			parts[part.FormName()] = string(value)
+		}
+
+		assert.Equal(t, string(FilePurposeDisputeEvidence), parts["purpose"])
+		assert.Equal(t, string(contents), parts["file"])
+		assert.Equal(t, "true", parts["file_link_data[create]"])
+	}
+}
+
+func TestFileParams_GetBodyReaderNotSeekable(t *testing.T) {
+	p := &FileParams{
+		FileReader: ioutil.NopCloser(strings.NewReader("x")),
+		Filename:   String("x.txt"),
+	}
+	_, _, _, err := p.GetBodyReader()
+	assert.Error(t, err)
+}
+
+func TestCallMultipartReader_Retry(t *testing.T) {
+	var bodies []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, params["boundary"])
+
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) == 1 {
+			w.Header().Set("Stripe-Should-Retry", "true")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error":{"message":"try again"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"file_123"}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		UploadsBackend,
+		&BackendConfig{
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(1),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+	backend.SetNetworkRetriesSleep(false)
+
+	p := &FileParams{
+		FileReader: strings.NewReader(strings.Repeat("evidence", 1000)),
+		Filename:   String("evidence.txt"),
+	}
+	body, size, boundary, err := p.GetBodyReader()
+	assert.NoError(t, err)
+
+	var file File
+	err = backend.CallMultipartReader(http.MethodPost, "/v1/files", "sk_test_123", boundary, body, size, nil, &file)
+	assert.NoError(t, err)
+	assert.Equal(t, "file_123", file.ID)
+
+	assert.Equal(t, 2, len(bodies))
+	assert.Equal(t, size, int64(len(bodies[0])))
+	assert.Equal(t, bodies[0], bodies[1])
+}
+
+func TestCallMultipartStream(t *testing.T) {
+	requests := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		// The size of the body isn't known, so it's sent in chunks
+		assert.Equal(t, []string{"chunked"}, r.TransferEncoding)
+		assert.Equal(t, int64(-1), r.ContentLength)
+
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		assert.NoError(t, err)
+		reader := multipart.NewReader(r.Body, params["boundary"])
+		parts := map[string]string{}
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			value, err := ioutil.ReadAll(part)
+			assert.NoError(t, err)
+			parts[part.FormName()] = string(value)
+		}
+		assert.Equal(t, string(FilePurposeDisputeEvidence), parts["purpose"])
+		assert.Equal(t, strings.Repeat("evidence", 1000), parts["file"])
+		assert.Equal(t, "true", parts["file_link_data[create]"])
+
+		// Retries are requested, but shouldn't happen
+		w.Header().Set("Stripe-Should-Retry", "true")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error":{"message":"try again"}}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		UploadsBackend,
+		&BackendConfig{
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(2),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+	backend.SetNetworkRetriesSleep(false)
+
+	p := &FileParams{
+		FileReader: ioutil.NopCloser(strings.NewReader(strings.Repeat("evidence", 1000))),
+		Filename:   String("evidence.txt"),
+		Purpose:    String(string(FilePurposeDisputeEvidence)),
+		FileLinkData: &FileFileLinkDataParams{
+			Create: Bool(true),
+		},
+	}
+	body, size, boundary, err := p.GetBodyStream()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), size)
+	defer body.Close()
+
+	var file File
+	err = backend.CallMultipartStream(http.MethodPost, "/v1/files", "sk_test_123", boundary, body, size, nil, &file)
+	assert.Error(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestCallMultipartStream_ContentLength(t *testing.T) {
+	var received []byte
+	var contentLength int64
+	var transferEncoding []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentLength, transferEncoding = r.ContentLength, r.TransferEncoding
+		received, _ = ioutil.ReadAll(r.Body)
+		w.Write([]byte(`{"id":"file_123","object":"file"}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		UploadsBackend,
+		&BackendConfig{
+			LeveledLogger: nullLeveledLogger,
+			URL:           String(testServer.URL),
+		},
+	).(*BackendImplementation)
+
+	// A bytes.Buffer reports its size, so the body's size is known
+	p := &FileParams{
+		FileReader: bytes.NewBufferString(strings.Repeat("evidence", 1000)),
+		Filename:   String("evidence.txt"),
+		Purpose:    String(string(FilePurposeDisputeEvidence)),
+		FileLinkData: &FileFileLinkDataParams{
+			Create: Bool(true),
+		},
+	}
+	body, size, boundary, err := p.GetBodyStream()
+	assert.NoError(t, err)
+	defer body.Close()
+
+	var file File
+	err = backend.CallMultipartStream(http.MethodPost, "/v1/files", "sk_test_123", boundary, body, size, nil, &file)
+	assert.NoError(t, err)
+	assert.Equal(t, "file_123", file.ID)
+	assert.Equal(t, size, contentLength)
+	assert.Equal(t, int64(len(received)), size)
+	assert.Empty(t, transferEncoding)
+}
@@ -98,5 +98,5 @@ func (i *Iter) FileLinkList() *stripe.FileLinkList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -148,5 +148,5 @@ func (i *OwnerIter) FinancialConnectionsAccountOwnerList() *stripe.FinancialConn
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
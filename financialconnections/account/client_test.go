@@ -0,0 +1,74 @@
+package account
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestFinancialConnectionsAccountDisconnect(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/financial_connections/accounts/fca_123/disconnect", r.URL.Path)
+		w.Write([]byte(`{"id": "fca_123", "object": "financial_connections.account", "status": "disconnected"}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	account, err := c.Disconnect("fca_123", &stripe.FinancialConnectionsAccountDisconnectParams{})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.FinancialConnectionsAccountStatusDisconnected, account.Status)
+}
+
+func TestFinancialConnectionsAccountListOwners(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/financial_connections/accounts/fca_123/owners", r.URL.Path)
+		assert.Equal(t, "fcaowns_123", r.URL.Query().Get("ownership"))
+		w.Write([]byte(`{
+			"object": "list",
+			"has_more": false,
+			"data": [
+				{"id": "fcaown_123", "object": "financial_connections.account_owner", "name": "Jenny Rosen", "ownership": "fcaowns_123"}
+			]
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	i := c.ListOwners(&stripe.FinancialConnectionsAccountListOwnersParams{
+		Account:   stripe.String("fca_123"),
+		Ownership: stripe.String("fcaowns_123"),
+	})
+
+	assert.True(t, i.Next())
+	assert.Equal(t, "Jenny Rosen", i.FinancialConnectionsAccountOwner().Name)
+	assert.NotNil(t, i.FinancialConnectionsAccountOwnerList())
+	assert.False(t, i.Next())
+	assert.Nil(t, i.Err())
+}
+
+func TestFinancialConnectionsAccountRefresh(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/financial_connections/accounts/fca_123/refresh", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "balance", r.PostForm.Get("features[0]"))
+		w.Write([]byte(`{
+			"id": "fca_123",
+			"object": "financial_connections.account",
+			"balance_refresh": {"last_attempted_at": 1234567890, "status": "pending"}
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	account, err := c.Refresh("fca_123", &stripe.FinancialConnectionsAccountRefreshParams{
+		Features: stripe.StringSlice([]string{"balance"}),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.FinancialConnectionsAccountBalanceRefreshStatusPending, account.BalanceRefresh.Status)
+}
@@ -51,5 +51,5 @@ func (c Client) Get(id string, params *stripe.FinancialConnectionsSessionParams)
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,44 @@
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestFinancialConnectionsSessionNew(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/financial_connections/sessions", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "customer", r.PostForm.Get("account_holder[type]"))
+		assert.Equal(t, "cus_123", r.PostForm.Get("account_holder[customer]"))
+		assert.Equal(t, "payment_method", r.PostForm.Get("permissions[0]"))
+		w.Write([]byte(`{
+			"id": "fcsess_123",
+			"object": "financial_connections.session",
+			"client_secret": "fcsess_123_secret_456",
+			"permissions": ["payment_method"]
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	session, err := c.New(&stripe.FinancialConnectionsSessionParams{
+		AccountHolder: &stripe.FinancialConnectionsSessionAccountHolderParams{
+			Customer: stripe.String("cus_123"),
+			Type:     stripe.String(string(stripe.FinancialConnectionsSessionAccountHolderTypeCustomer)),
+		},
+		Permissions: stripe.StringSlice([]string{
+			string(stripe.FinancialConnectionsSessionPermissionPaymentMethod),
+		}),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "fcsess_123_secret_456", session.ClientSecret)
+	assert.Equal(t, []stripe.FinancialConnectionsSessionPermission{stripe.FinancialConnectionsSessionPermissionPaymentMethod}, session.Permissions)
+}
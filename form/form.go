@@ -2,9 +2,11 @@ package form
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -12,6 +14,8 @@ import (
 
 const tagName = "form"
 
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
 // Appender is the interface implemented by types that can append themselves to
 // a collection of form values.
 //
@@ -351,6 +355,66 @@ func mapEncoder(values *Values, v reflect.Value, keyParts []string, _ bool, _ *f
 	}
 }
 
+// rawMessageEncoder encodes the JSON in a json.RawMessage as if it were made
+// of maps and slices, which makes it possible to send parameters that the
+// library doesn't have a type for yet. JSON nulls are encoded as empty
+// values, which unset a field.
+func rawMessageEncoder(values *Values, v reflect.Value, keyParts []string, _ bool, _ *formOptions) {
+	if v.Len() == 0 {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(v.Bytes()))
+	decoder.UseNumber()
+
+	var val interface{}
+	if err := decoder.Decode(&val); err != nil {
+		if Strict {
+			panic(fmt.Sprintf("Cannot encode invalid JSON in json.RawMessage: %v", err))
+		}
+		return
+	}
+	encodeJSONValue(values, val, keyParts)
+}
+
+func encodeJSONValue(values *Values, val interface{}, keyParts []string) {
+	switch val := val.(type) {
+	case map[string]interface{}:
+		// Sort keys so that the encoding is stable
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			encodeJSONValue(values, val[key], append(keyParts, key))
+		}
+
+	case []interface{}:
+		// Like for slices, an empty array zeroes the API array
+		if len(val) == 0 {
+			values.Add(FormatKey(keyParts), "")
+			return
+		}
+		for i, elem := range val {
+			encodeJSONValue(values, elem, append(keyParts, strconv.Itoa(i)))
+		}
+
+	case bool:
+		values.Add(FormatKey(keyParts), strconv.FormatBool(val))
+
+	case json.Number:
+		values.Add(FormatKey(keyParts), val.String())
+
+	case string:
+		values.Add(FormatKey(keyParts), val)
+
+	case nil:
+		values.Add(FormatKey(keyParts), "")
+	}
+}
+
 func stringEncoder(values *Values, v reflect.Value, keyParts []string, encodeZero bool, options *formOptions) {
 	val := v.String()
 	if val == "" && !encodeZero {
@@ -452,6 +516,10 @@ func makeStructEncoder(t reflect.Type) *structEncoder {
 }
 
 func makeTypeEncoder(t reflect.Type) encoderFunc {
+	if t == rawMessageType {
+		return rawMessageEncoder
+	}
+
 	switch t.Kind() {
 	case reflect.Array, reflect.Slice:
 		return buildArrayOrSliceEncoder(t)
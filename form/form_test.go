@@ -1,6 +1,7 @@
 package form
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"sync"
@@ -372,6 +373,25 @@ func TestAppendTo_ZeroValues(t *testing.T) {
 	assert.Equal(t, &Values{}, form)
 }
 
+func TestAppendTo_RawMessage(t *testing.T) {
+	type rawStruct struct {
+		Raw json.RawMessage `form:"raw"`
+	}
+
+	form := &Values{}
+	data := &rawStruct{Raw: json.RawMessage(`{"a":{"b":1.5},"c":[true,"x"],"d":null,"e":[]}`)}
+	AppendTo(form, data)
+	assert.Equal(t, "raw[a][b]=1.5&raw[c][0]=true&raw[c][1]=x&raw[d]=&raw[e]=", form.Encode())
+
+	form = &Values{}
+	AppendTo(form, &rawStruct{})
+	assert.Equal(t, &Values{}, form)
+
+	assert.Panics(t, func() {
+		AppendTo(&Values{}, &rawStruct{Raw: json.RawMessage(`{`)})
+	})
+}
+
 func TestAppendToPrefixed(t *testing.T) {
 	form := &Values{}
 	data := &testStruct{String: "foo"}
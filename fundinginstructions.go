@@ -41,11 +41,40 @@ const (
 	FundingInstructionsFundingTypeBankTransfer FundingInstructionsFundingType = "bank_transfer"
 )
 
+// Iban Records contain E.U. bank account details per the SEPA format.
+type FundingInstructionsBankTransferFinancialAddressIBAN struct {
+	// The name of the person or business that owns the bank account
+	AccountHolderName string `json:"account_holder_name"`
+	// The BIC/SWIFT code of the account.
+	BIC string `json:"bic"`
+	// Two-letter country code ([ISO 3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2)).
+	Country string `json:"country"`
+	// The IBAN of the account.
+	IBAN string `json:"iban"`
+}
+
 // Zengin Records contain Japan bank account details per the Zengin format.
-type FundingInstructionsBankTransferFinancialAddressZengin struct{}
+type FundingInstructionsBankTransferFinancialAddressZengin struct {
+	// The account holder name
+	AccountHolderName string `json:"account_holder_name"`
+	// The account number
+	AccountNumber string `json:"account_number"`
+	// The bank account type. In Japan, this can only be `futsu` or `toza`.
+	AccountType string `json:"account_type"`
+	// The bank code of the account
+	BankCode string `json:"bank_code"`
+	// The bank name of the account
+	BankName string `json:"bank_name"`
+	// The branch code of the account
+	BranchCode string `json:"branch_code"`
+	// The branch name of the account
+	BranchName string `json:"branch_name"`
+}
 
 // A list of financial addresses that can be used to fund a particular balance
 type FundingInstructionsBankTransferFinancialAddress struct {
+	// Iban Records contain E.U. bank account details per the SEPA format.
+	IBAN *FundingInstructionsBankTransferFinancialAddressIBAN `json:"iban"`
 	// The payment networks supported by this FinancialAddress
 	SupportedNetworks []FundingInstructionsBankTransferFinancialAddressSupportedNetwork `json:"supported_networks"`
 	// The type of financial address
@@ -68,6 +97,7 @@ type FundingInstructionsBankTransfer struct {
 // `financial_addresses` field.
 // Related guide: [Customer Balance - Funding Instructions](https://stripe.com/docs/payments/customer-balance/funding-instructions) to learn more
 type FundingInstructions struct {
+	APIResource
 	BankTransfer *FundingInstructionsBankTransfer `json:"bank_transfer"`
 	// Three-letter [ISO currency code](https://www.iso.org/iso-4217-currency-codes.html), in lowercase. Must be a [supported currency](https://stripe.com/docs/currencies).
 	Currency Currency `json:"currency"`
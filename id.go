@@ -0,0 +1,534 @@
+package stripe
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This file contains typed ID values for the most commonly used resources.
+// Each has a constructor that checks the ID against the prefixes documented
+// for that resource, which catches mistakes like passing a charge ID where a
+// customer ID was expected before a request is ever made.
+//
+// Typed IDs can be used in params structs through the ID function:
+//
+//	customerID, err := stripe.NewCustomerID(input)
+//	if err != nil {
+//		return err
+//	}
+//	params := &stripe.PaymentIntentParams{Customer: stripe.ID(customerID)}
+//
+// Resources that allow user-defined IDs, such as coupons, plans and products,
+// don't have a typed ID since their IDs can't be validated.
+
+//
+// Public types
+//
+
+// InvalidIDError is returned by the typed ID constructors when an ID doesn't
+// start with any of the prefixes expected for that type of resource. The
+// constructors return the zero value of their type along with it.
+type InvalidIDError struct {
+	// ID is the ID that failed validation.
+	ID string
+
+	// Object is the name of the resource that was expected, e.g. "customer".
+	Object string
+
+	// Prefixes are the prefixes that a valid ID may start with.
+	Prefixes []string
+}
+
+// Error serializes the error object to a string.
+func (e *InvalidIDError) Error() string {
+	return fmt.Sprintf("%q is not a valid %s ID (expected prefix %s)",
+		e.ID, e.Object, strings.Join(e.Prefixes, " or "))
+}
+
+// ResourceID is implemented by every typed ID in this package.
+type ResourceID interface {
+	String() string
+}
+
+// AccountID is the ID of an account.
+type AccountID string
+
+// String returns the ID as a plain string.
+func (id AccountID) String() string {
+	return string(id)
+}
+
+// BalanceTransactionID is the ID of a balance transaction.
+type BalanceTransactionID string
+
+// String returns the ID as a plain string.
+func (id BalanceTransactionID) String() string {
+	return string(id)
+}
+
+// ChargeID is the ID of a charge.
+type ChargeID string
+
+// String returns the ID as a plain string.
+func (id ChargeID) String() string {
+	return string(id)
+}
+
+// CheckoutSessionID is the ID of a Checkout Session.
+type CheckoutSessionID string
+
+// String returns the ID as a plain string.
+func (id CheckoutSessionID) String() string {
+	return string(id)
+}
+
+// CustomerID is the ID of a customer.
+type CustomerID string
+
+// String returns the ID as a plain string.
+func (id CustomerID) String() string {
+	return string(id)
+}
+
+// DisputeID is the ID of a dispute.
+type DisputeID string
+
+// String returns the ID as a plain string.
+func (id DisputeID) String() string {
+	return string(id)
+}
+
+// EventID is the ID of an event.
+type EventID string
+
+// String returns the ID as a plain string.
+func (id EventID) String() string {
+	return string(id)
+}
+
+// InvoiceID is the ID of an invoice.
+type InvoiceID string
+
+// String returns the ID as a plain string.
+func (id InvoiceID) String() string {
+	return string(id)
+}
+
+// PaymentIntentID is the ID of a PaymentIntent.
+type PaymentIntentID string
+
+// String returns the ID as a plain string.
+func (id PaymentIntentID) String() string {
+	return string(id)
+}
+
+// PaymentMethodID is the ID of a PaymentMethod.
+type PaymentMethodID string
+
+// String returns the ID as a plain string.
+func (id PaymentMethodID) String() string {
+	return string(id)
+}
+
+// PayoutID is the ID of a payout.
+type PayoutID string
+
+// String returns the ID as a plain string.
+func (id PayoutID) String() string {
+	return string(id)
+}
+
+// PriceID is the ID of a price.
+type PriceID string
+
+// String returns the ID as a plain string.
+func (id PriceID) String() string {
+	return string(id)
+}
+
+// RefundID is the ID of a refund.
+type RefundID string
+
+// String returns the ID as a plain string.
+func (id RefundID) String() string {
+	return string(id)
+}
+
+// SetupIntentID is the ID of a SetupIntent.
+type SetupIntentID string
+
+// String returns the ID as a plain string.
+func (id SetupIntentID) String() string {
+	return string(id)
+}
+
+// SubscriptionID is the ID of a subscription.
+type SubscriptionID string
+
+// String returns the ID as a plain string.
+func (id SubscriptionID) String() string {
+	return string(id)
+}
+
+// TransferID is the ID of a transfer.
+type TransferID string
+
+// String returns the ID as a plain string.
+func (id TransferID) String() string {
+	return string(id)
+}
+
+//
+// Public functions
+//
+
+// ID returns a pointer to the string value of a typed ID, for use in params
+// structs alongside plain strings set with the String function.
+func ID(id ResourceID) *string {
+	return String(id.String())
+}
+
+// NewAccountID validates that the given string looks like the ID of an account
+// and returns it as an AccountID.
+func NewAccountID(id string) (AccountID, error) {
+	if err := validateID("account", id); err != nil {
+		return "", err
+	}
+	return AccountID(id), nil
+}
+
+// MustAccountID is like NewAccountID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustAccountID(id string) AccountID {
+	v, err := NewAccountID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewBalanceTransactionID validates that the given string looks like the ID of
+// a balance transaction and returns it as a BalanceTransactionID.
+func NewBalanceTransactionID(id string) (BalanceTransactionID, error) {
+	if err := validateID("balance_transaction", id); err != nil {
+		return "", err
+	}
+	return BalanceTransactionID(id), nil
+}
+
+// MustBalanceTransactionID is like NewBalanceTransactionID, but panics if the
+// ID isn't valid. It's intended for IDs that are known to be valid, like
+// constants.
+func MustBalanceTransactionID(id string) BalanceTransactionID {
+	v, err := NewBalanceTransactionID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewChargeID validates that the given string looks like the ID of a charge and
+// returns it as a ChargeID.
+func NewChargeID(id string) (ChargeID, error) {
+	if err := validateID("charge", id); err != nil {
+		return "", err
+	}
+	return ChargeID(id), nil
+}
+
+// MustChargeID is like NewChargeID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustChargeID(id string) ChargeID {
+	v, err := NewChargeID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewCheckoutSessionID validates that the given string looks like the ID of a
+// Checkout Session and returns it as a CheckoutSessionID.
+func NewCheckoutSessionID(id string) (CheckoutSessionID, error) {
+	if err := validateID("checkout.session", id); err != nil {
+		return "", err
+	}
+	return CheckoutSessionID(id), nil
+}
+
+// MustCheckoutSessionID is like NewCheckoutSessionID, but panics if the ID
+// isn't valid. It's intended for IDs that are known to be valid, like
+// constants.
+func MustCheckoutSessionID(id string) CheckoutSessionID {
+	v, err := NewCheckoutSessionID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewCustomerID validates that the given string looks like the ID of a customer
+// and returns it as a CustomerID.
+func NewCustomerID(id string) (CustomerID, error) {
+	if err := validateID("customer", id); err != nil {
+		return "", err
+	}
+	return CustomerID(id), nil
+}
+
+// MustCustomerID is like NewCustomerID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustCustomerID(id string) CustomerID {
+	v, err := NewCustomerID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewDisputeID validates that the given string looks like the ID of a dispute
+// and returns it as a DisputeID.
+func NewDisputeID(id string) (DisputeID, error) {
+	if err := validateID("dispute", id); err != nil {
+		return "", err
+	}
+	return DisputeID(id), nil
+}
+
+// MustDisputeID is like NewDisputeID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustDisputeID(id string) DisputeID {
+	v, err := NewDisputeID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewEventID validates that the given string looks like the ID of an event and
+// returns it as an EventID.
+func NewEventID(id string) (EventID, error) {
+	if err := validateID("event", id); err != nil {
+		return "", err
+	}
+	return EventID(id), nil
+}
+
+// MustEventID is like NewEventID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustEventID(id string) EventID {
+	v, err := NewEventID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewInvoiceID validates that the given string looks like the ID of an invoice
+// and returns it as an InvoiceID.
+func NewInvoiceID(id string) (InvoiceID, error) {
+	if err := validateID("invoice", id); err != nil {
+		return "", err
+	}
+	return InvoiceID(id), nil
+}
+
+// MustInvoiceID is like NewInvoiceID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustInvoiceID(id string) InvoiceID {
+	v, err := NewInvoiceID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewPaymentIntentID validates that the given string looks like the ID of a
+// PaymentIntent and returns it as a PaymentIntentID.
+func NewPaymentIntentID(id string) (PaymentIntentID, error) {
+	if err := validateID("payment_intent", id); err != nil {
+		return "", err
+	}
+	return PaymentIntentID(id), nil
+}
+
+// MustPaymentIntentID is like NewPaymentIntentID, but panics if the ID isn't
+// valid. It's intended for IDs that are known to be valid, like constants.
+func MustPaymentIntentID(id string) PaymentIntentID {
+	v, err := NewPaymentIntentID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewPaymentMethodID validates that the given string looks like the ID of a
+// PaymentMethod and returns it as a PaymentMethodID.
+func NewPaymentMethodID(id string) (PaymentMethodID, error) {
+	if err := validateID("payment_method", id); err != nil {
+		return "", err
+	}
+	return PaymentMethodID(id), nil
+}
+
+// MustPaymentMethodID is like NewPaymentMethodID, but panics if the ID isn't
+// valid. It's intended for IDs that are known to be valid, like constants.
+func MustPaymentMethodID(id string) PaymentMethodID {
+	v, err := NewPaymentMethodID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewPayoutID validates that the given string looks like the ID of a payout and
+// returns it as a PayoutID.
+func NewPayoutID(id string) (PayoutID, error) {
+	if err := validateID("payout", id); err != nil {
+		return "", err
+	}
+	return PayoutID(id), nil
+}
+
+// MustPayoutID is like NewPayoutID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustPayoutID(id string) PayoutID {
+	v, err := NewPayoutID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewPriceID validates that the given string looks like the ID of a price and
+// returns it as a PriceID.
+func NewPriceID(id string) (PriceID, error) {
+	if err := validateID("price", id); err != nil {
+		return "", err
+	}
+	return PriceID(id), nil
+}
+
+// MustPriceID is like NewPriceID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustPriceID(id string) PriceID {
+	v, err := NewPriceID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewRefundID validates that the given string looks like the ID of a refund and
+// returns it as a RefundID.
+func NewRefundID(id string) (RefundID, error) {
+	if err := validateID("refund", id); err != nil {
+		return "", err
+	}
+	return RefundID(id), nil
+}
+
+// MustRefundID is like NewRefundID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustRefundID(id string) RefundID {
+	v, err := NewRefundID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewSetupIntentID validates that the given string looks like the ID of a
+// SetupIntent and returns it as a SetupIntentID.
+func NewSetupIntentID(id string) (SetupIntentID, error) {
+	if err := validateID("setup_intent", id); err != nil {
+		return "", err
+	}
+	return SetupIntentID(id), nil
+}
+
+// MustSetupIntentID is like NewSetupIntentID, but panics if the ID isn't valid.
+// It's intended for IDs that are known to be valid, like constants.
+func MustSetupIntentID(id string) SetupIntentID {
+	v, err := NewSetupIntentID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewSubscriptionID validates that the given string looks like the ID of a
+// subscription and returns it as a SubscriptionID.
+func NewSubscriptionID(id string) (SubscriptionID, error) {
+	if err := validateID("subscription", id); err != nil {
+		return "", err
+	}
+	return SubscriptionID(id), nil
+}
+
+// MustSubscriptionID is like NewSubscriptionID, but panics if the ID isn't
+// valid. It's intended for IDs that are known to be valid, like constants.
+func MustSubscriptionID(id string) SubscriptionID {
+	v, err := NewSubscriptionID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewTransferID validates that the given string looks like the ID of a transfer
+// and returns it as a TransferID.
+func NewTransferID(id string) (TransferID, error) {
+	if err := validateID("transfer", id); err != nil {
+		return "", err
+	}
+	return TransferID(id), nil
+}
+
+// MustTransferID is like NewTransferID, but panics if the ID isn't valid. It's
+// intended for IDs that are known to be valid, like constants.
+func MustTransferID(id string) TransferID {
+	v, err := NewTransferID(id)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+//
+// Private variables
+//
+
+// idPrefixes maps the name of resources to the prefixes their IDs are
+// documented to start with.
+var idPrefixes = map[string][]string{
+	"account":             {"acct_"},
+	"balance_transaction": {"txn_"},
+	"charge":              {"ch_", "py_"},
+	"checkout.session":    {"cs_"},
+	"customer":            {"cus_"},
+	"dispute":             {"dp_", "du_"},
+	"event":               {"evt_"},
+	"invoice":             {"in_"},
+	"payment_intent":      {"pi_"},
+	"payment_method":      {"pm_", "card_", "src_"},
+	"payout":              {"po_"},
+	"price":               {"price_"},
+	"refund":              {"re_", "pyr_"},
+	"setup_intent":        {"seti_"},
+	"subscription":        {"sub_"},
+	"transfer":            {"tr_"},
+}
+
+//
+// Private functions
+//
+
+func validateID(object, id string) error {
+	prefixes := idPrefixes[object]
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(id, prefix) && len(id) > len(prefix) {
+			return nil
+		}
+	}
+	return &InvalidIDError{ID: id, Object: object, Prefixes: prefixes}
+}
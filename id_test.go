@@ -0,0 +1,41 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestNewCustomerID(t *testing.T) {
+	id, err := NewCustomerID("cus_123")
+	assert.NoError(t, err)
+	assert.Equal(t, CustomerID("cus_123"), id)
+	assert.Equal(t, "cus_123", *ID(id))
+
+	id, err = NewCustomerID("ch_123")
+	assert.Equal(t, CustomerID(""), id)
+	assert.EqualError(t, err, `"ch_123" is not a valid customer ID (expected prefix cus_)`)
+
+	invalidErr, ok := err.(*InvalidIDError)
+	assert.True(t, ok)
+	assert.Equal(t, "customer", invalidErr.Object)
+
+	// A bare prefix isn't a valid ID either
+	_, err = NewCustomerID("cus_")
+	assert.Error(t, err)
+}
+
+func TestNewChargeID(t *testing.T) {
+	_, err := NewChargeID("py_123")
+	assert.NoError(t, err)
+
+	_, err = NewChargeID("pi_123")
+	assert.EqualError(t, err, `"pi_123" is not a valid charge ID (expected prefix ch_ or py_)`)
+}
+
+func TestMustPaymentIntentID(t *testing.T) {
+	assert.Equal(t, PaymentIntentID("pi_123"), MustPaymentIntentID("pi_123"))
+	assert.Panics(t, func() {
+		MustPaymentIntentID("seti_123")
+	})
+}
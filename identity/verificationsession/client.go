@@ -136,5 +136,5 @@ func (i *Iter) IdentityVerificationSessionList() *stripe.IdentityVerificationSes
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
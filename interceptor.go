@@ -0,0 +1,66 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+)
+
+//
+// Public types
+//
+
+// RequestInterceptor wraps the requests sent by a backend that's configured
+// with it in BackendConfig.Interceptors. It's called with each attempt at a
+// request, including retries, and must call next to send the request on,
+// unless it wants to fail the attempt. This makes it possible to inspect or
+// change the outgoing request and the response that comes back, like to add
+// tracing headers, audit requests, or sign them for an egress proxy:
+//
+//	func traceInterceptor(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+//		req.Header.Set("Traceparent", traceparent(req.Context()))
+//		return next(req)
+//	}
+//
+// Except for file uploads, whose bodies are streamed, req.GetBody returns a
+// copy of the request's body, which interceptors can read without consuming
+// the body that's sent. Errors they return are handled like network errors, so they
+// may lead to the request being retried.
+type RequestInterceptor func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error)
+
+//
+// Public functions
+//
+
+// RequestRetries returns the number of times that the request whose context
+// is ctx has already been retried, for use by a RequestInterceptor. It's 0 for
+// the first attempt at a request.
+func RequestRetries(ctx context.Context) int {
+	retries, _ := ctx.Value(requestRetriesKey{}).(int)
+	return retries
+}
+
+//
+// Private types
+//
+
+// requestRetriesKey is the context key of the value returned by
+// RequestRetries.
+type requestRetriesKey struct{}
+
+//
+// Private functions
+//
+
+// sendRequest sends req with the backend's HTTP client, through its
+// interceptors. The first interceptor is the outermost one, so it sees the
+// request first and the response last.
+func (s *BackendImplementation) sendRequest(req *http.Request) (*http.Response, error) {
+	send := s.HTTPClient.Do
+	for i := len(s.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := s.interceptors[i], send
+		send = func(req *http.Request) (*http.Response, error) {
+			return interceptor(req, next)
+		}
+	}
+	return send(req)
+}
@@ -0,0 +1,133 @@
+package stripe
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+type interceptorTestParams struct {
+	Params `form:"*"`
+	Foo    string `form:"foo"`
+}
+
+func TestDo_Interceptors(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "outer,inner", r.Header.Get("X-Intercepted"))
+		w.Header().Set("Request-Id", "req_123")
+		_, err := w.Write([]byte(`{}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	var calls []string
+	intercept := func(name string) RequestInterceptor {
+		return func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+			if header := req.Header.Get("X-Intercepted"); header != "" {
+				name = header + "," + name
+			}
+			req.Header.Set("X-Intercepted", name)
+
+			body, err := req.GetBody()
+			assert.NoError(t, err)
+			data, err := ioutil.ReadAll(body)
+			assert.NoError(t, err)
+			assert.Equal(t, "foo=bar", string(data))
+
+			assert.Equal(t, 0, RequestRetries(req.Context()))
+			calls = append(calls, "request "+name)
+			resp, err := next(req)
+			calls = append(calls, "response "+resp.Header.Get("Request-Id"))
+			return resp, err
+		}
+	}
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			Interceptors:      []RequestInterceptor{intercept("outer"), intercept("inner")},
+			LeveledLogger:     debugLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+
+	var response APIResource
+	err := backend.Call(http.MethodPost, "/v1/charges", "sk_test_123", &interceptorTestParams{Foo: "bar"}, &response)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"request outer",
+		"request outer,inner",
+		"response req_123",
+		"response req_123",
+	}, calls)
+}
+
+func TestDo_InterceptorError(t *testing.T) {
+	requested := false
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			Interceptors: []RequestInterceptor{
+				func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+					return nil, errors.New("request blocked")
+				},
+			},
+			LeveledLogger:     debugLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+
+	var response APIResource
+	err := backend.Call(http.MethodGet, "/v1/charges", "sk_test_123", nil, &response)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "request blocked")
+	assert.False(t, requested)
+}
+
+func TestDo_InterceptorRetries(t *testing.T) {
+	requestNum := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestNum++
+		if requestNum == 1 {
+			w.WriteHeader(http.StatusConflict)
+			_, err := w.Write([]byte(`{"error":{"message":"Conflict"}}`))
+			assert.NoError(t, err)
+			return
+		}
+		_, err := w.Write([]byte(`{}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	var retries []int
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			Interceptors: []RequestInterceptor{
+				func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+					retries = append(retries, RequestRetries(req.Context()))
+					return next(req)
+				},
+			},
+			LeveledLogger:     debugLeveledLogger,
+			MaxNetworkRetries: Int64(1),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+	backend.SetNetworkRetriesSleep(false)
+
+	var response APIResource
+	assert.NoError(t, backend.Call(http.MethodGet, "/v1/charges", "sk_test_123", nil, &response))
+	assert.Equal(t, []int{0, 1}, retries)
+}
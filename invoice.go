@@ -11,6 +11,15 @@ import (
 	"github.com/stripe/stripe-go/v72/form"
 )
 
+// Type of the account referenced.
+type InvoiceAutomaticTaxLiabilityType string
+
+// List of values that InvoiceAutomaticTaxLiabilityType can take
+const (
+	InvoiceAutomaticTaxLiabilityTypeAccount InvoiceAutomaticTaxLiabilityType = "account"
+	InvoiceAutomaticTaxLiabilityTypeSelf    InvoiceAutomaticTaxLiabilityType = "self"
+)
+
 // The status of the most recent automated tax calculation for this invoice.
 type InvoiceAutomaticTaxStatus string
 
@@ -130,6 +139,25 @@ const (
 	InvoicePaymentSettingsPaymentMethodTypeWechatPay          InvoicePaymentSettingsPaymentMethodType = "wechat_pay"
 )
 
+// How line-item prices and amounts will be displayed with respect to tax on invoice PDFs.
+type InvoiceRenderingAmountTaxDisplay string
+
+// List of values that InvoiceRenderingAmountTaxDisplay can take
+const (
+	InvoiceRenderingAmountTaxDisplayExcludeTax          InvoiceRenderingAmountTaxDisplay = "exclude_tax"
+	InvoiceRenderingAmountTaxDisplayIncludeInclusiveTax InvoiceRenderingAmountTaxDisplay = "include_inclusive_tax"
+)
+
+// Page size of invoice pdf. Options include a4, letter, and auto. If set to auto, page size will be switched to a4 or letter based on customer locale.
+type InvoiceRenderingPDFPageSize string
+
+// List of values that InvoiceRenderingPDFPageSize can take
+const (
+	InvoiceRenderingPDFPageSizeA4     InvoiceRenderingPDFPageSize = "a4"
+	InvoiceRenderingPDFPageSizeAuto   InvoiceRenderingPDFPageSize = "auto"
+	InvoiceRenderingPDFPageSizeLetter InvoiceRenderingPDFPageSize = "letter"
+)
+
 // The status of the invoice, one of `draft`, `open`, `paid`, `uncollectible`, or `void`. [Learn more](https://stripe.com/docs/billing/invoices/workflow#workflow-overview)
 type InvoiceStatus string
 
@@ -170,10 +198,20 @@ type InvoiceListParams struct {
 	Subscription *string `form:"subscription"`
 }
 
+// The account that's liable for tax. If set, the business address and tax registrations required to perform the tax calculation are loaded from this account. The tax transaction is returned in the report of the connected account.
+type InvoiceAutomaticTaxLiabilityParams struct {
+	// The connected account being referenced when `type` is `account`.
+	Account *string `form:"account"`
+	// Type of the account referenced in the request.
+	Type *string `form:"type"`
+}
+
 // Settings for automatic tax lookup for this invoice.
 type InvoiceAutomaticTaxParams struct {
 	// Controls whether Stripe will automatically compute tax on this invoice.
 	Enabled *bool `form:"enabled"`
+	// The account that's liable for tax. If set, the business address and tax registrations required to perform the tax calculation are loaded from this account. The tax transaction is returned in the report of the connected account.
+	Liability *InvoiceAutomaticTaxLiabilityParams `form:"liability"`
 }
 
 // A list of up to 4 custom fields to be displayed on the invoice.
@@ -281,6 +319,22 @@ type InvoiceTransferDataParams struct {
 	Destination *string `form:"destination"`
 }
 
+// Invoice pdf rendering options
+type InvoiceRenderingPDFParams struct {
+	// Page size for invoice PDF. Can be set to `a4`, `letter`, or `auto`.
+	//  If set to `auto`, invoice PDF page size defaults to `a4` for customers with
+	//  Japanese locale and `letter` for customers with other locales.
+	PageSize *string `form:"page_size"`
+}
+
+// The rendering-related settings that control how the invoice is displayed on customer-facing surfaces such as PDF and Hosted Invoice Page.
+type InvoiceRenderingParams struct {
+	// How line-item prices and amounts will be displayed with respect to tax on invoice PDFs. One of `exclude_tax` or `include_inclusive_tax`. `include_inclusive_tax` will include inclusive tax (and exclude exclusive tax) in invoice PDF amounts. `exclude_tax` will exclude all tax (inclusive and exclusive alike) from invoice PDF amounts.
+	AmountTaxDisplay *string `form:"amount_tax_display"`
+	// Invoice pdf rendering options
+	PDF *InvoiceRenderingPDFParams `form:"pdf"`
+}
+
 // This endpoint creates a draft invoice for a given customer. The draft invoice created pulls in all pending invoice items on that customer, including prorations. The invoice remains a draft until you [finalize the invoice, which allows you to [pay](#pay_invoice) or <a href="#send_invoice">send](https://stripe.com/docs/api#finalize_invoice) the invoice to your customers.
 type InvoiceParams struct {
 	Params `form:"*"`
@@ -290,6 +344,8 @@ type InvoiceParams struct {
 	ApplicationFeeAmount *int64 `form:"application_fee_amount"`
 	// Controls whether Stripe will perform [automatic collection](https://stripe.com/docs/billing/invoices/workflow/#auto_advance) of the invoice.
 	AutoAdvance *bool `form:"auto_advance"`
+	// The time when this invoice should be scheduled to finalize. The invoice will be finalized at this time if it is still in draft state. Only valid for draft invoices with `auto_advance` set to `true`.
+	AutomaticallyFinalizesAt *int64 `form:"automatically_finalizes_at"`
 	// Settings for automatic tax lookup for this invoice.
 	AutomaticTax *InvoiceAutomaticTaxParams `form:"automatic_tax"`
 	// Either `charge_automatically` or `send_invoice`. This field can be updated only on `draft` invoices.
@@ -321,6 +377,8 @@ type InvoiceParams struct {
 	PaymentSettings *InvoicePaymentSettingsParams `form:"payment_settings"`
 	// How to handle pending invoice items on invoice creation. One of `include`, `exclude`, or `include_and_require`. `include` will include any pending invoice items, and will create an empty draft invoice if no pending invoice items exist. `include_and_require` will include any pending invoice items, if no pending invoice items exist then the request will fail. `exclude` will always create an empty invoice draft regardless if there are pending invoice items or not. Defaults to `include_and_require` if the parameter is omitted.
 	PendingInvoiceItemsBehavior *string `form:"pending_invoice_items_behavior"`
+	// The rendering-related settings that control how the invoice is displayed on customer-facing surfaces such as PDF and Hosted Invoice Page.
+	Rendering *InvoiceRenderingParams `form:"rendering"`
 	// The identifier of the unstarted schedule whose upcoming invoice you'd like to retrieve. Cannot be used with subscription or subscription fields.
 	Schedule *string `form:"schedule"`
 	// Extra information about a charge for the customer's credit card statement. It must contain at least one letter. If not specified and this invoice is part of a subscription, the default `statement_descriptor` will be set to the first subscription item's product's `statement_descriptor`.
@@ -382,8 +440,18 @@ func (i *InvoiceParams) AppendTo(body *form.Values, keyParts []string) {
 	}
 }
 
+// The account that's liable for tax. If set, the business address and tax registrations required to perform the tax calculation are loaded from this account. The tax transaction is returned in the report of the connected account.
+type InvoiceUpcomingAutomaticTaxLiabilityParams struct {
+	// The connected account being referenced when `type` is `account`.
+	Account *string `form:"account"`
+	// Type of the account referenced in the request.
+	Type *string `form:"type"`
+}
+
 type InvoiceUpcomingAutomaticTaxParams struct {
 	Enabled *bool `form:"enabled"`
+	// The account that's liable for tax. If set, the business address and tax registrations required to perform the tax calculation are loaded from this account. The tax transaction is returned in the report of the connected account.
+	Liability *InvoiceUpcomingAutomaticTaxLiabilityParams `form:"liability"`
 }
 
 // The customer's shipping information. Appears on invoices emailed to this customer.
@@ -514,9 +582,19 @@ type InvoiceLineListParams struct {
 	Customer     *string `form:"customer"`
 	Subscription *string `form:"subscription"`
 }
+
+// The account that's liable for tax. If set, the business address and tax registrations required to perform the tax calculation are loaded from this account. The tax transaction is returned in the report of the connected account.
+type InvoiceAutomaticTaxLiability struct {
+	// The ID of the account being referenced when `type` is `account`.
+	Account *Account `json:"account"`
+	// Type of the account referenced.
+	Type InvoiceAutomaticTaxLiabilityType `json:"type"`
+}
 type InvoiceAutomaticTax struct {
 	// Whether Stripe automatically computes tax on this invoice.
 	Enabled bool `json:"enabled"`
+	// The account that's liable for tax. If set, the business address and tax registrations required to perform the tax calculation are loaded from this account. The tax transaction is returned in the report of the connected account.
+	Liability *InvoiceAutomaticTaxLiability `json:"liability"`
 	// The status of the most recent automated tax calculation for this invoice.
 	Status InvoiceAutomaticTaxStatus `json:"status"`
 }
@@ -606,6 +684,20 @@ type InvoicePaymentSettings struct {
 	// The list of payment method types (e.g. card) to provide to the invoice's PaymentIntent. If not set, Stripe attempts to automatically determine the types to use by looking at the invoice's default payment method, the subscription's default payment method, the customer's default payment method, and your [invoice template settings](https://dashboard.stripe.com/settings/billing/invoice).
 	PaymentMethodTypes []InvoicePaymentSettingsPaymentMethodType `json:"payment_method_types"`
 }
+
+// Invoice pdf rendering options
+type InvoiceRenderingPDF struct {
+	// Page size of invoice pdf. Options include a4, letter, and auto. If set to auto, page size will be switched to a4 or letter based on customer locale.
+	PageSize InvoiceRenderingPDFPageSize `json:"page_size"`
+}
+
+// The rendering-related settings that control how the invoice is displayed on customer-facing surfaces such as PDF and Hosted Invoice Page.
+type InvoiceRendering struct {
+	// How line-item prices and amounts will be displayed with respect to tax on invoice PDFs.
+	AmountTaxDisplay InvoiceRenderingAmountTaxDisplay `json:"amount_tax_display"`
+	// Invoice pdf rendering options
+	PDF *InvoiceRenderingPDF `json:"pdf"`
+}
 type InvoiceStatusTransitions struct {
 	// The time that the invoice draft was finalized.
 	FinalizedAt int64 `json:"finalized_at"`
@@ -712,8 +804,10 @@ type Invoice struct {
 	// Whether an attempt has been made to pay the invoice. An invoice is not attempted until 1 hour after the `invoice.created` webhook, for example, so you might not want to display that invoice as unpaid to your users.
 	Attempted bool `json:"attempted"`
 	// Controls whether Stripe will perform [automatic collection](https://stripe.com/docs/billing/invoices/workflow/#auto_advance) of the invoice. When `false`, the invoice's state will not automatically advance without an explicit action.
-	AutoAdvance  bool                 `json:"auto_advance"`
-	AutomaticTax *InvoiceAutomaticTax `json:"automatic_tax"`
+	AutoAdvance bool `json:"auto_advance"`
+	// The time when this invoice is currently scheduled to be automatically finalized. The field will be `null` if the invoice is not scheduled to finalize in the future. If the invoice is not in the draft state, this field will always be `null` - see `finalized_at` for the time when an already-finalized invoice was finalized.
+	AutomaticallyFinalizesAt int64                `json:"automatically_finalizes_at"`
+	AutomaticTax             *InvoiceAutomaticTax `json:"automatic_tax"`
 	// Indicates the reason why the invoice was created. `subscription_cycle` indicates an invoice created by a subscription advancing into a new period. `subscription_create` indicates an invoice created due to creating a subscription. `subscription_update` indicates an invoice created due to updating a subscription. `subscription` is set for all old invoices to indicate either a change to a subscription or a period advancement. `manual` is set for all invoices unrelated to a subscription (for example: created via the invoice editor). The `upcoming` value is reserved for simulated invoices per the upcoming invoice endpoint. `subscription_threshold` indicates an invoice created due to a billing threshold being reached.
 	BillingReason InvoiceBillingReason `json:"billing_reason"`
 	// ID of the latest charge generated for this invoice, if any.
@@ -802,6 +896,8 @@ type Invoice struct {
 	Quote *Quote `json:"quote"`
 	// This is the transaction number that appears on email receipts sent for this invoice.
 	ReceiptNumber string `json:"receipt_number"`
+	// The rendering-related settings that control how the invoice is displayed on customer-facing surfaces such as PDF and Hosted Invoice Page.
+	Rendering *InvoiceRendering `json:"rendering"`
 	// Starting customer balance before the invoice is finalized. If the invoice has not been finalized yet, this will be the current customer balance.
 	StartingBalance int64 `json:"starting_balance"`
 	// Extra information about an invoice for the customer's credit card statement.
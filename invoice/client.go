@@ -18,6 +18,10 @@ import (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// HTTPClient is used by DownloadPDF to fetch invoice PDFs, which are
+	// served from outside the API. Defaults to a client with an 80 second
+	// timeout.
+	HTTPClient *http.Client
 }
 
 // New creates a new invoice.
@@ -276,5 +280,5 @@ func (i *SearchIter) InvoiceSearchResult() *stripe.InvoiceSearchResult {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.GetKey()}
 }
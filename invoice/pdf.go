@@ -0,0 +1,110 @@
+package invoice
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+// pdfMaxAttempts is the number of times DownloadPDF requests an invoice's PDF
+// before giving up on it being generated.
+const pdfMaxAttempts = 5
+
+// pdfRetryDelay is the time DownloadPDF waits before retrying for the first
+// time. It doubles with every subsequent attempt. It's a variable so that
+// tests can shorten it.
+var pdfRetryDelay = 1 * time.Second
+
+// defaultPDFHTTPClient downloads invoice PDFs for clients that don't have an
+// HTTPClient.
+var defaultPDFHTTPClient = &http.Client{Timeout: 80 * time.Second}
+
+// DownloadPDF streams the PDF of a finalized invoice into w, like to attach it
+// to an email. The invoice is retrieved first to get a current link to its
+// PDF, so only its ID is needed. Draft invoices have no PDF, and return an
+// error.
+//
+// PDFs are generated asynchronously after an invoice is finalized, so the
+// download is retried a few times with an increasing delay while the PDF
+// isn't available yet, which makes it possible to call DownloadPDF right
+// after finalizing an invoice.
+//
+// The PDF's layout can be configured with the invoice's rendering settings,
+// like InvoiceParams.Rendering.PDF.PageSize.
+func DownloadPDF(id string, w io.Writer, params *stripe.InvoiceParams) error {
+	return getC().DownloadPDF(id, w, params)
+}
+
+// DownloadPDF streams the PDF of a finalized invoice into w, like to attach it
+// to an email. The invoice is retrieved first to get a current link to its
+// PDF, so only its ID is needed. Draft invoices have no PDF, and return an
+// error.
+//
+// PDFs are generated asynchronously after an invoice is finalized, so the
+// download is retried a few times with an increasing delay while the PDF
+// isn't available yet, which makes it possible to call DownloadPDF right
+// after finalizing an invoice.
+//
+// The PDF's layout can be configured with the invoice's rendering settings,
+// like InvoiceParams.Rendering.PDF.PageSize.
+func (c Client) DownloadPDF(id string, w io.Writer, params *stripe.InvoiceParams) error {
+	invoice, err := c.Get(id, params)
+	if err != nil {
+		return err
+	}
+	if invoice.InvoicePDF == "" {
+		return fmt.Errorf("invoice %s has no PDF; only finalized invoices do", id)
+	}
+
+	// The link is signed, so the request doesn't need the API key
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = defaultPDFHTTPClient
+	}
+
+	delay := pdfRetryDelay
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequest(http.MethodGet, invoice.InvoicePDF, nil)
+		if err != nil {
+			return err
+		}
+		if params != nil && params.Context != nil {
+			req = req.WithContext(params.Context)
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			_, err = io.Copy(w, resp.Body)
+			resp.Body.Close()
+			return err
+		}
+		resp.Body.Close()
+
+		// The PDF is still being generated
+		pending := resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusNotFound
+		if !pending {
+			return fmt.Errorf("error downloading PDF of invoice %s: %s", id, resp.Status)
+		}
+		if attempt == pdfMaxAttempts {
+			return fmt.Errorf("PDF of invoice %s still not available after %d attempts", id, attempt)
+		}
+
+		if params != nil && params.Context != nil {
+			select {
+			case <-time.After(delay):
+			case <-params.Context.Done():
+				return params.Context.Err()
+			}
+		} else {
+			time.Sleep(delay)
+		}
+		delay *= 2
+	}
+}
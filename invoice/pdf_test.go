@@ -0,0 +1,126 @@
+package invoice
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestInvoiceDownloadPDF(t *testing.T) {
+	var serverURL string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/invoices/in_123":
+			w.Write([]byte(`{"id":"in_123","status":"open","invoice_pdf":"` + serverURL + `/invoice/acct_123/in_123/pdf?s=ap"}`))
+		case "/invoice/acct_123/in_123/pdf":
+			assert.Equal(t, "ap", r.URL.Query().Get("s"))
+			assert.Equal(t, "", r.Header.Get("Authorization"))
+			w.Write([]byte("%PDF-1.4"))
+		default:
+			assert.Fail(t, "unexpected request to "+r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+	serverURL = ts.URL
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	var buf bytes.Buffer
+	err := c.DownloadPDF("in_123", &buf, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "%PDF-1.4", buf.String())
+}
+
+func TestInvoiceDownloadPDF_Draft(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"in_123","status":"draft","invoice_pdf":null}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	err := c.DownloadPDF("in_123", &bytes.Buffer{}, nil)
+	assert.EqualError(t, err, "invoice in_123 has no PDF; only finalized invoices do")
+}
+
+func TestInvoiceDownloadPDF_GivesUp(t *testing.T) {
+	defer setPDFRetryDelay(time.Millisecond)()
+
+	var serverURL string
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/invoices/in_123" {
+			w.Write([]byte(`{"id":"in_123","invoice_pdf":"` + serverURL + `/invoice/pdf"}`))
+			return
+		}
+		requests++
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer ts.Close()
+	serverURL = ts.URL
+
+	var buf bytes.Buffer
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	err := c.DownloadPDF("in_123", &buf, nil)
+	assert.EqualError(t, err, "PDF of invoice in_123 still not available after 5 attempts")
+	assert.Equal(t, pdfMaxAttempts, requests)
+	assert.Equal(t, 0, buf.Len())
+}
+
+func TestInvoiceDownloadPDF_Forbidden(t *testing.T) {
+	var serverURL string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/invoices/in_123" {
+			w.Write([]byte(`{"id":"in_123","invoice_pdf":"` + serverURL + `/invoice/pdf"}`))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+	serverURL = ts.URL
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	err := c.DownloadPDF("in_123", &bytes.Buffer{}, nil)
+	assert.EqualError(t, err, "error downloading PDF of invoice in_123: 403 Forbidden")
+}
+
+func TestInvoiceDownloadPDF_Retries(t *testing.T) {
+	defer setPDFRetryDelay(time.Millisecond)()
+
+	var serverURL string
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/invoices/in_123" {
+			w.Write([]byte(`{"id":"in_123","invoice_pdf":"` + serverURL + `/invoice/pdf"}`))
+			return
+		}
+		requests++
+		switch requests {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+		case 2:
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.Write([]byte("%PDF-1.4"))
+		}
+	}))
+	defer ts.Close()
+	serverURL = ts.URL
+
+	var buf bytes.Buffer
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	err := c.DownloadPDF("in_123", &buf, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, "%PDF-1.4", buf.String())
+}
+
+func setPDFRetryDelay(d time.Duration) func() {
+	previous := pdfRetryDelay
+	pdfRetryDelay = d
+	return func() { pdfRetryDelay = previous }
+}
@@ -32,6 +32,20 @@ func TestInvoiceParams_AppendTo(t *testing.T) {
 		t.Logf("body = %+v", body)
 		assert.Equal(t, []string{"now"}, body.Get("subscription_trial_end"))
 	}
+
+	{
+		params := &InvoiceParams{Rendering: &InvoiceRenderingParams{
+			AmountTaxDisplay: String(string(InvoiceRenderingAmountTaxDisplayIncludeInclusiveTax)),
+			PDF: &InvoiceRenderingPDFParams{
+				PageSize: String(string(InvoiceRenderingPDFPageSizeA4)),
+			},
+		}}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"include_inclusive_tax"}, body.Get("rendering[amount_tax_display]"))
+		assert.Equal(t, []string{"a4"}, body.Get("rendering[pdf][page_size]"))
+	}
 }
 
 func TestInvoice_Unmarshal(t *testing.T) {
@@ -186,3 +200,68 @@ func TestInvoice_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, "in_123", v.ID)
 	}
 }
+
+func TestInvoiceAutomaticTax(t *testing.T) {
+	{
+		params := &InvoiceParams{
+			AutomaticTax: &InvoiceAutomaticTaxParams{
+				Enabled: Bool(true),
+				Liability: &InvoiceAutomaticTaxLiabilityParams{
+					Account: String("acct_123"),
+					Type:    String(string(InvoiceAutomaticTaxLiabilityTypeAccount)),
+				},
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"true"}, body.Get("automatic_tax[enabled]"))
+		assert.Equal(t, []string{"acct_123"}, body.Get("automatic_tax[liability][account]"))
+		assert.Equal(t, []string{"account"}, body.Get("automatic_tax[liability][type]"))
+	}
+
+	{
+		data := []byte(`{
+			"id": "in_123",
+			"automatic_tax": {
+				"enabled": true,
+				"liability": {"account": "acct_123", "type": "account"},
+				"status": "requires_location_inputs"
+			}
+		}`)
+
+		var v Invoice
+		err := json.Unmarshal(data, &v)
+		assert.NoError(t, err)
+		assert.True(t, v.AutomaticTax.Enabled)
+		assert.Equal(t, "acct_123", v.AutomaticTax.Liability.Account.ID)
+		assert.Equal(t, InvoiceAutomaticTaxLiabilityTypeAccount, v.AutomaticTax.Liability.Type)
+		assert.Equal(t, InvoiceAutomaticTaxStatusRequiresLocationInputs, v.AutomaticTax.Status)
+	}
+}
+
+func TestInvoiceAutomaticFinalization(t *testing.T) {
+	{
+		params := &InvoiceParams{
+			AutoAdvance:              Bool(true),
+			AutomaticallyFinalizesAt: Int64(1700000000),
+			DaysUntilDue:             Int64(30),
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"true"}, body.Get("auto_advance"))
+		assert.Equal(t, []string{"1700000000"}, body.Get("automatically_finalizes_at"))
+		assert.Equal(t, []string{"30"}, body.Get("days_until_due"))
+	}
+
+	{
+		data := []byte(`{"id": "in_123", "auto_advance": true, "automatically_finalizes_at": 1700000000}`)
+
+		var v Invoice
+		err := json.Unmarshal(data, &v)
+		assert.NoError(t, err)
+		assert.True(t, v.AutoAdvance)
+		assert.Equal(t, int64(1700000000), v.AutomaticallyFinalizesAt)
+	}
+}
@@ -117,5 +117,5 @@ func (i *Iter) InvoiceItemList() *stripe.InvoiceItemList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -112,5 +112,5 @@ func (i *Iter) IssuingAuthorizationList() *stripe.IssuingAuthorizationList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
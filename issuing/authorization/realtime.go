@@ -0,0 +1,200 @@
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/webhook"
+)
+
+//
+// Public constants
+//
+
+const (
+	// DecisionAPIVersion is the API version sent in the `Stripe-Version`
+	// header of responses to `issuing_authorization.request` webhooks. It's
+	// the first version in which Stripe reads the decision from the body of
+	// the response, and it's pinned apart from stripe.APIVersion, which
+	// predates it.
+	DecisionAPIVersion = "2025-01-27.acacia"
+
+	// DefaultDecisionTimeout is how long RequestHandler gives Decide by
+	// default. Stripe waits 2 seconds for the response to an
+	// `issuing_authorization.request` webhook, so this leaves time for the
+	// response to reach it.
+	DefaultDecisionTimeout = 1500 * time.Millisecond
+
+	// RequestEventType is the type of the events Stripe sends to ask for an
+	// authorization to be approved or declined in real time.
+	RequestEventType = "issuing_authorization.request"
+)
+
+//
+// Public types
+//
+
+// Decision is the response to an `issuing_authorization.request` webhook.
+type Decision struct {
+	// Amount is the amount to hold if the authorization is approved, in the
+	// card's currency. It can only be set if the pending request's
+	// IsAmountControllable is true, and must be positive. Defaults to the
+	// requested amount.
+	Amount *int64 `json:"amount,omitempty"`
+
+	// Approved is whether the authorization is approved.
+	Approved bool `json:"approved"`
+
+	// Metadata is set on the authorization.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// RequestHandler is an http.Handler for the endpoint that receives
+// `issuing_authorization.request` webhooks, which approves or declines
+// authorizations in real time by responding to the webhook, without calling
+// Approve or Decline:
+//
+//	http.Handle("/webhooks/issuing", &authorization.RequestHandler{
+//		Decide: func(ctx context.Context, auth *stripe.IssuingAuthorization) (*authorization.Decision, error) {
+//			return &authorization.Decision{Approved: auth.PendingRequest.Amount <= 10000}, nil
+//		},
+//		Secret: "whsec_...",
+//	})
+//
+// Events of other types are acknowledged without calling Decide. If Decide
+// returns an error, or a decision that's invalid for the authorization, the
+// handler responds with a 500 status code, and Stripe approves or declines the
+// authorization following the Issuing settings of the account.
+type RequestHandler struct {
+	// Decide approves or declines an authorization. ctx is done once Timeout
+	// has passed, or if the webhook's request is canceled.
+	Decide func(ctx context.Context, authorization *stripe.IssuingAuthorization) (*Decision, error)
+
+	// Secret is the signing secret of the webhook endpoint, used to check that
+	// events were sent by Stripe.
+	Secret string
+
+	// Timeout is how long Decide has to make a decision. Defaults to
+	// DefaultDecisionTimeout.
+	Timeout time.Duration
+}
+
+// ServeHTTP checks the signature of the webhook's event and responds with the
+// decision made by Decide.
+func (h *RequestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := webhook.ConstructEvent(payload, r.Header.Get("Stripe-Signature"), h.Secret)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if event.Type != RequestEventType {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	authorization, err := ParseRequestEvent(&event)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	timeout := h.Timeout
+	if timeout == 0 {
+		timeout = DefaultDecisionTimeout
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	decision, err := h.Decide(ctx, authorization)
+	if err == nil {
+		err = validateDecision(authorization, decision)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Writing can only fail if the connection was lost, which leaves
+	// nothing to report the error to
+	RespondToRequest(w, decision)
+}
+
+//
+// Public functions
+//
+
+// ParseRequestEvent returns the authorization of an
+// `issuing_authorization.request` event, whose PendingRequest describes what
+// needs to be approved or declined.
+func ParseRequestEvent(event *stripe.Event) (*stripe.IssuingAuthorization, error) {
+	if event.Type != RequestEventType {
+		return nil, fmt.Errorf("event %s is a %s event, not a %s event", event.ID, event.Type, RequestEventType)
+	}
+	if event.Data == nil || len(event.Data.Raw) == 0 {
+		return nil, fmt.Errorf("event %s has no data", event.ID)
+	}
+
+	authorization := &stripe.IssuingAuthorization{}
+	if err := json.Unmarshal(event.Data.Raw, authorization); err != nil {
+		return nil, fmt.Errorf("error parsing the authorization of event %s: %v", event.ID, err)
+	}
+	if authorization.PendingRequest == nil {
+		return nil, fmt.Errorf("authorization %s of event %s has no pending request", authorization.ID, event.ID)
+	}
+	return authorization, nil
+}
+
+// RespondToRequest writes decision as the response to an
+// `issuing_authorization.request` webhook, with DecisionAPIVersion as its
+// `Stripe-Version` header. It must be called before anything else is written
+// to w.
+func RespondToRequest(w http.ResponseWriter, decision *Decision) error {
+	body, err := json.Marshal(decision)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Stripe-Version", DecisionAPIVersion)
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(body)
+	return err
+}
+
+//
+// Private constants
+//
+
+// maxPayloadBytes protects against clients streaming an endless request body.
+const maxPayloadBytes = 65536
+
+//
+// Private functions
+//
+
+func validateDecision(authorization *stripe.IssuingAuthorization, decision *Decision) error {
+	if decision == nil {
+		return fmt.Errorf("no decision was made for authorization %s", authorization.ID)
+	}
+	if decision.Amount == nil || !decision.Approved {
+		return nil
+	}
+	if !authorization.PendingRequest.IsAmountControllable {
+		return fmt.Errorf("the amount of authorization %s can't be controlled", authorization.ID)
+	}
+	if *decision.Amount <= 0 {
+		return fmt.Errorf("the amount to approve for authorization %s must be positive, got %d", authorization.ID, *decision.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/webhook"
+)
+
+const testSecret = "whsec_test_secret"
+
+func newRequestEventPayload(eventType string, isAmountControllable bool) []byte {
+	return []byte(fmt.Sprintf(`{
+		"id": "evt_123",
+		"object": "event",
+		"type": %q,
+		"data": {
+			"object": {
+				"id": "iauth_123",
+				"object": "issuing.authorization",
+				"pending_request": {
+					"amount": 1000,
+					"currency": "usd",
+					"is_amount_controllable": %t
+				}
+			}
+		}
+	}`, eventType, isAmountControllable))
+}
+
+func newSignedRequest(payload []byte, secret string) *http.Request {
+	now := time.Now()
+	signature := hex.EncodeToString(webhook.ComputeSignature(now, payload, secret))
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/issuing", bytes.NewReader(payload))
+	req.Header.Set("Stripe-Signature", fmt.Sprintf("t=%d,v1=%s", now.Unix(), signature))
+	return req
+}
+
+func TestParseRequestEvent(t *testing.T) {
+	var event stripe.Event
+	err := json.Unmarshal(newRequestEventPayload(RequestEventType, true), &event)
+	assert.NoError(t, err)
+
+	authorization, err := ParseRequestEvent(&event)
+	assert.NoError(t, err)
+	assert.Equal(t, "iauth_123", authorization.ID)
+	assert.Equal(t, int64(1000), authorization.PendingRequest.Amount)
+	assert.True(t, authorization.PendingRequest.IsAmountControllable)
+
+	event.Type = "issuing_authorization.created"
+	_, err = ParseRequestEvent(&event)
+	assert.Error(t, err)
+}
+
+func TestRequestHandler(t *testing.T) {
+	handler := &RequestHandler{
+		Decide: func(ctx context.Context, authorization *stripe.IssuingAuthorization) (*Decision, error) {
+			_, ok := ctx.Deadline()
+			assert.True(t, ok)
+			return &Decision{
+				Amount:   stripe.Int64(authorization.PendingRequest.Amount / 2),
+				Approved: true,
+				Metadata: map[string]string{"rule": "half"},
+			}, nil
+		},
+		Secret: testSecret,
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newSignedRequest(newRequestEventPayload(RequestEventType, true), testSecret))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2025-01-27.acacia", w.Header().Get("Stripe-Version"))
+	assert.JSONEq(t, `{"amount":500,"approved":true,"metadata":{"rule":"half"}}`, w.Body.String())
+
+	// The amount of the authorization can't be controlled
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newSignedRequest(newRequestEventPayload(RequestEventType, false), testSecret))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestRequestHandler_Decline(t *testing.T) {
+	handler := &RequestHandler{
+		Decide: func(ctx context.Context, authorization *stripe.IssuingAuthorization) (*Decision, error) {
+			return &Decision{Approved: false}, nil
+		},
+		Secret: testSecret,
+	}
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newSignedRequest(newRequestEventPayload(RequestEventType, false), testSecret))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"approved":false}`, w.Body.String())
+}
+
+func TestRequestHandler_Errors(t *testing.T) {
+	decided := false
+	handler := &RequestHandler{
+		Decide: func(ctx context.Context, authorization *stripe.IssuingAuthorization) (*Decision, error) {
+			decided = true
+			return nil, errors.New("rules are unavailable")
+		},
+		Secret: testSecret,
+	}
+
+	// Bad signature
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newSignedRequest(newRequestEventPayload(RequestEventType, false), "whsec_other"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.False(t, decided)
+
+	// Other event types are acknowledged
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newSignedRequest(newRequestEventPayload("issuing_authorization.created", false), testSecret))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, decided)
+
+	// Decide fails
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, newSignedRequest(newRequestEventPayload(RequestEventType, false), testSecret))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.True(t, decided)
+}
@@ -8,6 +8,7 @@
 package card
 
 import (
+	"context"
 	"net/http"
 
 	stripe "github.com/stripe/stripe-go/v72"
@@ -39,6 +40,10 @@ func Get(id string, params *stripe.IssuingCardParams) (*stripe.IssuingCard, erro
 
 // Get returns the details of an issuing card.
 func (c Client) Get(id string, params *stripe.IssuingCardParams) (*stripe.IssuingCard, error) {
+	if params != nil && stripe.BoolValue(params.IncludeSensitiveDetails) {
+		params = withSensitiveDetails(params)
+	}
+
 	path := stripe.FormatURLPath("/v1/issuing/cards/%s", id)
 	card := &stripe.IssuingCard{}
 	err := c.B.Call(http.MethodGet, path, c.Key, params, card)
@@ -97,6 +102,23 @@ func (i *Iter) IssuingCardList() *stripe.IssuingCardList {
 	return i.List().(*stripe.IssuingCardList)
 }
 
+// withSensitiveDetails returns a copy of params that expands the card's number
+// and CVC, and keeps them out of logs.
+func withSensitiveDetails(params *stripe.IssuingCardParams) *stripe.IssuingCardParams {
+	detailed := *params
+	detailed.Expand = append([]*string{}, params.Expand...)
+	detailed.AddExpand("cvc")
+	detailed.AddExpand("number")
+
+	ctx := params.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	detailed.Context = stripe.WithSensitiveResponse(ctx)
+
+	return &detailed
+}
+
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
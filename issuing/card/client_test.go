@@ -1,10 +1,13 @@
 package card
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
 	_ "github.com/stripe/stripe-go/v72/testing"
 )
 
@@ -15,6 +18,28 @@ func TestIssuingCardGet(t *testing.T) {
 	assert.Equal(t, "issuing.card", card.Object)
 }
 
+func TestIssuingCardGet_IncludeSensitiveDetails(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cvc", r.URL.Query().Get("expand[0]"))
+		assert.Equal(t, "number", r.URL.Query().Get("expand[1]"))
+		w.Write([]byte(`{"id":"ic_123","object":"issuing.card","number":"4242424242424242","cvc":"123"}`))
+	}))
+	defer ts.Close()
+
+	backend := stripetest.NewBackend(ts.URL)
+	c := Client{B: backend, Key: "sk_test_123"}
+
+	params := &stripe.IssuingCardParams{IncludeSensitiveDetails: stripe.Bool(true)}
+	card, err := c.Get("ic_123", params)
+	assert.Nil(t, err)
+	assert.Equal(t, "4242424242424242", card.Number)
+	assert.Equal(t, "123", card.CVC)
+
+	// The caller's params are left untouched
+	assert.Nil(t, params.Expand)
+	assert.Nil(t, params.Context)
+}
+
 func TestIssuingCardList(t *testing.T) {
 	i := List(&stripe.IssuingCardListParams{})
 
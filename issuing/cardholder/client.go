@@ -105,5 +105,5 @@ func (i *Iter) IssuingCardholderList() *stripe.IssuingCardholderList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,52 @@
+package personalizationdesign
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	_ "github.com/stripe/stripe-go/v72/testing"
+)
+
+func TestIssuingPersonalizationDesignGet(t *testing.T) {
+	design, err := Get("pd_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, design)
+	assert.Equal(t, "issuing.personalization_design", design.Object)
+}
+
+func TestIssuingPersonalizationDesignList(t *testing.T) {
+	i := List(&stripe.IssuingPersonalizationDesignListParams{
+		LookupKeys: stripe.StringSlice([]string{"default"}),
+	})
+
+	// Verify that we can get at least one design
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IssuingPersonalizationDesign())
+	assert.Equal(t, "issuing.personalization_design", i.IssuingPersonalizationDesign().Object)
+	assert.NotNil(t, i.IssuingPersonalizationDesignList())
+}
+
+func TestIssuingPersonalizationDesignNew(t *testing.T) {
+	design, err := New(&stripe.IssuingPersonalizationDesignParams{
+		Name:           stripe.String("Default design"),
+		PhysicalBundle: stripe.String("ics_123"),
+		CarrierText: &stripe.IssuingPersonalizationDesignCarrierTextParams{
+			HeaderTitle: stripe.String("Welcome"),
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, design)
+	assert.Equal(t, "issuing.personalization_design", design.Object)
+}
+
+func TestIssuingPersonalizationDesignUpdate(t *testing.T) {
+	design, err := Update("pd_123", &stripe.IssuingPersonalizationDesignParams{
+		LookupKey:         stripe.String("default"),
+		TransferLookupKey: stripe.Bool(true),
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, design)
+	assert.Equal(t, "issuing.personalization_design", design.Object)
+}
@@ -0,0 +1,29 @@
+package physicalbundle
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	_ "github.com/stripe/stripe-go/v72/testing"
+)
+
+func TestIssuingPhysicalBundleGet(t *testing.T) {
+	bundle, err := Get("ics_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, bundle)
+	assert.Equal(t, "issuing.physical_bundle", bundle.Object)
+}
+
+func TestIssuingPhysicalBundleList(t *testing.T) {
+	i := List(&stripe.IssuingPhysicalBundleListParams{
+		Type: stripe.String(string(stripe.IssuingPhysicalBundleTypeStandard)),
+	})
+
+	// Verify that we can get at least one bundle
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IssuingPhysicalBundle())
+	assert.Equal(t, "issuing.physical_bundle", i.IssuingPhysicalBundle().Object)
+	assert.NotNil(t, i.IssuingPhysicalBundleList())
+}
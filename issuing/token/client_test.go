@@ -0,0 +1,38 @@
+package token
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	_ "github.com/stripe/stripe-go/v72/testing"
+)
+
+func TestIssuingTokenGet(t *testing.T) {
+	token, err := Get("intok_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, token)
+	assert.Equal(t, "issuing.token", token.Object)
+}
+
+func TestIssuingTokenList(t *testing.T) {
+	i := List(&stripe.IssuingTokenListParams{
+		Card: stripe.String("ic_123"),
+	})
+
+	// Verify that we can get at least one token
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.IssuingToken())
+	assert.Equal(t, "issuing.token", i.IssuingToken().Object)
+	assert.NotNil(t, i.IssuingTokenList())
+}
+
+func TestIssuingTokenUpdate(t *testing.T) {
+	token, err := Update("intok_123", &stripe.IssuingTokenParams{
+		Status: stripe.String(string(stripe.IssuingTokenStatusSuspended)),
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, token)
+	assert.Equal(t, "issuing.token", token.Object)
+}
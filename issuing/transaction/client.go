@@ -86,5 +86,5 @@ func (i *Iter) IssuingTransactionList() *stripe.IssuingTransactionList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
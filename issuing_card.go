@@ -202,6 +202,11 @@ type IssuingCardParams struct {
 	// The following parameter is only supported when updating a card
 	// Reason why the `status` of this card is `canceled`.
 	CancellationReason *string `form:"cancellation_reason"`
+
+	// IncludeSensitiveDetails opts into retrieving the card's full number and
+	// CVC when getting a card, which is only possible for virtual cards and
+	// requires the account to be PCI compliant. The response isn't logged.
+	IncludeSensitiveDetails *bool `form:"-"` // Not an API parameter
 }
 
 // The desired new PIN for this card.
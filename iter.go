@@ -1,7 +1,12 @@
 package stripe
 
 import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/stripe/stripe-go/v72/form"
 )
@@ -16,8 +21,18 @@ import (
 // Successive calls to the Next method
 // will step through each item in the list,
 // fetching pages of items as needed.
+//
+// Pages are fetched forward from ListParams.StartingAfter, or from the start
+// of the list if it's not set. If ListParams.EndingBefore is set instead,
+// pages are fetched backward from it, and the items of each page are visited
+// in reverse so that the iteration moves steadily away from the cursor. The
+// metadata of the last page fetched, including HasMore and TotalCount, is
+// available through Meta.
+//
 // Iterators are not thread-safe, so they should not be consumed
-// across multiple goroutines.
+// across multiple goroutines. Independent iterators share no state other
+// than their backend, which is safe for concurrent use, so each goroutine can
+// run its own.
 type Iter struct {
 	cur        interface{}
 	err        error
@@ -25,10 +40,47 @@ type Iter struct {
 	list       ListContainer
 	listParams ListParams
 	meta       *ListMeta
+	progress   PageProgress
 	query      Query
 	values     []interface{}
 }
 
+// AutoPagingEach calls f with every remaining item in the list, fetching
+// pages as needed, until the list ends or f returns an error. It returns the
+// error returned by f, or the error that stopped the iterator, if any. Items
+// can be converted to their type with a type assertion:
+//
+//	i := balancetransaction.List(&stripe.BalanceTransactionListParams{
+//		Payout: stripe.String("po_123"),
+//	})
+//	err := i.AutoPagingEach(func(item interface{}) error {
+//		txn := item.(*stripe.BalanceTransaction)
+//		...
+//	})
+func (it *Iter) AutoPagingEach(f func(item interface{}) error) error {
+	for it.Next() {
+		if err := f(it.Current()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+// Collect gathers up to limit items, fetching further pages as needed, or all
+// the remaining items if limit is 0 or less. It stops at the first error of
+// the iterator, and returns the items gathered until then along with it.
+//
+// Pages aren't fetched past the one holding the last item needed, but a
+// page's items are all requested, so ListParams.Limit can be set too to avoid
+// fetching more items than needed.
+func (it *Iter) Collect(limit int) ([]interface{}, error) {
+	var items []interface{}
+	for (limit <= 0 || len(items) < limit) && it.Next() {
+		items = append(items, it.Current())
+	}
+	return items, it.Err()
+}
+
 // Current returns the most recent item
 // visited by a call to Next.
 func (it *Iter) Current() interface{} {
@@ -54,6 +106,11 @@ func (it *Iter) Meta() *ListMeta {
 	return it.meta
 }
 
+// Progress returns information about the pages fetched so far.
+func (it *Iter) Progress() PageProgress {
+	return it.progress
+}
+
 // Next advances the Iter to the next item in the list,
 // which will then be available
 // through the Current method.
@@ -61,15 +118,7 @@ func (it *Iter) Meta() *ListMeta {
 // at the end of the list.
 func (it *Iter) Next() bool {
 	if len(it.values) == 0 && it.meta.HasMore && !it.listParams.Single {
-		// determine if we're moving forward or backwards in paging
-		if it.listParams.EndingBefore != nil {
-			it.listParams.EndingBefore = String(listItemID(it.cur))
-			it.formValues.Set(EndingBefore, *it.listParams.EndingBefore)
-		} else {
-			it.listParams.StartingAfter = String(listItemID(it.cur))
-			it.formValues.Set(StartingAfter, *it.listParams.StartingAfter)
-		}
-		it.getPage()
+		it.getNextPage()
 	}
 	if len(it.values) == 0 {
 		return false
@@ -79,8 +128,24 @@ func (it *Iter) Next() bool {
 	return true
 }
 
+func (it *Iter) getNextPage() {
+	// determine if we're moving forward or backwards in paging
+	if it.listParams.EndingBefore != nil {
+		it.listParams.EndingBefore = String(listItemID(it.cur))
+		it.formValues.Set(EndingBefore, *it.listParams.EndingBefore)
+	} else {
+		it.listParams.StartingAfter = String(listItemID(it.cur))
+		it.formValues.Set(StartingAfter, *it.listParams.StartingAfter)
+	}
+	it.getPage()
+}
+
 func (it *Iter) getPage() {
-	it.values, it.list, it.err = it.query(it.listParams.GetParams(), it.formValues)
+	it.err = fetchWithPageRetries(it.listParams.Context, it.listParams.PageRetries, func() error {
+		var err error
+		it.values, it.list, err = it.query(it.listParams.GetParams(), it.formValues)
+		return err
+	})
 	it.meta = it.list.GetListMeta()
 
 	if it.listParams.EndingBefore != nil {
@@ -88,6 +153,44 @@ func (it *Iter) getPage() {
 		// but items arrive in forward order.
 		reverse(it.values)
 	}
+
+	if it.err == nil {
+		it.progress.advance(len(it.values), it.meta.HasMore)
+		if it.meta.TotalCount != 0 {
+			it.progress.TotalCount = &it.meta.TotalCount
+		}
+		if it.listParams.OnPage != nil {
+			it.listParams.OnPage(it.progress)
+		}
+	}
+}
+
+// PageProgress describes how far an iterator has progressed through a
+// paginated list or search, as reported by the iterator's Progress method and
+// the OnPage callback of ListParams and SearchParams.
+type PageProgress struct {
+	// HasMore is whether there are more pages left to fetch.
+	HasMore bool
+
+	// ItemsFetched is the total number of items fetched so far, across all
+	// pages.
+	ItemsFetched int
+
+	// Page is the number of pages fetched so far, starting at 1 for the first
+	// page.
+	Page int
+
+	// TotalCount is the total number of items in the list or search results,
+	// which can be used to estimate completion. It's only available in some
+	// legacy list calls, and for searches when `total_count` is expanded, and
+	// is nil otherwise.
+	TotalCount *uint32
+}
+
+func (p *PageProgress) advance(items int, hasMore bool) {
+	p.HasMore = hasMore
+	p.ItemsFetched += items
+	p.Page++
 }
 
 // Query is the function used to get a page listing.
@@ -126,10 +229,68 @@ func GetIter(container ListParamsContainer, query Query) *Iter {
 	return iter
 }
 
+//
+// Private variables
+//
+
+// pageRetryDelay is the time waited before the first retry of a page that
+// failed to be fetched. It's doubled for every subsequent retry, up to
+// maxNetworkRetriesDelay. It's a variable so that tests can shorten it.
+var pageRetryDelay = minNetworkRetriesDelay
+
 //
 // Private functions
 //
 
+// fetchWithPageRetries calls fetch until it succeeds, fails with an error
+// that isn't retryable, or has been retried the given number of times. It
+// returns the error of the last attempt.
+func fetchWithPageRetries(ctx context.Context, retries *int64, fetch func() error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	delay := pageRetryDelay
+	for attempt := int64(0); ; attempt++ {
+		err := fetch()
+		if err == nil || attempt >= Int64Value(retries) || !isRetryablePageError(err) {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		delay *= 2
+		if delay > maxNetworkRetriesDelay {
+			delay = maxNetworkRetriesDelay
+		}
+	}
+}
+
+// isRetryablePageError reports whether a page that failed to be fetched with
+// err is worth fetching again: network errors, including timeouts, and API
+// errors with a 429 or 5xx status, which are usually temporary. Other errors,
+// like a response that couldn't be decoded, would fail again the same way.
+// Errors caused by the iteration's context being done aren't retried either.
+func isRetryablePageError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if stripeErr, ok := err.(*Error); ok {
+		return stripeErr.HTTPStatusCode == http.StatusTooManyRequests ||
+			stripeErr.HTTPStatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
 func listItemID(x interface{}) string {
 	return reflect.ValueOf(x).Elem().FieldByName("ID").String()
 }
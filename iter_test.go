@@ -1,8 +1,13 @@
 package stripe
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	assert "github.com/stretchr/testify/require"
 	"github.com/stripe/stripe-go/v72/form"
@@ -130,6 +135,68 @@ func TestReverse(t *testing.T) {
 	}
 }
 
+func TestIterCollect(t *testing.T) {
+	tq := testQuery{
+		{[]interface{}{&item{"x"}, &item{"y"}}, &ListMeta{HasMore: true}, nil},
+		{[]interface{}{&item{"z"}}, &ListMeta{HasMore: false}, nil},
+	}
+
+	items, err := GetIter(nil, tq.query).Collect(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{&item{"x"}, &item{"y"}, &item{"z"}}, items)
+
+	// The second page isn't fetched when the first one has enough items
+	tq = testQuery{
+		{[]interface{}{&item{"x"}, &item{"y"}}, &ListMeta{HasMore: true}, nil},
+		{nil, &ListMeta{}, errTest},
+	}
+	items, err = GetIter(nil, tq.query).Collect(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{&item{"x"}, &item{"y"}}, items)
+
+	// Returns the items gathered before an error along with it
+	tq = testQuery{
+		{[]interface{}{&item{"x"}}, &ListMeta{HasMore: true}, nil},
+		{nil, &ListMeta{}, errTest},
+	}
+	items, err = GetIter(nil, tq.query).Collect(5)
+	assert.Equal(t, errTest, err)
+	assert.Equal(t, []interface{}{&item{"x"}}, items)
+}
+
+func TestIterAutoPagingEach(t *testing.T) {
+	tq := testQuery{
+		{[]interface{}{&item{"x"}, &item{"y"}}, &ListMeta{HasMore: true}, nil},
+		{[]interface{}{&item{"z"}}, &ListMeta{HasMore: false}, nil},
+	}
+
+	var items []interface{}
+	err := GetIter(nil, tq.query).AutoPagingEach(func(item interface{}) error {
+		items = append(items, item)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{&item{"x"}, &item{"y"}, &item{"z"}}, items)
+
+	// Stops at the first error returned by the callback
+	tq = testQuery{{[]interface{}{1, 2}, &ListMeta{}, nil}}
+	items = nil
+	stop := errors.New("stop")
+	err = GetIter(nil, tq.query).AutoPagingEach(func(item interface{}) error {
+		items = append(items, item)
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, []interface{}{1}, items)
+
+	// Returns the error of the iterator
+	tq = testQuery{{nil, &ListMeta{}, errTest}}
+	err = GetIter(nil, tq.query).AutoPagingEach(func(item interface{}) error {
+		return nil
+	})
+	assert.Equal(t, errTest, err)
+}
+
 func TestIterListAndMeta(t *testing.T) {
 	type listType struct {
 		ListMeta
@@ -143,6 +210,145 @@ func TestIterListAndMeta(t *testing.T) {
 	assert.Equal(t, listMeta, it.Meta())
 }
 
+func TestIterProgress(t *testing.T) {
+	tq := testQuery{
+		{[]interface{}{&item{"x"}, &item{"y"}}, &ListMeta{HasMore: true, TotalCount: 3}, nil},
+		{[]interface{}{3}, &ListMeta{HasMore: false, TotalCount: 3}, nil},
+	}
+
+	var pages []PageProgress
+	params := &ListParams{
+		OnPage: func(p PageProgress) {
+			pages = append(pages, p)
+		},
+	}
+	it := GetIter(params, tq.query)
+	_, err := collect(it)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(pages))
+	assert.Equal(t, 1, pages[0].Page)
+	assert.Equal(t, 2, pages[0].ItemsFetched)
+	assert.True(t, pages[0].HasMore)
+	assert.Equal(t, uint32(3), *pages[0].TotalCount)
+	assert.Equal(t, 2, pages[1].Page)
+	assert.Equal(t, 3, pages[1].ItemsFetched)
+	assert.False(t, pages[1].HasMore)
+	assert.Equal(t, pages[1], it.Progress())
+}
+
+func TestIterProgressErr(t *testing.T) {
+	tq := testQuery{{nil, &ListMeta{}, errTest}}
+
+	called := false
+	params := &ListParams{
+		OnPage: func(p PageProgress) {
+			called = true
+		},
+	}
+	it := GetIter(params, tq.query)
+	assert.False(t, called)
+	assert.Equal(t, 0, it.Progress().Page)
+	assert.Nil(t, it.Progress().TotalCount)
+}
+
+func TestIterPageRetries(t *testing.T) {
+	defer func(d time.Duration) { pageRetryDelay = d }(pageRetryDelay)
+	pageRetryDelay = time.Millisecond
+
+	errServer := &Error{HTTPStatusCode: http.StatusInternalServerError}
+	tq := testQuery{
+		{[]interface{}{&item{"x"}}, &ListMeta{HasMore: true}, nil},
+		{nil, &ListMeta{}, errServer},
+		{nil, &ListMeta{}, errServer},
+		{[]interface{}{2}, &ListMeta{}, nil},
+	}
+
+	var pages []PageProgress
+	params := &ListParams{
+		OnPage: func(p PageProgress) {
+			pages = append(pages, p)
+		},
+		PageRetries: Int64(2),
+	}
+	g, gerr := collect(GetIter(params, tq.query))
+	assert.Equal(t, 0, len(tq))
+	assert.Equal(t, []interface{}{&item{"x"}, 2}, g)
+	assert.NoError(t, gerr)
+
+	// Failed attempts aren't reported as pages
+	assert.Equal(t, 2, len(pages))
+	assert.Equal(t, 2, pages[1].Page)
+}
+
+func TestIterPageRetriesExhausted(t *testing.T) {
+	defer func(d time.Duration) { pageRetryDelay = d }(pageRetryDelay)
+	pageRetryDelay = time.Millisecond
+
+	errNetwork := &url.Error{Op: "Get", URL: "/v1/charges", Err: errors.New("connection reset by peer")}
+	tq := testQuery{
+		{[]interface{}{&item{"x"}}, &ListMeta{HasMore: true}, nil},
+		{nil, &ListMeta{}, errNetwork},
+		{nil, &ListMeta{}, errNetwork},
+		{[]interface{}{2}, &ListMeta{}, nil},
+	}
+	g, gerr := collect(GetIter(&ListParams{PageRetries: Int64(1)}, tq.query))
+	assert.Equal(t, 1, len(tq))
+	assert.Equal(t, []interface{}{&item{"x"}}, g)
+	assert.Equal(t, errNetwork, gerr)
+}
+
+func TestIterPageRetriesNotRetryable(t *testing.T) {
+	errInvalid := &Error{HTTPStatusCode: http.StatusBadRequest}
+	tq := testQuery{
+		{nil, &ListMeta{}, errInvalid},
+		{[]interface{}{1}, &ListMeta{}, nil},
+	}
+	g, gerr := collect(GetIter(&ListParams{PageRetries: Int64(3)}, tq.query))
+	assert.Equal(t, 1, len(tq))
+	assert.Equal(t, 0, len(g))
+	assert.Equal(t, errInvalid, gerr)
+}
+
+func TestIterPageRetriesDecodeError(t *testing.T) {
+	defer func(d time.Duration) { pageRetryDelay = d }(pageRetryDelay)
+	pageRetryDelay = time.Millisecond
+
+	requests := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"object":"list","data":[`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(APIBackend, &BackendConfig{
+		LeveledLogger: nullLeveledLogger,
+		URL:           String(testServer.URL),
+	})
+	it := GetIter(&ListParams{PageRetries: Int64(3)}, func(p *Params, b *form.Values) ([]interface{}, ListContainer, error) {
+		list := &ChargeList{}
+		err := backend.CallRaw(http.MethodGet, "/v1/charges", "sk_test_123", b, p, list)
+		return nil, list, err
+	})
+
+	// The page is fetched once, since it would fail to decode again
+	assert.False(t, it.Next())
+	assert.Contains(t, it.Err().Error(), "Couldn't deserialize JSON")
+	assert.Equal(t, 1, requests)
+}
+
+func TestIsRetryablePageError(t *testing.T) {
+	assert.True(t, isRetryablePageError(&url.Error{Op: "Get", URL: "/v1/charges", Err: errors.New("connection refused")}))
+	assert.True(t, isRetryablePageError(&Error{HTTPStatusCode: http.StatusServiceUnavailable}))
+	assert.True(t, isRetryablePageError(&Error{HTTPStatusCode: http.StatusTooManyRequests, Code: ErrorCodeLockTimeout}))
+	assert.True(t, isRetryablePageError(&Error{HTTPStatusCode: http.StatusTooManyRequests, Code: ErrorCodeRateLimit}))
+	assert.False(t, isRetryablePageError(&Error{HTTPStatusCode: http.StatusConflict}))
+	assert.False(t, isRetryablePageError(&Error{HTTPStatusCode: http.StatusNotFound}))
+	assert.False(t, isRetryablePageError(errTest))
+	assert.False(t, isRetryablePageError(context.Canceled))
+	assert.False(t, isRetryablePageError(&url.Error{Op: "Get", URL: "/v1/charges", Err: context.DeadlineExceeded}))
+}
+
 //
 // ---
 //
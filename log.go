@@ -3,6 +3,7 @@ package stripe
 import (
 	"fmt"
 	"io"
+	"log"
 	"os"
 )
 
@@ -46,6 +47,10 @@ const (
 // This Logger will be inherited by any backends created by default, but will
 // be overridden if a backend is created with GetBackendWithConfig with a
 // custom LeveledLogger set.
+//
+// Assigning DefaultLeveledLogger while backends are being created is a data
+// race. Use SetDefaultLeveledLogger instead when the logger may change at
+// runtime.
 var DefaultLeveledLogger LeveledLoggerInterface = &LeveledLogger{
 	Level: LevelError,
 }
@@ -121,6 +126,60 @@ func (l *LeveledLogger) stdout() io.Writer {
 	return os.Stdout
 }
 
+// StdLeveledLogger is a leveled logger that writes to a logger from the
+// standard library's log package, so that the library's messages share the
+// output, prefix and flags of an application's other log messages.
+type StdLeveledLogger struct {
+	// Level is the minimum logging level that will be emitted by this logger.
+	//
+	// Always set this with a constant like LevelWarn because the individual
+	// values are not guaranteed to be stable.
+	Level Level
+
+	// Logger is the logger that messages are written to. Defaults to the
+	// standard logger of the log package.
+	Logger *log.Logger
+}
+
+// Debugf logs a debug message using Printf conventions.
+func (l *StdLeveledLogger) Debugf(format string, v ...interface{}) {
+	if l.Level >= LevelDebug {
+		l.printf("[DEBUG] "+format, v...)
+	}
+}
+
+// Errorf logs an error message using Printf conventions.
+func (l *StdLeveledLogger) Errorf(format string, v ...interface{}) {
+	if l.Level >= LevelError {
+		l.printf("[ERROR] "+format, v...)
+	}
+}
+
+// Infof logs an informational message using Printf conventions.
+func (l *StdLeveledLogger) Infof(format string, v ...interface{}) {
+	if l.Level >= LevelInfo {
+		l.printf("[INFO] "+format, v...)
+	}
+}
+
+// Warnf logs a warning message using Printf conventions.
+func (l *StdLeveledLogger) Warnf(format string, v ...interface{}) {
+	if l.Level >= LevelWarn {
+		l.printf("[WARN] "+format, v...)
+	}
+}
+
+func (l *StdLeveledLogger) printf(format string, v ...interface{}) {
+	if l.Logger == nil {
+		// Output rather than Printf so that the call depth matches the
+		// one of a configured Logger
+		log.Output(3, fmt.Sprintf(format, v...))
+		return
+	}
+
+	l.Logger.Output(3, fmt.Sprintf(format, v...))
+}
+
 // LeveledLoggerInterface provides a basic leveled logging interface for
 // printing debug, informational, warning, and error messages.
 //
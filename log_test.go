@@ -2,6 +2,7 @@ package stripe
 
 import (
 	"bytes"
+	"log"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
@@ -106,6 +107,32 @@ func TestLeveledLoggerErrorf(t *testing.T) {
 	}
 }
 
+//
+// StdLeveledLogger
+//
+
+func TestStdLeveledLogger(t *testing.T) {
+	var out bytes.Buffer
+	logger := &StdLeveledLogger{Level: LevelWarn, Logger: log.New(&out, "app: ", 0)}
+
+	logger.Debugf("debug")
+	logger.Infof("info")
+	assert.Equal(t, "", out.String())
+
+	logger.Warnf("test %d", 1)
+	logger.Errorf("test %d", 2)
+	assert.Equal(t, "app: [WARN] test 1\napp: [ERROR] test 2\n", out.String())
+}
+
+func TestStdLeveledLogger_CallDepth(t *testing.T) {
+	var out bytes.Buffer
+	logger := &StdLeveledLogger{Level: LevelError, Logger: log.New(&out, "", log.Lshortfile)}
+
+	// The file reported is the caller's, not log.go
+	logger.Errorf("test")
+	assert.Regexp(t, `^log_test\.go:\d+: \[ERROR\] test\n$`, out.String())
+}
+
 //
 // Private functions
 //
@@ -40,5 +40,5 @@ func (c Client) New(params *stripe.LoginLinkParams) (*stripe.LoginLink, error) {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -33,5 +33,5 @@ func (c Client) Get(id string, params *stripe.MandateParams) (*stripe.Mandate, e
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,213 @@
+package stripe
+
+import "context"
+
+// The helpers in this file follow the links between related resources, like
+// from an invoice to its subscription, fetching the linked resource if it
+// wasn't expanded in the original response. They're built on the Fetch
+// methods of each resource, so a linked resource is only retrieved once.
+//
+// Each helper returns nil without making a request if the link isn't set, for
+// example for an invoice that doesn't belong to a subscription.
+
+//
+// Public functions
+//
+
+// FetchBalanceTransaction returns the balance transaction of the charge, retrieving it if needed.
+func (c *Charge) FetchBalanceTransaction(ctx context.Context, b Backend, key string) (*BalanceTransaction, error) {
+	if c.BalanceTransaction == nil {
+		return nil, nil
+	}
+	if err := c.BalanceTransaction.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return c.BalanceTransaction, nil
+}
+
+// FetchCustomer returns the customer of the charge, retrieving it if needed.
+func (c *Charge) FetchCustomer(ctx context.Context, b Backend, key string) (*Customer, error) {
+	if c.Customer == nil {
+		return nil, nil
+	}
+	if err := c.Customer.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return c.Customer, nil
+}
+
+// FetchInvoice returns the invoice of the charge, retrieving it if needed.
+func (c *Charge) FetchInvoice(ctx context.Context, b Backend, key string) (*Invoice, error) {
+	if c.Invoice == nil {
+		return nil, nil
+	}
+	if err := c.Invoice.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return c.Invoice, nil
+}
+
+// FetchPaymentIntent returns the PaymentIntent of the charge, retrieving it if needed.
+func (c *Charge) FetchPaymentIntent(ctx context.Context, b Backend, key string) (*PaymentIntent, error) {
+	if c.PaymentIntent == nil {
+		return nil, nil
+	}
+	if err := c.PaymentIntent.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return c.PaymentIntent, nil
+}
+
+// FetchCharge returns the charge of the invoice, retrieving it if needed.
+func (i *Invoice) FetchCharge(ctx context.Context, b Backend, key string) (*Charge, error) {
+	if i.Charge == nil {
+		return nil, nil
+	}
+	if err := i.Charge.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return i.Charge, nil
+}
+
+// FetchCustomer returns the customer of the invoice, retrieving it if needed.
+func (i *Invoice) FetchCustomer(ctx context.Context, b Backend, key string) (*Customer, error) {
+	if i.Customer == nil {
+		return nil, nil
+	}
+	if err := i.Customer.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return i.Customer, nil
+}
+
+// FetchPaymentIntent returns the PaymentIntent of the invoice, retrieving it if needed.
+func (i *Invoice) FetchPaymentIntent(ctx context.Context, b Backend, key string) (*PaymentIntent, error) {
+	if i.PaymentIntent == nil {
+		return nil, nil
+	}
+	if err := i.PaymentIntent.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return i.PaymentIntent, nil
+}
+
+// FetchSubscription returns the subscription of the invoice, retrieving it if needed.
+func (i *Invoice) FetchSubscription(ctx context.Context, b Backend, key string) (*Subscription, error) {
+	if i.Subscription == nil {
+		return nil, nil
+	}
+	if err := i.Subscription.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return i.Subscription, nil
+}
+
+// FetchCustomer returns the customer of the PaymentIntent, retrieving it if needed.
+func (p *PaymentIntent) FetchCustomer(ctx context.Context, b Backend, key string) (*Customer, error) {
+	if p.Customer == nil {
+		return nil, nil
+	}
+	if err := p.Customer.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return p.Customer, nil
+}
+
+// FetchInvoice returns the invoice of the PaymentIntent, retrieving it if needed.
+func (p *PaymentIntent) FetchInvoice(ctx context.Context, b Backend, key string) (*Invoice, error) {
+	if p.Invoice == nil {
+		return nil, nil
+	}
+	if err := p.Invoice.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return p.Invoice, nil
+}
+
+// FetchPaymentMethod returns the PaymentMethod of the PaymentIntent, retrieving it if needed.
+func (p *PaymentIntent) FetchPaymentMethod(ctx context.Context, b Backend, key string) (*PaymentMethod, error) {
+	if p.PaymentMethod == nil {
+		return nil, nil
+	}
+	if err := p.PaymentMethod.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return p.PaymentMethod, nil
+}
+
+// FetchBalanceTransaction returns the balance transaction of the payout, retrieving it if needed.
+func (p *Payout) FetchBalanceTransaction(ctx context.Context, b Backend, key string) (*BalanceTransaction, error) {
+	if p.BalanceTransaction == nil {
+		return nil, nil
+	}
+	if err := p.BalanceTransaction.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return p.BalanceTransaction, nil
+}
+
+// FetchBalanceTransaction returns the balance transaction of the refund, retrieving it if needed.
+func (r *Refund) FetchBalanceTransaction(ctx context.Context, b Backend, key string) (*BalanceTransaction, error) {
+	if r.BalanceTransaction == nil {
+		return nil, nil
+	}
+	if err := r.BalanceTransaction.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return r.BalanceTransaction, nil
+}
+
+// FetchCharge returns the charge of the refund, retrieving it if needed.
+func (r *Refund) FetchCharge(ctx context.Context, b Backend, key string) (*Charge, error) {
+	if r.Charge == nil {
+		return nil, nil
+	}
+	if err := r.Charge.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return r.Charge, nil
+}
+
+// FetchPaymentIntent returns the PaymentIntent of the refund, retrieving it if needed.
+func (r *Refund) FetchPaymentIntent(ctx context.Context, b Backend, key string) (*PaymentIntent, error) {
+	if r.PaymentIntent == nil {
+		return nil, nil
+	}
+	if err := r.PaymentIntent.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return r.PaymentIntent, nil
+}
+
+// FetchCustomer returns the customer of the subscription, retrieving it if needed.
+func (s *Subscription) FetchCustomer(ctx context.Context, b Backend, key string) (*Customer, error) {
+	if s.Customer == nil {
+		return nil, nil
+	}
+	if err := s.Customer.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return s.Customer, nil
+}
+
+// FetchLatestInvoice returns the latest invoice of the subscription, retrieving it if needed.
+func (s *Subscription) FetchLatestInvoice(ctx context.Context, b Backend, key string) (*Invoice, error) {
+	if s.LatestInvoice == nil {
+		return nil, nil
+	}
+	if err := s.LatestInvoice.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return s.LatestInvoice, nil
+}
+
+// FetchBalanceTransaction returns the balance transaction of the transfer, retrieving it if needed.
+func (t *Transfer) FetchBalanceTransaction(ctx context.Context, b Backend, key string) (*BalanceTransaction, error) {
+	if t.BalanceTransaction == nil {
+		return nil, nil
+	}
+	if err := t.BalanceTransaction.Fetch(ctx, b, key); err != nil {
+		return nil, err
+	}
+	return t.BalanceTransaction, nil
+}
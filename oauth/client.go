@@ -45,7 +45,7 @@ func New(params *stripe.OAuthTokenParams) (*stripe.OAuthToken, error) {
 func (c Client) New(params *stripe.OAuthTokenParams) (*stripe.OAuthToken, error) {
 	// client_secret is sent in the post body for this endpoint.
 	if stripe.StringValue(params.ClientSecret) == "" {
-		params.ClientSecret = stripe.String(stripe.Key)
+		params.ClientSecret = stripe.String(stripe.GetKey())
 	}
 
 	oauthToken := &stripe.OAuthToken{}
@@ -73,5 +73,5 @@ func (c Client) Del(params *stripe.DeauthorizeParams) (*stripe.Deauthorize, erro
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.ConnectBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.ConnectBackend), stripe.GetKey()}
 }
@@ -129,7 +129,7 @@ func stubConnectBackend(httpClient *http.Client) {
 }
 
 func TestNewOAuthToken(t *testing.T) {
-	stripe.Key = "sk_123"
+	stripe.SetKey("sk_123")
 
 	// stripe-mock doesn't support connect URLs so this stubs out the server.
 	httpClient := newTestClient(func(req *http.Request) *http.Response {
@@ -177,7 +177,7 @@ func TestNewOAuthToken(t *testing.T) {
 }
 
 func TestNewOAuthTokenWithCustomKey(t *testing.T) {
-	stripe.Key = "sk_123"
+	stripe.SetKey("sk_123")
 	// stripe-mock doesn't support connect URLs so this stubs out the server.
 	httpClient := newTestClient(func(req *http.Request) *http.Response {
 		buf := new(bytes.Buffer)
@@ -201,7 +201,7 @@ func TestNewOAuthTokenWithCustomKey(t *testing.T) {
 }
 
 func TestNewOAuthTokenWithError(t *testing.T) {
-	stripe.Key = "sk_123"
+	stripe.SetKey("sk_123")
 	// stripe-mock doesn't support connect URLs so this stubs out the server.
 
 	responseBody := `{"error":"invalid_grant","error_description": "Authorization code does not exist"}`
@@ -233,7 +233,7 @@ func TestNewOAuthTokenWithError(t *testing.T) {
 }
 
 func TestDeauthorize(t *testing.T) {
-	stripe.Key = "sk_123"
+	stripe.SetKey("sk_123")
 
 	// stripe-mock doesn't support connect URLs so this stubs out the server.
 	httpClient := newTestClient(func(req *http.Request) *http.Response {
@@ -3,7 +3,7 @@ package stripe
 import (
 	"context"
 	"crypto/rand"
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -113,6 +113,22 @@ type ListParams struct {
 	Filters      Filters   `form:"*"`
 	Limit        *int64    `form:"limit"`
 
+	// OnPage, if set, is invoked by the iterator after each page of results
+	// is fetched, which is useful for reporting the progress of long running
+	// jobs that walk through a large list.
+	OnPage func(PageProgress) `form:"-"` // Not an API parameter
+
+	// PageRetries is the number of times the iterator fetches a page again
+	// after it fails with a network error or an API error with a 429 or 5xx
+	// status, on top of the retries made by the backend for every request,
+	// before giving up on the iteration. Pages are retried with an increasing
+	// delay, and the count is reset once a page is fetched, so that long
+	// running jobs walking through a large list can ride out a temporary
+	// outage instead of starting over.
+	//
+	// Defaults to 0, which makes the iterator stop at the first error.
+	PageRetries *int64 `form:"-"` // Not an API parameter
+
 	// Single specifies whether this is a single page iterator. By default,
 	// listing through an iterator will automatically grab additional pages as
 	// the query progresses. To change this behavior and just load a single
@@ -126,6 +142,11 @@ type ListParams struct {
 	// account instead of under the account of the owner of the configured
 	// Stripe key.
 	StripeAccount *string `form:"-"` // Passed as header
+
+	// StripeVersion, if set, overrides the API version sent in the
+	// `Stripe-Version` header of the requests made by the iterator. See
+	// Params.StripeVersion.
+	StripeVersion *string `form:"-"` // Passed as header
 }
 
 // AddExpand appends a new field to expand.
@@ -133,6 +154,14 @@ func (p *ListParams) AddExpand(f string) {
 	p.Expand = append(p.Expand, &f)
 }
 
+// AddFilter adds a filter to the list request, like `AddFilter("created",
+// "gte", "1600000000")`, which is sent as `created[gte]=1600000000`. An empty
+// op sends the value as `key=value`. It's an escape hatch for filters that the
+// list params of a resource don't have a field for yet.
+func (p *ListParams) AddFilter(key, op, value string) {
+	p.Filters.AddFilter(key, op, value)
+}
+
 // GetListParams returns a ListParams struct (itself). It exists because any
 // structs that embed ListParams will inherit it, and thus implement the
 // ListParamsContainer interface.
@@ -160,6 +189,7 @@ func (p *ListParams) ToParams() *Params {
 	return &Params{
 		Context:       p.Context,
 		StripeAccount: p.StripeAccount,
+		StripeVersion: p.StripeVersion,
 	}
 }
 
@@ -192,11 +222,30 @@ type Params struct {
 	IdempotencyKey *string           `form:"-"` // Passed as header
 	Metadata       map[string]string `form:"metadata"`
 
+	// MaxNetworkRetries, if set, overrides the backend's MaxNetworkRetries for
+	// this request only. For example, a user-facing call can be made with
+	// stripe.Int64(0) to fail fast, while a background job sharing the same
+	// client retries more aggressively.
+	MaxNetworkRetries *int64 `form:"-"`
+
+	// RetryStatusCodes lists HTTP status codes that should be retried for this
+	// request, in addition to those the backend retries by default. A
+	// `Stripe-Should-Retry: false` header in the response still takes
+	// precedence.
+	RetryStatusCodes []int `form:"-"`
+
 	// StripeAccount may contain the ID of a connected account. By including
 	// this field, the request is made as if it originated from the connected
 	// account instead of under the account of the owner of the configured
 	// Stripe key.
 	StripeAccount *string `form:"-"` // Passed as header
+
+	// StripeVersion, if set, overrides the API version sent in the
+	// `Stripe-Version` header of this request, which is useful to move calls
+	// to a new API version one at a time during a migration. Responses are
+	// decoded into the library's types, so the version must be compatible
+	// with them.
+	StripeVersion *string `form:"-"` // Passed as header
 }
 
 // AddExpand appends a new field to expand.
@@ -265,19 +314,103 @@ type RangeQueryParams struct {
 	LesserThanOrEqual int64 `form:"lte"`
 }
 
+// After sets the range to only include values strictly after the given time.
+// It returns the RangeQueryParams so that calls can be chained.
+func (r *RangeQueryParams) After(t time.Time) *RangeQueryParams {
+	r.GreaterThan = t.Unix()
+	r.GreaterThanOrEqual = 0
+	return r
+}
+
+// Before sets the range to only include values strictly before the given
+// time. It returns the RangeQueryParams so that calls can be chained.
+func (r *RangeQueryParams) Before(t time.Time) *RangeQueryParams {
+	r.LesserThan = t.Unix()
+	r.LesserThanOrEqual = 0
+	return r
+}
+
+// OnOrAfter sets the range to only include values at or after the given
+// time. It returns the RangeQueryParams so that calls can be chained.
+func (r *RangeQueryParams) OnOrAfter(t time.Time) *RangeQueryParams {
+	r.GreaterThanOrEqual = t.Unix()
+	r.GreaterThan = 0
+	return r
+}
+
+// OnOrBefore sets the range to only include values at or before the given
+// time. It returns the RangeQueryParams so that calls can be chained.
+func (r *RangeQueryParams) OnOrBefore(t time.Time) *RangeQueryParams {
+	r.LesserThanOrEqual = t.Unix()
+	r.LesserThan = 0
+	return r
+}
+
+// Validate checks that the range doesn't combine bounds that the API would
+// reject or that can never match, like setting both GreaterThan and
+// GreaterThanOrEqual or a lower bound that's after the upper bound.
+func (r *RangeQueryParams) Validate() error {
+	if r.GreaterThan != 0 && r.GreaterThanOrEqual != 0 {
+		return errors.New("range can't set both gt and gte")
+	}
+	if r.LesserThan != 0 && r.LesserThanOrEqual != 0 {
+		return errors.New("range can't set both lt and lte")
+	}
+
+	lower := r.GreaterThanOrEqual
+	if r.GreaterThan != 0 {
+		lower = r.GreaterThan + 1
+	}
+	upper := r.LesserThanOrEqual
+	if r.LesserThan != 0 {
+		upper = r.LesserThan - 1
+	}
+	if lower != 0 && upper != 0 && lower > upper {
+		return fmt.Errorf("range is empty: lower bound %v is after upper bound %v", lower, upper)
+	}
+
+	return nil
+}
+
 //
 // Public functions
 //
 
+// CreatedAfter returns a range filter matching objects created strictly after
+// the given time, for use as the CreatedRange of list params. More bounds can
+// be added by chaining, e.g. `stripe.CreatedAfter(start).Before(end)`.
+func CreatedAfter(t time.Time) *RangeQueryParams {
+	return (&RangeQueryParams{}).After(t)
+}
+
+// CreatedBefore returns a range filter matching objects created strictly
+// before the given time, for use as the CreatedRange of list params.
+func CreatedBefore(t time.Time) *RangeQueryParams {
+	return (&RangeQueryParams{}).Before(t)
+}
+
+// CreatedBetween returns a range filter matching objects created at or after
+// start and strictly before end, for use as the CreatedRange of list params.
+// The half-open interval makes it easy to page through consecutive windows
+// without overlap.
+func CreatedBetween(start, end time.Time) *RangeQueryParams {
+	return (&RangeQueryParams{}).OnOrAfter(start).Before(end)
+}
+
 // NewIdempotencyKey generates a new idempotency key that
-// can be used on a request.
+// can be used on a request. Keys are random (version 4) UUIDs, like the ones
+// generated by Stripe's other libraries.
 func NewIdempotencyKey() string {
-	now := time.Now().UnixNano()
-	buf := make([]byte, 4)
-	if _, err := rand.Read(buf); err != nil {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
 		panic(err)
 	}
-	return fmt.Sprintf("%v_%v", now, base64.URLEncoding.EncodeToString(buf)[:6])
+
+	// Set the version and variant bits defined by RFC 4122.
+	buf[6] = buf[6]&0x0f | 0x40
+	buf[8] = buf[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", buf[0:4], buf[4:6], buf[6:8], buf[8:10], buf[10:])
 }
 
 //
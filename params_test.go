@@ -3,6 +3,7 @@ package stripe_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go/v72"
@@ -182,6 +183,21 @@ func TestListParams_Filters(t *testing.T) {
 	}), body)
 }
 
+func TestListParams_AddFilter(t *testing.T) {
+	p := &stripe.ChargeListParams{Customer: stripe.String("cus_123")}
+	p.AddFilter("created", "gte", "123")
+	p.AddFilter("transfer_group", "", "group_123")
+
+	body := &form.Values{}
+	form.AppendTo(body, p)
+
+	assert.Equal(t, valuesFromArray([][2]string{
+		{"created[gte]", "123"},
+		{"transfer_group", "group_123"},
+		{"customer", "cus_123"},
+	}), body)
+}
+
 func TestListParams_Expand(t *testing.T) {
 	testCases := []struct {
 		InitialBody  [][2]string
@@ -255,3 +271,45 @@ func valuesFromArray(arr [][2]string) *form.Values {
 	}
 	return body
 }
+
+func TestCreatedRangeBuilders(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	end := time.Unix(1700000000, 0)
+
+	r := stripe.CreatedAfter(start)
+	assert.Equal(t, &stripe.RangeQueryParams{GreaterThan: start.Unix()}, r)
+
+	r = stripe.CreatedBefore(end)
+	assert.Equal(t, &stripe.RangeQueryParams{LesserThan: end.Unix()}, r)
+
+	r = stripe.CreatedBetween(start, end)
+	assert.Equal(t, &stripe.RangeQueryParams{
+		GreaterThanOrEqual: start.Unix(),
+		LesserThan:         end.Unix(),
+	}, r)
+
+	// Setting a bound replaces its exclusive or inclusive counterpart
+	r = stripe.CreatedAfter(start).OnOrAfter(start).OnOrBefore(end)
+	assert.Equal(t, &stripe.RangeQueryParams{
+		GreaterThanOrEqual: start.Unix(),
+		LesserThanOrEqual:  end.Unix(),
+	}, r)
+	assert.NoError(t, r.Validate())
+}
+
+func TestRangeQueryParamsValidate(t *testing.T) {
+	assert.NoError(t, (&stripe.RangeQueryParams{}).Validate())
+	assert.NoError(t, (&stripe.RangeQueryParams{GreaterThan: 1, LesserThan: 3}).Validate())
+	assert.NoError(t, (&stripe.RangeQueryParams{GreaterThanOrEqual: 2, LesserThanOrEqual: 2}).Validate())
+
+	assert.Error(t, (&stripe.RangeQueryParams{GreaterThan: 1, GreaterThanOrEqual: 1}).Validate())
+	assert.Error(t, (&stripe.RangeQueryParams{LesserThan: 1, LesserThanOrEqual: 1}).Validate())
+	assert.Error(t, (&stripe.RangeQueryParams{GreaterThan: 2, LesserThan: 3}).Validate())
+	assert.Error(t, (&stripe.RangeQueryParams{GreaterThanOrEqual: 5, LesserThanOrEqual: 4}).Validate())
+}
+
+func TestNewIdempotencyKey(t *testing.T) {
+	key := stripe.NewIdempotencyKey()
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, key)
+	assert.NotEqual(t, key, stripe.NewIdempotencyKey())
+}
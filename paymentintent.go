@@ -44,7 +44,10 @@ type PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSuppo
 
 // List of values that PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetwork can take
 const (
+	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetworkBACS   PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetwork = "bacs"
+	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetworkFPS    PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetwork = "fps"
 	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetworkSepa   PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetwork = "sepa"
+	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetworkSpei   PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetwork = "spei"
 	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetworkZengin PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetwork = "zengin"
 )
 
@@ -53,8 +56,10 @@ type PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressType
 
 // List of values that PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressType can take
 const (
-	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressTypeIban   PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressType = "iban"
-	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressTypeZengin PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressType = "zengin"
+	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressTypeIban     PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressType = "iban"
+	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressTypeSortCode PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressType = "sort_code"
+	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressTypeSpei     PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressType = "spei"
+	PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressTypeZengin   PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressType = "zengin"
 )
 
 // Type of bank transfer
@@ -62,7 +67,10 @@ type PaymentIntentNextActionDisplayBankTransferInstructionsType string
 
 // List of values that PaymentIntentNextActionDisplayBankTransferInstructionsType can take
 const (
+	PaymentIntentNextActionDisplayBankTransferInstructionsTypeEUBankTransfer PaymentIntentNextActionDisplayBankTransferInstructionsType = "eu_bank_transfer"
+	PaymentIntentNextActionDisplayBankTransferInstructionsTypeGBBankTransfer PaymentIntentNextActionDisplayBankTransferInstructionsType = "gb_bank_transfer"
 	PaymentIntentNextActionDisplayBankTransferInstructionsTypeJPBankTransfer PaymentIntentNextActionDisplayBankTransferInstructionsType = "jp_bank_transfer"
+	PaymentIntentNextActionDisplayBankTransferInstructionsTypeMXBankTransfer PaymentIntentNextActionDisplayBankTransferInstructionsType = "mx_bank_transfer"
 )
 
 // Type of the next action to perform, one of `redirect_to_url`, `use_stripe_sdk`, `alipay_handle_redirect`, `oxxo_display_details`, or `verify_with_microdeposits`.
@@ -70,9 +78,15 @@ type PaymentIntentNextActionType string
 
 // List of values that PaymentIntentNextActionType can take
 const (
-	PaymentIntentNextActionTypeAlipayHandleRedirect PaymentIntentNextActionType = "alipay_handle_redirect"
-	PaymentIntentNextActionTypeOXXODisplayDetails   PaymentIntentNextActionType = "oxxo_display_details"
-	PaymentIntentNextActionTypeRedirectToURL        PaymentIntentNextActionType = "redirect_to_url"
+	PaymentIntentNextActionTypeAlipayHandleRedirect          PaymentIntentNextActionType = "alipay_handle_redirect"
+	PaymentIntentNextActionTypeBoletoDisplayDetails          PaymentIntentNextActionType = "boleto_display_details"
+	PaymentIntentNextActionTypeKonbiniDisplayDetails         PaymentIntentNextActionType = "konbini_display_details"
+	PaymentIntentNextActionTypeMultibancoDisplayDetails      PaymentIntentNextActionType = "multibanco_display_details"
+	PaymentIntentNextActionTypeOXXODisplayDetails            PaymentIntentNextActionType = "oxxo_display_details"
+	PaymentIntentNextActionTypeRedirectToURL                 PaymentIntentNextActionType = "redirect_to_url"
+	PaymentIntentNextActionTypeWechatPayDisplayQRCode        PaymentIntentNextActionType = "wechat_pay_display_qr_code"
+	PaymentIntentNextActionTypeWechatPayRedirectToAndroidApp PaymentIntentNextActionType = "wechat_pay_redirect_to_android_app"
+	PaymentIntentNextActionTypeWechatPayRedirectToIOSApp     PaymentIntentNextActionType = "wechat_pay_redirect_to_ios_app"
 )
 
 // PaymentIntentOffSession is the list of allowed values for types of off-session.
@@ -137,6 +151,9 @@ const (
 )
 
 // Controls when the funds will be captured from the customer's account.
+//
+// With `manual`, the customer's Affirm authorization is only held for a limited period (usually 7 days) and the
+// PaymentIntent is canceled if it isn't captured in time. Leave it unset to capture automatically on confirmation.
 type PaymentIntentPaymentMethodOptionsAffirmCaptureMethod string
 
 // List of values that PaymentIntentPaymentMethodOptionsAffirmCaptureMethod can take
@@ -157,6 +174,9 @@ const (
 )
 
 // Controls when the funds will be captured from the customer's account.
+//
+// With `manual`, the customer's Afterpay Clearpay authorization is only held for a limited period (usually 7 days) and the
+// PaymentIntent is canceled if it isn't captured in time. Leave it unset to capture automatically on confirmation.
 type PaymentIntentPaymentMethodOptionsAfterpayClearpayCaptureMethod string
 
 // List of values that PaymentIntentPaymentMethodOptionsAfterpayClearpayCaptureMethod can take
@@ -324,12 +344,15 @@ const (
 
 // List of address types that should be returned in the financial_addresses response. If not specified, all valid types will be returned.
 //
-// Permitted values include: `zengin`.
+// Permitted values include: `sort_code`, `zengin`, `iban`, or `spei`.
 type PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressType string
 
 // List of values that PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressType can take
 const (
-	PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressTypeZengin PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressType = "zengin"
+	PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressTypeIban     PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressType = "iban"
+	PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressTypeSortCode PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressType = "sort_code"
+	PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressTypeSpei     PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressType = "spei"
+	PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressTypeZengin   PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressType = "zengin"
 )
 
 // The bank transfer type that this PaymentIntent is allowed to use for funding. Permitted values include: `us_bank_account`, `eu_bank_account`, `id_bank_account`, `gb_bank_account`, `jp_bank_account`, `mx_bank_account`, `eu_bank_transfer`, `gb_bank_transfer`, `id_bank_transfer`, `jp_bank_transfer`, `mx_bank_transfer`, or `us_bank_transfer`.
@@ -433,6 +456,9 @@ const (
 )
 
 // Controls when the funds will be captured from the customer's account.
+//
+// With `manual`, the customer's Klarna authorization is only held for a limited period (usually 7 days) and the
+// PaymentIntent is canceled if it isn't captured in time. Leave it unset to capture automatically on confirmation.
 type PaymentIntentPaymentMethodOptionsKlarnaCaptureMethod string
 
 // List of values that PaymentIntentPaymentMethodOptionsKlarnaCaptureMethod can take
@@ -485,6 +511,18 @@ const (
 	PaymentIntentPaymentMethodOptionsLinkSetupFutureUsageOffSession PaymentIntentPaymentMethodOptionsLinkSetupFutureUsage = "off_session"
 )
 
+// Indicates that you intend to make future payments with this PaymentIntent's payment method.
+//
+// Providing this parameter will [attach the payment method](https://stripe.com/docs/payments/save-during-payment) to the PaymentIntent's Customer, if present, after the PaymentIntent is confirmed and any required actions from the user are complete. If no Customer was provided, the payment method can still be [attached](https://stripe.com/docs/api/payment_methods/attach) to a Customer after the transaction completes.
+//
+// When processing card payments, Stripe also uses `setup_future_usage` to dynamically optimize your payment flow and comply with regional legislation and network rules, such as [SCA](https://stripe.com/docs/strong-customer-authentication).
+type PaymentIntentPaymentMethodOptionsMultibancoSetupFutureUsage string
+
+// List of values that PaymentIntentPaymentMethodOptionsMultibancoSetupFutureUsage can take
+const (
+	PaymentIntentPaymentMethodOptionsMultibancoSetupFutureUsageNone PaymentIntentPaymentMethodOptionsMultibancoSetupFutureUsage = "none"
+)
+
 // Indicates that you intend to make future payments with this PaymentIntent's payment method.
 //
 // Providing this parameter will [attach the payment method](https://stripe.com/docs/payments/save-during-payment) to the PaymentIntent's Customer, if present, after the PaymentIntent is confirmed and any required actions from the user are complete. If no Customer was provided, the payment method can still be [attached](https://stripe.com/docs/api/payment_methods/attach) to a Customer after the transaction completes.
@@ -757,6 +795,8 @@ type PaymentIntentPaymentMethodDataParams struct {
 	Link *PaymentIntentPaymentMethodDataLinkParams `form:"link"`
 	// Set of [key-value pairs](https://stripe.com/docs/api/metadata) that you can attach to an object. This can be useful for storing additional information about the object in a structured format. Individual keys can be unset by posting an empty value to them. All keys can be unset by posting an empty value to `metadata`.
 	Metadata map[string]string `form:"metadata"`
+	// If this is a `multibanco` PaymentMethod, this hash contains details about the Multibanco payment method.
+	Multibanco *PaymentMethodMultibancoParams `form:"multibanco"`
 	// If this is an `oxxo` PaymentMethod, this hash contains details about the OXXO payment method.
 	OXXO *PaymentMethodOXXOParams `form:"oxxo"`
 	// If this is a `p24` PaymentMethod, this hash contains details about the P24 payment method.
@@ -868,8 +908,16 @@ type PaymentIntentPaymentMethodOptionsAUBECSDebitParams struct {
 	SetupFutureUsage *string `form:"setup_future_usage"`
 }
 
+// Additional fields for Mandate creation
+type PaymentIntentPaymentMethodOptionsBACSDebitMandateOptionsParams struct {
+	// Prefix used to generate the Mandate reference. Must be at most 12 characters long. Must consist of only uppercase letters, numbers, spaces, or the following special characters: '/', '_', '-', '&', '.'. Cannot begin with 'DDIC' or 'STRIPE'.
+	ReferencePrefix *string `form:"reference_prefix"`
+}
+
 // If this is a `bacs_debit` PaymentMethod, this sub-hash contains details about the BACS Debit payment method options.
 type PaymentIntentPaymentMethodOptionsBACSDebitParams struct {
+	// Additional fields for Mandate creation
+	MandateOptions *PaymentIntentPaymentMethodOptionsBACSDebitMandateOptionsParams `form:"mandate_options"`
 	// Indicates that you intend to make future payments with this PaymentIntent's payment method.
 	//
 	// Providing this parameter will [attach the payment method](https://stripe.com/docs/payments/save-during-payment) to the PaymentIntent's Customer, if present, after the PaymentIntent is confirmed and any required actions from the user are complete. If no Customer was provided, the payment method can still be [attached](https://stripe.com/docs/api/payment_methods/attach) to a Customer after the transaction completes.
@@ -997,11 +1045,19 @@ type PaymentIntentPaymentMethodOptionsCardPresentParams struct {
 	RequestIncrementalAuthorizationSupport *bool `form:"request_incremental_authorization_support"`
 }
 
+// Configuration for the eu_bank_transfer funding type.
+type PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferEUBankTransferParams struct {
+	// The desired country code of the bank account information. Permitted values include: `BE`, `DE`, `ES`, `FR`, `IE`, or `NL`.
+	Country *string `form:"country"`
+}
+
 // Configuration for the bank transfer funding type, if the `funding_type` is set to `bank_transfer`.
 type PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferParams struct {
+	// Configuration for the eu_bank_transfer funding type.
+	EUBankTransfer *PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferEUBankTransferParams `form:"eu_bank_transfer"`
 	// List of address types that should be returned in the financial_addresses response. If not specified, all valid types will be returned.
 	//
-	// Permitted values include: `zengin`.
+	// Permitted values include: `sort_code`, `zengin`, `iban`, or `spei`.
 	RequestedAddressTypes []*string `form:"requested_address_types"`
 	// The list of bank transfer types that this PaymentIntent is allowed to use for funding. Permitted values include: `us_bank_account`, `eu_bank_account`, `id_bank_account`, `gb_bank_account`, `jp_bank_account`, `mx_bank_account`, `eu_bank_transfer`, `gb_bank_transfer`, `id_bank_transfer`, `jp_bank_transfer`, `mx_bank_transfer`, or `us_bank_transfer`.
 	Type *string `form:"type"`
@@ -1146,6 +1202,16 @@ type PaymentIntentPaymentMethodOptionsLinkParams struct {
 	SetupFutureUsage *string `form:"setup_future_usage"`
 }
 
+// If this is a `multibanco` PaymentMethod, this sub-hash contains details about the Multibanco payment method options.
+type PaymentIntentPaymentMethodOptionsMultibancoParams struct {
+	// Indicates that you intend to make future payments with this PaymentIntent's payment method.
+	//
+	// Providing this parameter will [attach the payment method](https://stripe.com/docs/payments/save-during-payment) to the PaymentIntent's Customer, if present, after the PaymentIntent is confirmed and any required actions from the user are complete. If no Customer was provided, the payment method can still be [attached](https://stripe.com/docs/api/payment_methods/attach) to a Customer after the transaction completes.
+	//
+	// When processing card payments, Stripe also uses `setup_future_usage` to dynamically optimize your payment flow and comply with regional legislation and network rules, such as [SCA](https://stripe.com/docs/strong-customer-authentication).
+	SetupFutureUsage *string `form:"setup_future_usage"`
+}
+
 // If this is a `oxxo` PaymentMethod, this sub-hash contains details about the OXXO payment method options.
 type PaymentIntentPaymentMethodOptionsOXXOParams struct {
 	// The number of calendar days before an OXXO voucher expires. For example, if you create an OXXO voucher on Monday and you set expires_after_days to 2, the OXXO invoice will expire on Wednesday at 23:59 America/Mexico_City time.
@@ -1187,7 +1253,10 @@ type PaymentIntentPaymentMethodOptionsPayNowParams struct {
 }
 
 // Additional fields for Mandate creation
-type PaymentIntentPaymentMethodOptionsSepaDebitMandateOptionsParams struct{}
+type PaymentIntentPaymentMethodOptionsSepaDebitMandateOptionsParams struct {
+	// Prefix used to generate the Mandate reference. Must be at most 12 characters long. Must consist of only uppercase letters, numbers, spaces, or the following special characters: '/', '_', '-', '&', '.'. Cannot begin with 'DDIC' or 'STRIPE'.
+	ReferencePrefix *string `form:"reference_prefix"`
+}
 
 // If this is a `sepa_debit` PaymentIntent, this sub-hash contains details about the SEPA Debit payment method options.
 type PaymentIntentPaymentMethodOptionsSepaDebitParams struct {
@@ -1307,6 +1376,8 @@ type PaymentIntentPaymentMethodOptionsParams struct {
 	Konbini *PaymentIntentPaymentMethodOptionsKonbiniParams `form:"konbini"`
 	// If this is a `link` PaymentMethod, this sub-hash contains details about the Link payment method options.
 	Link *PaymentIntentPaymentMethodOptionsLinkParams `form:"link"`
+	// If this is a `multibanco` PaymentMethod, this sub-hash contains details about the Multibanco payment method options.
+	Multibanco *PaymentIntentPaymentMethodOptionsMultibancoParams `form:"multibanco"`
 	// If this is a `oxxo` PaymentMethod, this sub-hash contains details about the OXXO payment method options.
 	OXXO *PaymentIntentPaymentMethodOptionsOXXOParams `form:"oxxo"`
 	// If this is a `p24` PaymentMethod, this sub-hash contains details about the Przelewy24 payment method options.
@@ -1632,11 +1703,64 @@ type PaymentIntentNextActionCardAwaitNotification struct {
 	CustomerApprovalRequired bool `json:"customer_approval_required"`
 }
 
+// Iban Records contain E.U. bank account details per the SEPA format.
+type PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressIban struct {
+	// The name of the person or business that owns the bank account
+	AccountHolderName string `json:"account_holder_name"`
+	// The BIC/SWIFT code of the account.
+	Bic string `json:"bic"`
+	// Two-letter country code ([ISO 3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2)).
+	Country string `json:"country"`
+	// The IBAN of the account.
+	Iban string `json:"iban"`
+}
+
+// Sort Code Records contain U.K. bank account details per the sort code format.
+type PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSortCode struct {
+	// The name of the person or business that owns the bank account
+	AccountHolderName string `json:"account_holder_name"`
+	// The account number
+	AccountNumber string `json:"account_number"`
+	// The six-digit sort code
+	SortCode string `json:"sort_code"`
+}
+
+// SPEI Records contain Mexico bank account details per the SPEI format.
+type PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSpei struct {
+	// The three-digit bank code
+	BankCode string `json:"bank_code"`
+	// The short banking institution name
+	BankName string `json:"bank_name"`
+	// The CLABE number
+	Clabe string `json:"clabe"`
+}
+
 // Zengin Records contain Japan bank account details per the Zengin format.
-type PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressZengin struct{}
+type PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressZengin struct {
+	// The account holder name
+	AccountHolderName string `json:"account_holder_name"`
+	// The account number
+	AccountNumber string `json:"account_number"`
+	// The bank account type. In Japan, this can only be `futsu` or `toza`.
+	AccountType string `json:"account_type"`
+	// The bank code of the account
+	BankCode string `json:"bank_code"`
+	// The bank name of the account
+	BankName string `json:"bank_name"`
+	// The branch code of the account
+	BranchCode string `json:"branch_code"`
+	// The branch name of the account
+	BranchName string `json:"branch_name"`
+}
 
 // A list of financial addresses that can be used to fund the customer balance
 type PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddress struct {
+	// Iban Records contain E.U. bank account details per the SEPA format.
+	Iban *PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressIban `json:"iban"`
+	// Sort Code Records contain U.K. bank account details per the sort code format.
+	SortCode *PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSortCode `json:"sort_code"`
+	// SPEI Records contain Mexico bank account details per the SPEI format.
+	Spei *PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSpei `json:"spei"`
 	// The payment networks supported by this FinancialAddress
 	SupportedNetworks []PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressSupportedNetwork `json:"supported_networks"`
 	// The type of financial address
@@ -1707,6 +1831,16 @@ type PaymentIntentNextActionKonbiniDisplayDetails struct {
 	HostedVoucherURL string                                              `json:"hosted_voucher_url"`
 	Stores           *PaymentIntentNextActionKonbiniDisplayDetailsStores `json:"stores"`
 }
+type PaymentIntentNextActionMultibancoDisplayDetails struct {
+	// Entity number associated with this Multibanco payment.
+	Entity string `json:"entity"`
+	// The timestamp at which the Multibanco voucher expires.
+	ExpiresAt int64 `json:"expires_at"`
+	// The URL for the hosted Multibanco voucher page, which allows customers to view a Multibanco voucher.
+	HostedVoucherURL string `json:"hosted_voucher_url"`
+	// Reference number associated with this Multibanco payment.
+	Reference string `json:"reference"`
+}
 type PaymentIntentNextActionOXXODisplayDetails struct {
 	// The timestamp after which the OXXO voucher expires.
 	ExpiresAfter int64 `json:"expires_after"`
@@ -1778,6 +1912,7 @@ type PaymentIntentNextAction struct {
 	CardAwaitNotification           *PaymentIntentNextActionCardAwaitNotification           `json:"card_await_notification"`
 	DisplayBankTransferInstructions *PaymentIntentNextActionDisplayBankTransferInstructions `json:"display_bank_transfer_instructions"`
 	KonbiniDisplayDetails           *PaymentIntentNextActionKonbiniDisplayDetails           `json:"konbini_display_details"`
+	MultibancoDisplayDetails        *PaymentIntentNextActionMultibancoDisplayDetails        `json:"multibanco_display_details"`
 	OXXODisplayDetails              *PaymentIntentNextActionOXXODisplayDetails              `json:"oxxo_display_details"`
 	PayNowDisplayQRCode             *PaymentIntentNextActionPayNowDisplayQRCode             `json:"paynow_display_qr_code"`
 	RedirectToURL                   *PaymentIntentNextActionRedirectToURL                   `json:"redirect_to_url"`
@@ -1850,7 +1985,12 @@ type PaymentIntentPaymentMethodOptionsAUBECSDebit struct {
 	// When processing card payments, Stripe also uses `setup_future_usage` to dynamically optimize your payment flow and comply with regional legislation and network rules, such as [SCA](https://stripe.com/docs/strong-customer-authentication).
 	SetupFutureUsage PaymentIntentPaymentMethodOptionsAUBECSDebitSetupFutureUsage `json:"setup_future_usage"`
 }
+type PaymentIntentPaymentMethodOptionsBACSDebitMandateOptions struct {
+	// Prefix used to generate the Mandate reference. Must be at most 12 characters long. Must consist of only uppercase letters, numbers, spaces, or the following special characters: '/', '_', '-', '&', '.'. Cannot begin with 'DDIC' or 'STRIPE'.
+	ReferencePrefix string `json:"reference_prefix"`
+}
 type PaymentIntentPaymentMethodOptionsBACSDebit struct {
+	MandateOptions *PaymentIntentPaymentMethodOptionsBACSDebitMandateOptions `json:"mandate_options"`
 	// Indicates that you intend to make future payments with this PaymentIntent's payment method.
 	//
 	// Providing this parameter will [attach the payment method](https://stripe.com/docs/payments/save-during-payment) to the PaymentIntent's Customer, if present, after the PaymentIntent is confirmed and any required actions from the user are complete. If no Customer was provided, the payment method can still be [attached](https://stripe.com/docs/api/payment_methods/attach) to a Customer after the transaction completes.
@@ -1952,10 +2092,15 @@ type PaymentIntentPaymentMethodOptionsCardPresent struct {
 	// Request ability to [increment](https://stripe.com/docs/terminal/features/incremental-authorizations) this PaymentIntent if the combination of MCC and card brand is eligible. Check [incremental_authorization_supported](https://stripe.com/docs/api/charges/object#charge_object-payment_method_details-card_present-incremental_authorization_supported) in the [Confirm](https://stripe.com/docs/api/payment_intents/confirm) response to verify support.
 	RequestIncrementalAuthorizationSupport bool `json:"request_incremental_authorization_support"`
 }
+type PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferEUBankTransfer struct {
+	// The desired country code of the bank account information. Permitted values include: `BE`, `DE`, `ES`, `FR`, `IE`, or `NL`.
+	Country string `json:"country"`
+}
 type PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransfer struct {
+	EUBankTransfer *PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferEUBankTransfer `json:"eu_bank_transfer"`
 	// List of address types that should be returned in the financial_addresses response. If not specified, all valid types will be returned.
 	//
-	// Permitted values include: `zengin`.
+	// Permitted values include: `sort_code`, `zengin`, `iban`, or `spei`.
 	RequestedAddressTypes []PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferRequestedAddressType `json:"requested_address_types"`
 	// The bank transfer type that this PaymentIntent is allowed to use for funding. Permitted values include: `us_bank_account`, `eu_bank_account`, `id_bank_account`, `gb_bank_account`, `jp_bank_account`, `mx_bank_account`, `eu_bank_transfer`, `gb_bank_transfer`, `id_bank_transfer`, `jp_bank_transfer`, `mx_bank_transfer`, or `us_bank_transfer`.
 	Type PaymentIntentPaymentMethodOptionsCustomerBalanceBankTransferType `json:"type"`
@@ -2058,6 +2203,14 @@ type PaymentIntentPaymentMethodOptionsLink struct {
 	// When processing card payments, Stripe also uses `setup_future_usage` to dynamically optimize your payment flow and comply with regional legislation and network rules, such as [SCA](https://stripe.com/docs/strong-customer-authentication).
 	SetupFutureUsage PaymentIntentPaymentMethodOptionsLinkSetupFutureUsage `json:"setup_future_usage"`
 }
+type PaymentIntentPaymentMethodOptionsMultibanco struct {
+	// Indicates that you intend to make future payments with this PaymentIntent's payment method.
+	//
+	// Providing this parameter will [attach the payment method](https://stripe.com/docs/payments/save-during-payment) to the PaymentIntent's Customer, if present, after the PaymentIntent is confirmed and any required actions from the user are complete. If no Customer was provided, the payment method can still be [attached](https://stripe.com/docs/api/payment_methods/attach) to a Customer after the transaction completes.
+	//
+	// When processing card payments, Stripe also uses `setup_future_usage` to dynamically optimize your payment flow and comply with regional legislation and network rules, such as [SCA](https://stripe.com/docs/strong-customer-authentication).
+	SetupFutureUsage PaymentIntentPaymentMethodOptionsMultibancoSetupFutureUsage `json:"setup_future_usage"`
+}
 type PaymentIntentPaymentMethodOptionsOXXO struct {
 	// The number of calendar days before an OXXO invoice expires. For example, if you create an OXXO invoice on Monday and you set expires_after_days to 2, the OXXO invoice will expire on Wednesday at 23:59 America/Mexico_City time.
 	ExpiresAfterDays int64 `json:"expires_after_days"`
@@ -2087,7 +2240,10 @@ type PaymentIntentPaymentMethodOptionsPayNow struct {
 	// When processing card payments, Stripe also uses `setup_future_usage` to dynamically optimize your payment flow and comply with regional legislation and network rules, such as [SCA](https://stripe.com/docs/strong-customer-authentication).
 	SetupFutureUsage PaymentIntentPaymentMethodOptionsPayNowSetupFutureUsage `json:"setup_future_usage"`
 }
-type PaymentIntentPaymentMethodOptionsSepaDebitMandateOptions struct{}
+type PaymentIntentPaymentMethodOptionsSepaDebitMandateOptions struct {
+	// Prefix used to generate the Mandate reference. Must be at most 12 characters long. Must consist of only uppercase letters, numbers, spaces, or the following special characters: '/', '_', '-', '&', '.'. Cannot begin with 'DDIC' or 'STRIPE'.
+	ReferencePrefix string `json:"reference_prefix"`
+}
 
 // PaymentIntentPaymentMethodOptionsSepaDebit is the set of SEPA Debit-specific options associated
 // with that payment intent.
@@ -2162,6 +2318,7 @@ type PaymentIntentPaymentMethodOptions struct {
 	Klarna           *PaymentIntentPaymentMethodOptionsKlarna           `json:"klarna"`
 	Konbini          *PaymentIntentPaymentMethodOptionsKonbini          `json:"konbini"`
 	Link             *PaymentIntentPaymentMethodOptionsLink             `json:"link"`
+	Multibanco       *PaymentIntentPaymentMethodOptionsMultibanco       `json:"multibanco"`
 	OXXO             *PaymentIntentPaymentMethodOptionsOXXO             `json:"oxxo"`
 	P24              *PaymentIntentPaymentMethodOptionsP24              `json:"p24"`
 	PayNow           *PaymentIntentPaymentMethodOptionsPayNow           `json:"paynow"`
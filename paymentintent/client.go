@@ -230,5 +230,5 @@ func (i *SearchIter) PaymentIntentSearchResult() *stripe.PaymentIntentSearchResu
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,67 @@
+package paymentintent
+
+import (
+	"context"
+	"time"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+// waitInitialInterval and waitMaxInterval bound the time WaitForStatus waits
+// between checks of a payment intent. They're variables so that tests can
+// shorten them.
+var (
+	waitInitialInterval = 1 * time.Second
+	waitMaxInterval     = 30 * time.Second
+)
+
+// WaitForStatus polls a payment intent until it has one of the given statuses
+// and returns it. If no statuses are given, it waits until the payment intent
+// is no longer `processing`, which is when asynchronous payment methods like
+// bank debits have either succeeded or failed.
+//
+// The interval between checks starts at one second and doubles up to thirty
+// seconds. Polling stops when ctx is done, in which case the most recently
+// fetched payment intent is returned along with the context's error.
+func WaitForStatus(ctx context.Context, id string, statuses ...stripe.PaymentIntentStatus) (*stripe.PaymentIntent, error) {
+	return getC().WaitForStatus(ctx, id, statuses...)
+}
+
+// WaitForStatus polls a payment intent until it has one of the given statuses
+// and returns it. If no statuses are given, it waits until the payment intent
+// is no longer `processing`, which is when asynchronous payment methods like
+// bank debits have either succeeded or failed.
+//
+// The interval between checks starts at one second and doubles up to thirty
+// seconds. Polling stops when ctx is done, in which case the most recently
+// fetched payment intent is returned along with the context's error.
+func (c Client) WaitForStatus(ctx context.Context, id string, statuses ...stripe.PaymentIntentStatus) (*stripe.PaymentIntent, error) {
+	done := func(status stripe.PaymentIntentStatus) bool {
+		if len(statuses) == 0 {
+			return status != stripe.PaymentIntentStatusProcessing
+		}
+		for _, s := range statuses {
+			if status == s {
+				return true
+			}
+		}
+		return false
+	}
+
+	v, err := stripe.Poll(ctx,
+		func(ctx context.Context) (interface{}, error) {
+			params := &stripe.PaymentIntentParams{}
+			params.Context = ctx
+			return c.Get(id, params)
+		},
+		func(v interface{}) bool {
+			return done(v.(*stripe.PaymentIntent).Status)
+		},
+		&stripe.PollOptions{
+			InitialInterval: waitInitialInterval,
+			MaxInterval:     waitMaxInterval,
+		},
+	)
+	intent, _ := v.(*stripe.PaymentIntent)
+	return intent, err
+}
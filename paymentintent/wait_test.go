@@ -0,0 +1,77 @@
+package paymentintent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestPaymentIntentWaitForStatus(t *testing.T) {
+	defer func(d time.Duration) { waitInitialInterval = d }(waitInitialInterval)
+	waitInitialInterval = time.Millisecond
+
+	gets := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/payment_intents/pi_123", r.URL.Path)
+		gets++
+		if gets < 3 {
+			w.Write([]byte(`{"id":"pi_123","status":"processing"}`))
+			return
+		}
+		w.Write([]byte(`{"id":"pi_123","status":"succeeded"}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	intent, err := c.WaitForStatus(context.Background(), "pi_123")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, gets)
+	assert.Equal(t, stripe.PaymentIntentStatusSucceeded, intent.Status)
+}
+
+func TestPaymentIntentWaitForStatus_Statuses(t *testing.T) {
+	defer func(d time.Duration) { waitInitialInterval = d }(waitInitialInterval)
+	waitInitialInterval = time.Millisecond
+
+	gets := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gets++
+		if gets < 2 {
+			w.Write([]byte(`{"id":"pi_123","status":"requires_action"}`))
+			return
+		}
+		w.Write([]byte(`{"id":"pi_123","status":"requires_capture"}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	intent, err := c.WaitForStatus(context.Background(), "pi_123",
+		stripe.PaymentIntentStatusRequiresCapture, stripe.PaymentIntentStatusSucceeded)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, gets)
+	assert.Equal(t, stripe.PaymentIntentStatusRequiresCapture, intent.Status)
+}
+
+func TestPaymentIntentWaitForStatus_ContextDone(t *testing.T) {
+	defer func(d time.Duration) { waitInitialInterval = d }(waitInitialInterval)
+	waitInitialInterval = time.Hour
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"pi_123","status":"processing"}`))
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	intent, err := c.WaitForStatus(ctx, "pi_123")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, stripe.PaymentIntentStatusProcessing, intent.Status)
+}
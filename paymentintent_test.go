@@ -63,3 +63,131 @@ func TestPaymentIntent_UnmarshalJSON(t *testing.T) {
 	assert.Equal(t, 2, len(intent.Charges.Data))
 	assert.Equal(t, 1, len(intent.PaymentMethodTypes))
 }
+
+func TestPaymentIntentNextAction_UnmarshalJSON_APACWallets(t *testing.T) {
+	// Alipay redirect
+	{
+		data := []byte(`{
+			"alipay_handle_redirect": {
+				"native_url": "alipay://pay",
+				"return_url": "https://stripe.com/return",
+				"url": "https://hooks.stripe.com/alipay"
+			},
+			"type": "alipay_handle_redirect"
+		}`)
+
+		var action PaymentIntentNextAction
+		err := json.Unmarshal(data, &action)
+		assert.NoError(t, err)
+		assert.Equal(t, PaymentIntentNextActionTypeAlipayHandleRedirect, action.Type)
+		assert.Equal(t, "alipay://pay", action.AlipayHandleRedirect.NativeURL)
+		assert.Equal(t, "https://hooks.stripe.com/alipay", action.AlipayHandleRedirect.URL)
+	}
+
+	// WeChat Pay QR code
+	{
+		data := []byte(`{
+			"wechat_pay_display_qr_code": {
+				"data": "weixin://wxpay/bizpayurl?pr=123",
+				"image_url_png": "https://qr.stripe.com/123.png"
+			},
+			"type": "wechat_pay_display_qr_code"
+		}`)
+
+		var action PaymentIntentNextAction
+		err := json.Unmarshal(data, &action)
+		assert.NoError(t, err)
+		assert.Equal(t, PaymentIntentNextActionTypeWechatPayDisplayQRCode, action.Type)
+		assert.Equal(t, "weixin://wxpay/bizpayurl?pr=123", action.WechatPayDisplayQRCode.Data)
+		assert.Equal(t, "https://qr.stripe.com/123.png", action.WechatPayDisplayQRCode.ImageURLPNG)
+	}
+
+	// WeChat Pay Android app
+	{
+		data := []byte(`{
+			"wechat_pay_redirect_to_android_app": {
+				"app_id": "wx123",
+				"partner_id": "123",
+				"prepay_id": "wx456",
+				"sign": "ABC"
+			},
+			"type": "wechat_pay_redirect_to_android_app"
+		}`)
+
+		var action PaymentIntentNextAction
+		err := json.Unmarshal(data, &action)
+		assert.NoError(t, err)
+		assert.Equal(t, PaymentIntentNextActionTypeWechatPayRedirectToAndroidApp, action.Type)
+		assert.Equal(t, "wx123", action.WechatPayRedirectToAndroidApp.AppID)
+		assert.Equal(t, "wx456", action.WechatPayRedirectToAndroidApp.PrepayID)
+	}
+}
+
+func TestPaymentIntentNextAction_UnmarshalJSON_Vouchers(t *testing.T) {
+	data := []byte(`{
+		"id": "pi_123",
+		"next_action": {
+			"multibanco_display_details": {
+				"entity": "12345",
+				"expires_at": 1600000000,
+				"hosted_voucher_url": "https://payments.stripe.com/multibanco/voucher/123",
+				"reference": "123 456 789"
+			},
+			"type": "multibanco_display_details"
+		},
+		"payment_method_options": {
+			"konbini": {"confirmation_number": "11111111110", "expires_after_days": 3},
+			"oxxo": {"expires_after_days": 2}
+		}
+	}`)
+
+	var pi PaymentIntent
+	err := json.Unmarshal(data, &pi)
+	assert.NoError(t, err)
+	assert.Equal(t, PaymentIntentNextActionTypeMultibancoDisplayDetails, pi.NextAction.Type)
+	assert.Equal(t, "12345", pi.NextAction.MultibancoDisplayDetails.Entity)
+	assert.Equal(t, "123 456 789", pi.NextAction.MultibancoDisplayDetails.Reference)
+	assert.Equal(t, int64(1600000000), pi.NextAction.MultibancoDisplayDetails.ExpiresAt)
+	assert.Equal(t, "11111111110", pi.PaymentMethodOptions.Konbini.ConfirmationNumber)
+	assert.Equal(t, int64(3), pi.PaymentMethodOptions.Konbini.ExpiresAfterDays)
+	assert.Equal(t, int64(2), pi.PaymentMethodOptions.OXXO.ExpiresAfterDays)
+}
+
+func TestPaymentIntentNextAction_UnmarshalJSON_DisplayBankTransferInstructions(t *testing.T) {
+	data := []byte(`{
+		"display_bank_transfer_instructions": {
+			"amount_remaining": 5000,
+			"currency": "eur",
+			"financial_addresses": [
+				{
+					"iban": {
+						"account_holder_name": "Stripe Technology Europe Limited",
+						"bic": "SXPYDEHH",
+						"country": "DE",
+						"iban": "DE00000000000000000001"
+					},
+					"supported_networks": ["sepa"],
+					"type": "iban"
+				}
+			],
+			"reference": "123-456",
+			"type": "eu_bank_transfer"
+		},
+		"type": "display_bank_transfer_instructions"
+	}`)
+
+	var action PaymentIntentNextAction
+	err := json.Unmarshal(data, &action)
+	assert.NoError(t, err)
+
+	instructions := action.DisplayBankTransferInstructions
+	assert.Equal(t, int64(5000), instructions.AmountRemaining)
+	assert.Equal(t, PaymentIntentNextActionDisplayBankTransferInstructionsTypeEUBankTransfer, instructions.Type)
+	assert.Equal(t, "123-456", instructions.Reference)
+	assert.Equal(t, 1, len(instructions.FinancialAddresses))
+
+	address := instructions.FinancialAddresses[0]
+	assert.Equal(t, PaymentIntentNextActionDisplayBankTransferInstructionsFinancialAddressTypeIban, address.Type)
+	assert.Equal(t, "SXPYDEHH", address.Iban.Bic)
+	assert.Equal(t, "DE00000000000000000001", address.Iban.Iban)
+}
@@ -147,5 +147,5 @@ func (i *LineItemIter) LineItemList() *stripe.LineItemList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
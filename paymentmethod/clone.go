@@ -0,0 +1,70 @@
+package paymentmethod
+
+import (
+	"errors"
+	"net/http"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+// Clone clones a payment method attached to a customer of the platform to a
+// connected account, for use with direct charges. It can optionally attach
+// the clone to a customer on the connected account so that it can be reused.
+func Clone(params *stripe.PaymentMethodCloneParams) (*stripe.PaymentMethod, error) {
+	return getC().Clone(params)
+}
+
+// Clone clones a payment method attached to a customer of the platform to a
+// connected account, for use with direct charges. It can optionally attach
+// the clone to a customer on the connected account so that it can be reused.
+//
+// The connected account is the one set on params with SetStripeAccount, or
+// else the one the client's backend was configured with through
+// BackendConfig.StripeAccount.
+//
+// If attaching the clone fails, the clone is returned along with the error so
+// that attaching it can be retried.
+func (c Client) Clone(params *stripe.PaymentMethodCloneParams) (*stripe.PaymentMethod, error) {
+	if params == nil {
+		return nil, errors.New("params cannot be nil")
+	}
+	if stripe.StringValue(params.StripeAccount) == "" && backendStripeAccount(c.B) == "" {
+		return nil, errors.New("cloning a payment method requires a connected account, set with SetStripeAccount or BackendConfig.StripeAccount")
+	}
+	if stripe.StringValue(params.Customer) == "" {
+		return nil, errors.New("cloning a payment method requires the platform customer it's attached to")
+	}
+	if stripe.StringValue(params.PaymentMethod) == "" {
+		return nil, errors.New("cloning a payment method requires the ID of the payment method")
+	}
+
+	clone := &stripe.PaymentMethod{}
+	err := c.B.Call(http.MethodPost, "/v1/payment_methods", c.Key, params, clone)
+	if err != nil {
+		return nil, err
+	}
+
+	if stripe.StringValue(params.AttachToCustomer) == "" {
+		return clone, nil
+	}
+
+	attachParams := &stripe.PaymentMethodAttachParams{
+		Customer: params.AttachToCustomer,
+	}
+	attachParams.Context = params.Context
+	attachParams.StripeAccount = params.StripeAccount
+	attached, err := c.Attach(clone.ID, attachParams)
+	if err != nil {
+		return clone, err
+	}
+	return attached, nil
+}
+
+// backendStripeAccount returns the connected account that a backend makes
+// requests on behalf of, if it's known.
+func backendStripeAccount(b stripe.Backend) string {
+	if scoped, ok := b.(interface{ StripeAccount() string }); ok {
+		return scoped.StripeAccount()
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package paymentmethod
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestPaymentMethodClone(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		assert.Equal(t, "acct_123", r.Header.Get("Stripe-Account"))
+		assert.NoError(t, r.ParseForm())
+
+		switch r.URL.Path {
+		case "/v1/payment_methods":
+			assert.Equal(t, "cus_platform", r.PostForm.Get("customer"))
+			assert.Equal(t, "pm_platform", r.PostForm.Get("payment_method"))
+			w.Write([]byte(`{"id":"pm_clone","object":"payment_method"}`))
+		case "/v1/payment_methods/pm_clone/attach":
+			assert.Equal(t, "cus_connected", r.PostForm.Get("customer"))
+			w.Write([]byte(`{"id":"pm_clone","object":"payment_method","customer":"cus_connected"}`))
+		default:
+			assert.Fail(t, "unexpected request to "+r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	params := &stripe.PaymentMethodCloneParams{
+		AttachToCustomer: stripe.String("cus_connected"),
+		Customer:         stripe.String("cus_platform"),
+		PaymentMethod:    stripe.String("pm_platform"),
+	}
+	params.SetStripeAccount("acct_123")
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	pm, err := c.Clone(params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/v1/payment_methods", "/v1/payment_methods/pm_clone/attach"}, paths)
+	assert.Equal(t, "pm_clone", pm.ID)
+	assert.Equal(t, "cus_connected", pm.Customer.ID)
+}
+
+func TestPaymentMethodClone_WithoutAttaching(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"id":"pm_clone","object":"payment_method"}`))
+	}))
+	defer ts.Close()
+
+	params := &stripe.PaymentMethodCloneParams{
+		Customer:      stripe.String("cus_platform"),
+		PaymentMethod: stripe.String("card_123"),
+	}
+	params.SetStripeAccount("acct_123")
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	pm, err := c.Clone(params)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, "pm_clone", pm.ID)
+}
+
+func TestPaymentMethodClone_BackendAccount(t *testing.T) {
+	var accounts []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accounts = append(accounts, r.Header.Get("Stripe-Account"))
+		w.Write([]byte(`{"id":"pm_clone","object":"payment_method"}`))
+	}))
+	defer ts.Close()
+
+	backend := stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{
+		LeveledLogger: &stripe.LeveledLogger{Level: stripe.LevelNull},
+		StripeAccount: stripe.String("acct_123"),
+		URL:           stripe.String(ts.URL),
+	})
+	c := Client{B: backend, Key: "sk_test_123"}
+	_, err := c.Clone(&stripe.PaymentMethodCloneParams{
+		AttachToCustomer: stripe.String("cus_connected"),
+		Customer:         stripe.String("cus_platform"),
+		PaymentMethod:    stripe.String("pm_platform"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"acct_123", "acct_123"}, accounts)
+}
+
+func TestPaymentMethodClone_RequiresAccount(t *testing.T) {
+	c := Client{Key: "sk_test_123"}
+	_, err := c.Clone(&stripe.PaymentMethodCloneParams{
+		Customer:      stripe.String("cus_platform"),
+		PaymentMethod: stripe.String("pm_platform"),
+	})
+	assert.EqualError(t, err, "cloning a payment method requires a connected account, set with SetStripeAccount or BackendConfig.StripeAccount")
+}
@@ -0,0 +1,236 @@
+package stripe
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// The constructors in this file build PaymentMethodParams for payment methods
+// whose details are entered by the customer, checking the fields each method
+// requires before a request is made. Validation is limited to the format of
+// the values: whether an account actually exists is only known to the API.
+//
+// The resulting params can be passed to paymentmethod.New, or converted with
+// PaymentIntentPaymentMethodData to create the payment method inline when
+// confirming a PaymentIntent.
+
+//
+// Public functions
+//
+
+// NewACSSDebitParams returns params for an `acss_debit` payment method using
+// a Canadian bank account.
+func NewACSSDebitParams(institutionNumber, transitNumber, accountNumber string) (*PaymentMethodParams, error) {
+	if !isDigits(institutionNumber, 3, 3) {
+		return nil, errors.New("acss_debit: institution number must be 3 digits")
+	}
+	if !isDigits(transitNumber, 5, 5) {
+		return nil, errors.New("acss_debit: transit number must be 5 digits")
+	}
+	if !isDigits(accountNumber, 7, 12) {
+		return nil, errors.New("acss_debit: account number must be 7 to 12 digits")
+	}
+
+	return &PaymentMethodParams{
+		Type: String(string(PaymentMethodTypeACSSDebit)),
+		ACSSDebit: &PaymentMethodACSSDebitParams{
+			AccountNumber:     String(accountNumber),
+			InstitutionNumber: String(institutionNumber),
+			TransitNumber:     String(transitNumber),
+		},
+	}, nil
+}
+
+// NewAUBECSDebitParams returns params for an `au_becs_debit` payment method
+// using an Australian bank account. The BSB number may include a dash (e.g.
+// `000-000`).
+func NewAUBECSDebitParams(bsbNumber, accountNumber string) (*PaymentMethodParams, error) {
+	bsbNumber = strings.Replace(bsbNumber, "-", "", -1)
+	if !isDigits(bsbNumber, 6, 6) {
+		return nil, errors.New("au_becs_debit: BSB number must be 6 digits")
+	}
+	if !isDigits(accountNumber, 5, 9) {
+		return nil, errors.New("au_becs_debit: account number must be 5 to 9 digits")
+	}
+
+	return &PaymentMethodParams{
+		Type: String(string(PaymentMethodTypeAUBECSDebit)),
+		AUBECSDebit: &PaymentMethodAUBECSDebitParams{
+			AccountNumber: String(accountNumber),
+			BSBNumber:     String(bsbNumber),
+		},
+	}, nil
+}
+
+// NewBACSDebitParams returns params for a `bacs_debit` payment method using a
+// UK bank account. The sort code may include dashes (e.g. `10-20-30`).
+func NewBACSDebitParams(sortCode, accountNumber string) (*PaymentMethodParams, error) {
+	sortCode = strings.Replace(sortCode, "-", "", -1)
+	if !isDigits(sortCode, 6, 6) {
+		return nil, errors.New("bacs_debit: sort code must be 6 digits")
+	}
+	if !isDigits(accountNumber, 8, 8) {
+		return nil, errors.New("bacs_debit: account number must be 8 digits")
+	}
+
+	return &PaymentMethodParams{
+		Type: String(string(PaymentMethodTypeBACSDebit)),
+		BACSDebit: &PaymentMethodBACSDebitParams{
+			AccountNumber: String(accountNumber),
+			SortCode:      String(sortCode),
+		},
+	}, nil
+}
+
+// NewIdealParams returns params for an `ideal` payment method with the
+// customer's bank (e.g. `ing` or `rabobank`).
+func NewIdealParams(bank string) (*PaymentMethodParams, error) {
+	if bank == "" {
+		return nil, errors.New("ideal: bank is required")
+	}
+
+	return &PaymentMethodParams{
+		Type: String(string(PaymentMethodTypeIdeal)),
+		Ideal: &PaymentMethodIdealParams{
+			Bank: String(bank),
+		},
+	}, nil
+}
+
+// NewSEPADebitParams returns params for a `sepa_debit` payment method. The
+// IBAN may be given in its printed form, with spaces and in lower case, and
+// its check digits are verified.
+func NewSEPADebitParams(iban string) (*PaymentMethodParams, error) {
+	iban = strings.ToUpper(strings.Replace(iban, " ", "", -1))
+	if err := validateIBAN(iban); err != nil {
+		return nil, fmt.Errorf("sepa_debit: %v", err)
+	}
+
+	return &PaymentMethodParams{
+		Type: String(string(PaymentMethodTypeSepaDebit)),
+		SepaDebit: &PaymentMethodSepaDebitParams{
+			Iban: String(iban),
+		},
+	}, nil
+}
+
+// NewUSBankAccountParams returns params for a `us_bank_account` payment
+// method. The routing number's checksum is verified, and holderType must be
+// one of the PaymentMethodUSBankAccountAccountHolderType values.
+func NewUSBankAccountParams(routingNumber, accountNumber string, holderType PaymentMethodUSBankAccountAccountHolderType) (*PaymentMethodParams, error) {
+	if !isDigits(routingNumber, 9, 9) || !validABARoutingNumber(routingNumber) {
+		return nil, fmt.Errorf("us_bank_account: invalid routing number %q", routingNumber)
+	}
+	if !isDigits(accountNumber, 4, 17) {
+		return nil, errors.New("us_bank_account: account number must be 4 to 17 digits")
+	}
+	switch holderType {
+	case PaymentMethodUSBankAccountAccountHolderTypeCompany,
+		PaymentMethodUSBankAccountAccountHolderTypeIndividual:
+	default:
+		return nil, fmt.Errorf("us_bank_account: invalid account holder type %q", holderType)
+	}
+
+	return &PaymentMethodParams{
+		Type: String(string(PaymentMethodTypeUSBankAccount)),
+		USBankAccount: &PaymentMethodUSBankAccountParams{
+			AccountHolderType: String(string(holderType)),
+			AccountNumber:     String(accountNumber),
+			RoutingNumber:     String(routingNumber),
+		},
+	}, nil
+}
+
+// PaymentIntentPaymentMethodData converts params built by one of the
+// constructors in this file into the equivalent payment_method_data for a
+// PaymentIntent, so that the payment method is created as part of creating or
+// confirming the PaymentIntent. Billing details and metadata are carried over.
+func (p *PaymentMethodParams) PaymentIntentPaymentMethodData() *PaymentIntentPaymentMethodDataParams {
+	data := &PaymentIntentPaymentMethodDataParams{
+		ACSSDebit:      p.ACSSDebit,
+		AUBECSDebit:    p.AUBECSDebit,
+		BACSDebit:      p.BACSDebit,
+		BillingDetails: p.BillingDetails,
+		Ideal:          p.Ideal,
+		Metadata:       p.Metadata,
+		SepaDebit:      p.SepaDebit,
+		Type:           p.Type,
+	}
+	if p.USBankAccount != nil {
+		data.USBankAccount = &PaymentIntentPaymentMethodDataUSBankAccountParams{
+			AccountHolderType:           p.USBankAccount.AccountHolderType,
+			AccountNumber:               p.USBankAccount.AccountNumber,
+			AccountType:                 p.USBankAccount.AccountType,
+			FinancialConnectionsAccount: p.USBankAccount.FinancialConnectionsAccount,
+			RoutingNumber:               p.USBankAccount.RoutingNumber,
+		}
+	}
+	return data
+}
+
+//
+// Private functions
+//
+
+func isDigits(s string, minLen, maxLen int) bool {
+	if len(s) < minLen || len(s) > maxLen {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// validABARoutingNumber checks the weighted checksum of a 9-digit ABA
+// routing number.
+func validABARoutingNumber(s string) bool {
+	weights := [9]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+	sum := 0
+	for i, r := range s {
+		sum += int(r-'0') * weights[i]
+	}
+	return sum%10 == 0
+}
+
+// validateIBAN checks the structure of an IBAN and its ISO 7064 mod 97-10
+// check digits. The IBAN must already be normalized to upper case without
+// spaces.
+func validateIBAN(iban string) error {
+	if len(iban) < 15 || len(iban) > 34 {
+		return errors.New("IBAN must be 15 to 34 characters")
+	}
+	for i, r := range iban {
+		isLetter := r >= 'A' && r <= 'Z'
+		isDigit := r >= '0' && r <= '9'
+		switch {
+		case i < 2 && !isLetter:
+			return errors.New("IBAN must start with a country code")
+		case i >= 2 && i < 4 && !isDigit:
+			return errors.New("IBAN must have numeric check digits")
+		case !isLetter && !isDigit:
+			return fmt.Errorf("IBAN contains invalid character %q", r)
+		}
+	}
+
+	// Move the country code and check digits to the end and replace every
+	// letter with two digits (A = 10, ..., Z = 35). A valid IBAN leaves a
+	// remainder of 1 when divided by 97.
+	var numeric strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			fmt.Fprintf(&numeric, "%d", r-'A'+10)
+		} else {
+			numeric.WriteRune(r)
+		}
+	}
+	n, _ := new(big.Int).SetString(numeric.String(), 10)
+	if new(big.Int).Mod(n, big.NewInt(97)).Int64() != 1 {
+		return errors.New("IBAN check digits are invalid")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package stripe
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+func TestNewACSSDebitParams(t *testing.T) {
+	params, err := NewACSSDebitParams("000", "11000", "000123456789")
+	assert.NoError(t, err)
+	assert.Equal(t, "acss_debit", *params.Type)
+	assert.Equal(t, "000123456789", *params.ACSSDebit.AccountNumber)
+
+	_, err = NewACSSDebitParams("00", "11000", "000123456789")
+	assert.Error(t, err)
+}
+
+func TestNewAUBECSDebitParams(t *testing.T) {
+	params, err := NewAUBECSDebitParams("000-000", "000123456")
+	assert.NoError(t, err)
+	assert.Equal(t, "au_becs_debit", *params.Type)
+	assert.Equal(t, "000000", *params.AUBECSDebit.BSBNumber)
+
+	_, err = NewAUBECSDebitParams("000000", "12")
+	assert.Error(t, err)
+}
+
+func TestNewBACSDebitParams(t *testing.T) {
+	params, err := NewBACSDebitParams("10-88-00", "00012345")
+	assert.NoError(t, err)
+	assert.Equal(t, "bacs_debit", *params.Type)
+	assert.Equal(t, "108800", *params.BACSDebit.SortCode)
+
+	_, err = NewBACSDebitParams("108800", "0001234x")
+	assert.Error(t, err)
+}
+
+func TestNewIdealParams(t *testing.T) {
+	params, err := NewIdealParams("ing")
+	assert.NoError(t, err)
+	assert.Equal(t, "ideal", *params.Type)
+	assert.Equal(t, "ing", *params.Ideal.Bank)
+
+	_, err = NewIdealParams("")
+	assert.Error(t, err)
+}
+
+func TestNewSEPADebitParams(t *testing.T) {
+	params, err := NewSEPADebitParams("de89 3704 0044 0532 0130 00")
+	assert.NoError(t, err)
+	assert.Equal(t, "sepa_debit", *params.Type)
+	assert.Equal(t, "DE89370400440532013000", *params.SepaDebit.Iban)
+
+	// Wrong check digits
+	_, err = NewSEPADebitParams("DE88370400440532013000")
+	assert.Error(t, err)
+
+	// Too short
+	_, err = NewSEPADebitParams("DE89")
+	assert.Error(t, err)
+}
+
+func TestNewUSBankAccountParams(t *testing.T) {
+	params, err := NewUSBankAccountParams("110000000", "000123456789",
+		PaymentMethodUSBankAccountAccountHolderTypeIndividual)
+	assert.NoError(t, err)
+	assert.Equal(t, "us_bank_account", *params.Type)
+	assert.Equal(t, "individual", *params.USBankAccount.AccountHolderType)
+
+	// Bad routing number checksum
+	_, err = NewUSBankAccountParams("110000001", "000123456789",
+		PaymentMethodUSBankAccountAccountHolderTypeIndividual)
+	assert.Error(t, err)
+
+	_, err = NewUSBankAccountParams("110000000", "000123456789", "person")
+	assert.Error(t, err)
+}
+
+func TestPaymentMethodParams_PaymentIntentPaymentMethodData(t *testing.T) {
+	params, err := NewUSBankAccountParams("110000000", "000123456789",
+		PaymentMethodUSBankAccountAccountHolderTypeCompany)
+	assert.NoError(t, err)
+	params.BillingDetails = &BillingDetailsParams{Name: String("Jenny Rosen")}
+
+	body := &form.Values{}
+	form.AppendTo(body, params.PaymentIntentPaymentMethodData())
+	assert.Equal(t, []string{"us_bank_account"}, body.Get("type"))
+	assert.Equal(t, []string{"Jenny Rosen"}, body.Get("billing_details[name]"))
+	assert.Equal(t, []string{"110000000"}, body.Get("us_bank_account[routing_number]"))
+	assert.Equal(t, []string{"company"}, body.Get("us_bank_account[account_holder_type]"))
+}
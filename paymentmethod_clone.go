@@ -0,0 +1,20 @@
+package stripe
+
+// PaymentMethodCloneParams is the set of parameters for cloning a payment
+// method belonging to a customer of the platform to a connected account with
+// paymentmethod.Clone. The connected account is given with SetStripeAccount,
+// or else it's the one the client's backend was configured with through
+// BackendConfig.StripeAccount. When both are set, SetStripeAccount wins.
+type PaymentMethodCloneParams struct {
+	Params `form:"*"`
+	// The ID of a customer on the connected account to attach the clone to.
+	// If unset, the clone isn't attached and can only be used for a single
+	// payment.
+	AttachToCustomer *string `form:"-"`
+	// The ID of the platform customer to whom the original payment method is
+	// attached.
+	Customer *string `form:"customer"`
+	// The ID of the payment method to clone. IDs of legacy cards and sources
+	// attached to the customer are accepted too.
+	PaymentMethod *string `form:"payment_method"`
+}
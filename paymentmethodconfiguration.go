@@ -0,0 +1,1554 @@
+//
+//
+// File generated from our OpenAPI spec
+//
+//
+
+package stripe
+
+// The account's display preference.
+type PaymentMethodConfigurationACSSDebitDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationACSSDebitDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationACSSDebitDisplayPreferencePreferenceNone PaymentMethodConfigurationACSSDebitDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationACSSDebitDisplayPreferencePreferenceOff  PaymentMethodConfigurationACSSDebitDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationACSSDebitDisplayPreferencePreferenceOn   PaymentMethodConfigurationACSSDebitDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationACSSDebitDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationACSSDebitDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationACSSDebitDisplayPreferenceValueOff PaymentMethodConfigurationACSSDebitDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationACSSDebitDisplayPreferenceValueOn  PaymentMethodConfigurationACSSDebitDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationAffirmDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationAffirmDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationAffirmDisplayPreferencePreferenceNone PaymentMethodConfigurationAffirmDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationAffirmDisplayPreferencePreferenceOff  PaymentMethodConfigurationAffirmDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationAffirmDisplayPreferencePreferenceOn   PaymentMethodConfigurationAffirmDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationAffirmDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationAffirmDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationAffirmDisplayPreferenceValueOff PaymentMethodConfigurationAffirmDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationAffirmDisplayPreferenceValueOn  PaymentMethodConfigurationAffirmDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreferenceNone PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreferenceOff  PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreferenceOn   PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceValueOff PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceValueOn  PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationAlipayDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationAlipayDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationAlipayDisplayPreferencePreferenceNone PaymentMethodConfigurationAlipayDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationAlipayDisplayPreferencePreferenceOff  PaymentMethodConfigurationAlipayDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationAlipayDisplayPreferencePreferenceOn   PaymentMethodConfigurationAlipayDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationAlipayDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationAlipayDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationAlipayDisplayPreferenceValueOff PaymentMethodConfigurationAlipayDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationAlipayDisplayPreferenceValueOn  PaymentMethodConfigurationAlipayDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationApplePayDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationApplePayDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationApplePayDisplayPreferencePreferenceNone PaymentMethodConfigurationApplePayDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationApplePayDisplayPreferencePreferenceOff  PaymentMethodConfigurationApplePayDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationApplePayDisplayPreferencePreferenceOn   PaymentMethodConfigurationApplePayDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationApplePayDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationApplePayDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationApplePayDisplayPreferenceValueOff PaymentMethodConfigurationApplePayDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationApplePayDisplayPreferenceValueOn  PaymentMethodConfigurationApplePayDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreferenceNone PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreferenceOff  PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreferenceOn   PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationAUBECSDebitDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationAUBECSDebitDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationAUBECSDebitDisplayPreferenceValueOff PaymentMethodConfigurationAUBECSDebitDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationAUBECSDebitDisplayPreferenceValueOn  PaymentMethodConfigurationAUBECSDebitDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationBACSDebitDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationBACSDebitDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationBACSDebitDisplayPreferencePreferenceNone PaymentMethodConfigurationBACSDebitDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationBACSDebitDisplayPreferencePreferenceOff  PaymentMethodConfigurationBACSDebitDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationBACSDebitDisplayPreferencePreferenceOn   PaymentMethodConfigurationBACSDebitDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationBACSDebitDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationBACSDebitDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationBACSDebitDisplayPreferenceValueOff PaymentMethodConfigurationBACSDebitDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationBACSDebitDisplayPreferenceValueOn  PaymentMethodConfigurationBACSDebitDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationBancontactDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationBancontactDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationBancontactDisplayPreferencePreferenceNone PaymentMethodConfigurationBancontactDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationBancontactDisplayPreferencePreferenceOff  PaymentMethodConfigurationBancontactDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationBancontactDisplayPreferencePreferenceOn   PaymentMethodConfigurationBancontactDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationBancontactDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationBancontactDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationBancontactDisplayPreferenceValueOff PaymentMethodConfigurationBancontactDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationBancontactDisplayPreferenceValueOn  PaymentMethodConfigurationBancontactDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationBLIKDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationBLIKDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationBLIKDisplayPreferencePreferenceNone PaymentMethodConfigurationBLIKDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationBLIKDisplayPreferencePreferenceOff  PaymentMethodConfigurationBLIKDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationBLIKDisplayPreferencePreferenceOn   PaymentMethodConfigurationBLIKDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationBLIKDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationBLIKDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationBLIKDisplayPreferenceValueOff PaymentMethodConfigurationBLIKDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationBLIKDisplayPreferenceValueOn  PaymentMethodConfigurationBLIKDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationBoletoDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationBoletoDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationBoletoDisplayPreferencePreferenceNone PaymentMethodConfigurationBoletoDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationBoletoDisplayPreferencePreferenceOff  PaymentMethodConfigurationBoletoDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationBoletoDisplayPreferencePreferenceOn   PaymentMethodConfigurationBoletoDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationBoletoDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationBoletoDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationBoletoDisplayPreferenceValueOff PaymentMethodConfigurationBoletoDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationBoletoDisplayPreferenceValueOn  PaymentMethodConfigurationBoletoDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationCardDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationCardDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationCardDisplayPreferencePreferenceNone PaymentMethodConfigurationCardDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationCardDisplayPreferencePreferenceOff  PaymentMethodConfigurationCardDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationCardDisplayPreferencePreferenceOn   PaymentMethodConfigurationCardDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationCardDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationCardDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationCardDisplayPreferenceValueOff PaymentMethodConfigurationCardDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationCardDisplayPreferenceValueOn  PaymentMethodConfigurationCardDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationCartesBancairesDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationCartesBancairesDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationCartesBancairesDisplayPreferencePreferenceNone PaymentMethodConfigurationCartesBancairesDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationCartesBancairesDisplayPreferencePreferenceOff  PaymentMethodConfigurationCartesBancairesDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationCartesBancairesDisplayPreferencePreferenceOn   PaymentMethodConfigurationCartesBancairesDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationCartesBancairesDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationCartesBancairesDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationCartesBancairesDisplayPreferenceValueOff PaymentMethodConfigurationCartesBancairesDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationCartesBancairesDisplayPreferenceValueOn  PaymentMethodConfigurationCartesBancairesDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationCashappDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationCashappDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationCashappDisplayPreferencePreferenceNone PaymentMethodConfigurationCashappDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationCashappDisplayPreferencePreferenceOff  PaymentMethodConfigurationCashappDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationCashappDisplayPreferencePreferenceOn   PaymentMethodConfigurationCashappDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationCashappDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationCashappDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationCashappDisplayPreferenceValueOff PaymentMethodConfigurationCashappDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationCashappDisplayPreferenceValueOn  PaymentMethodConfigurationCashappDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationEPSDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationEPSDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationEPSDisplayPreferencePreferenceNone PaymentMethodConfigurationEPSDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationEPSDisplayPreferencePreferenceOff  PaymentMethodConfigurationEPSDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationEPSDisplayPreferencePreferenceOn   PaymentMethodConfigurationEPSDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationEPSDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationEPSDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationEPSDisplayPreferenceValueOff PaymentMethodConfigurationEPSDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationEPSDisplayPreferenceValueOn  PaymentMethodConfigurationEPSDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationFPXDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationFPXDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationFPXDisplayPreferencePreferenceNone PaymentMethodConfigurationFPXDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationFPXDisplayPreferencePreferenceOff  PaymentMethodConfigurationFPXDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationFPXDisplayPreferencePreferenceOn   PaymentMethodConfigurationFPXDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationFPXDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationFPXDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationFPXDisplayPreferenceValueOff PaymentMethodConfigurationFPXDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationFPXDisplayPreferenceValueOn  PaymentMethodConfigurationFPXDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationGiropayDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationGiropayDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationGiropayDisplayPreferencePreferenceNone PaymentMethodConfigurationGiropayDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationGiropayDisplayPreferencePreferenceOff  PaymentMethodConfigurationGiropayDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationGiropayDisplayPreferencePreferenceOn   PaymentMethodConfigurationGiropayDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationGiropayDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationGiropayDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationGiropayDisplayPreferenceValueOff PaymentMethodConfigurationGiropayDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationGiropayDisplayPreferenceValueOn  PaymentMethodConfigurationGiropayDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationGooglePayDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationGooglePayDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationGooglePayDisplayPreferencePreferenceNone PaymentMethodConfigurationGooglePayDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationGooglePayDisplayPreferencePreferenceOff  PaymentMethodConfigurationGooglePayDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationGooglePayDisplayPreferencePreferenceOn   PaymentMethodConfigurationGooglePayDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationGooglePayDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationGooglePayDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationGooglePayDisplayPreferenceValueOff PaymentMethodConfigurationGooglePayDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationGooglePayDisplayPreferenceValueOn  PaymentMethodConfigurationGooglePayDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationGrabpayDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationGrabpayDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationGrabpayDisplayPreferencePreferenceNone PaymentMethodConfigurationGrabpayDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationGrabpayDisplayPreferencePreferenceOff  PaymentMethodConfigurationGrabpayDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationGrabpayDisplayPreferencePreferenceOn   PaymentMethodConfigurationGrabpayDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationGrabpayDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationGrabpayDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationGrabpayDisplayPreferenceValueOff PaymentMethodConfigurationGrabpayDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationGrabpayDisplayPreferenceValueOn  PaymentMethodConfigurationGrabpayDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationIdealDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationIdealDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationIdealDisplayPreferencePreferenceNone PaymentMethodConfigurationIdealDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationIdealDisplayPreferencePreferenceOff  PaymentMethodConfigurationIdealDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationIdealDisplayPreferencePreferenceOn   PaymentMethodConfigurationIdealDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationIdealDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationIdealDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationIdealDisplayPreferenceValueOff PaymentMethodConfigurationIdealDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationIdealDisplayPreferenceValueOn  PaymentMethodConfigurationIdealDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationJCBDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationJCBDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationJCBDisplayPreferencePreferenceNone PaymentMethodConfigurationJCBDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationJCBDisplayPreferencePreferenceOff  PaymentMethodConfigurationJCBDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationJCBDisplayPreferencePreferenceOn   PaymentMethodConfigurationJCBDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationJCBDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationJCBDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationJCBDisplayPreferenceValueOff PaymentMethodConfigurationJCBDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationJCBDisplayPreferenceValueOn  PaymentMethodConfigurationJCBDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationKlarnaDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationKlarnaDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationKlarnaDisplayPreferencePreferenceNone PaymentMethodConfigurationKlarnaDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationKlarnaDisplayPreferencePreferenceOff  PaymentMethodConfigurationKlarnaDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationKlarnaDisplayPreferencePreferenceOn   PaymentMethodConfigurationKlarnaDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationKlarnaDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationKlarnaDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationKlarnaDisplayPreferenceValueOff PaymentMethodConfigurationKlarnaDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationKlarnaDisplayPreferenceValueOn  PaymentMethodConfigurationKlarnaDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationKonbiniDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationKonbiniDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationKonbiniDisplayPreferencePreferenceNone PaymentMethodConfigurationKonbiniDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationKonbiniDisplayPreferencePreferenceOff  PaymentMethodConfigurationKonbiniDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationKonbiniDisplayPreferencePreferenceOn   PaymentMethodConfigurationKonbiniDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationKonbiniDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationKonbiniDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationKonbiniDisplayPreferenceValueOff PaymentMethodConfigurationKonbiniDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationKonbiniDisplayPreferenceValueOn  PaymentMethodConfigurationKonbiniDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationLinkDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationLinkDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationLinkDisplayPreferencePreferenceNone PaymentMethodConfigurationLinkDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationLinkDisplayPreferencePreferenceOff  PaymentMethodConfigurationLinkDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationLinkDisplayPreferencePreferenceOn   PaymentMethodConfigurationLinkDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationLinkDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationLinkDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationLinkDisplayPreferenceValueOff PaymentMethodConfigurationLinkDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationLinkDisplayPreferenceValueOn  PaymentMethodConfigurationLinkDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationOXXODisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationOXXODisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationOXXODisplayPreferencePreferenceNone PaymentMethodConfigurationOXXODisplayPreferencePreference = "none"
+	PaymentMethodConfigurationOXXODisplayPreferencePreferenceOff  PaymentMethodConfigurationOXXODisplayPreferencePreference = "off"
+	PaymentMethodConfigurationOXXODisplayPreferencePreferenceOn   PaymentMethodConfigurationOXXODisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationOXXODisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationOXXODisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationOXXODisplayPreferenceValueOff PaymentMethodConfigurationOXXODisplayPreferenceValue = "off"
+	PaymentMethodConfigurationOXXODisplayPreferenceValueOn  PaymentMethodConfigurationOXXODisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationP24DisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationP24DisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationP24DisplayPreferencePreferenceNone PaymentMethodConfigurationP24DisplayPreferencePreference = "none"
+	PaymentMethodConfigurationP24DisplayPreferencePreferenceOff  PaymentMethodConfigurationP24DisplayPreferencePreference = "off"
+	PaymentMethodConfigurationP24DisplayPreferencePreferenceOn   PaymentMethodConfigurationP24DisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationP24DisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationP24DisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationP24DisplayPreferenceValueOff PaymentMethodConfigurationP24DisplayPreferenceValue = "off"
+	PaymentMethodConfigurationP24DisplayPreferenceValueOn  PaymentMethodConfigurationP24DisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationPayNowDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationPayNowDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationPayNowDisplayPreferencePreferenceNone PaymentMethodConfigurationPayNowDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationPayNowDisplayPreferencePreferenceOff  PaymentMethodConfigurationPayNowDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationPayNowDisplayPreferencePreferenceOn   PaymentMethodConfigurationPayNowDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationPayNowDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationPayNowDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationPayNowDisplayPreferenceValueOff PaymentMethodConfigurationPayNowDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationPayNowDisplayPreferenceValueOn  PaymentMethodConfigurationPayNowDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationPromptPayDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationPromptPayDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationPromptPayDisplayPreferencePreferenceNone PaymentMethodConfigurationPromptPayDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationPromptPayDisplayPreferencePreferenceOff  PaymentMethodConfigurationPromptPayDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationPromptPayDisplayPreferencePreferenceOn   PaymentMethodConfigurationPromptPayDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationPromptPayDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationPromptPayDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationPromptPayDisplayPreferenceValueOff PaymentMethodConfigurationPromptPayDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationPromptPayDisplayPreferenceValueOn  PaymentMethodConfigurationPromptPayDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationSepaDebitDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationSepaDebitDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationSepaDebitDisplayPreferencePreferenceNone PaymentMethodConfigurationSepaDebitDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationSepaDebitDisplayPreferencePreferenceOff  PaymentMethodConfigurationSepaDebitDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationSepaDebitDisplayPreferencePreferenceOn   PaymentMethodConfigurationSepaDebitDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationSepaDebitDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationSepaDebitDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationSepaDebitDisplayPreferenceValueOff PaymentMethodConfigurationSepaDebitDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationSepaDebitDisplayPreferenceValueOn  PaymentMethodConfigurationSepaDebitDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationSofortDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationSofortDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationSofortDisplayPreferencePreferenceNone PaymentMethodConfigurationSofortDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationSofortDisplayPreferencePreferenceOff  PaymentMethodConfigurationSofortDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationSofortDisplayPreferencePreferenceOn   PaymentMethodConfigurationSofortDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationSofortDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationSofortDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationSofortDisplayPreferenceValueOff PaymentMethodConfigurationSofortDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationSofortDisplayPreferenceValueOn  PaymentMethodConfigurationSofortDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationUSBankAccountDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationUSBankAccountDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationUSBankAccountDisplayPreferencePreferenceNone PaymentMethodConfigurationUSBankAccountDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationUSBankAccountDisplayPreferencePreferenceOff  PaymentMethodConfigurationUSBankAccountDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationUSBankAccountDisplayPreferencePreferenceOn   PaymentMethodConfigurationUSBankAccountDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationUSBankAccountDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationUSBankAccountDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationUSBankAccountDisplayPreferenceValueOff PaymentMethodConfigurationUSBankAccountDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationUSBankAccountDisplayPreferenceValueOn  PaymentMethodConfigurationUSBankAccountDisplayPreferenceValue = "on"
+)
+
+// The account's display preference.
+type PaymentMethodConfigurationWechatPayDisplayPreferencePreference string
+
+// List of values that PaymentMethodConfigurationWechatPayDisplayPreferencePreference can take
+const (
+	PaymentMethodConfigurationWechatPayDisplayPreferencePreferenceNone PaymentMethodConfigurationWechatPayDisplayPreferencePreference = "none"
+	PaymentMethodConfigurationWechatPayDisplayPreferencePreferenceOff  PaymentMethodConfigurationWechatPayDisplayPreferencePreference = "off"
+	PaymentMethodConfigurationWechatPayDisplayPreferencePreferenceOn   PaymentMethodConfigurationWechatPayDisplayPreferencePreference = "on"
+)
+
+// The effective display preference value.
+type PaymentMethodConfigurationWechatPayDisplayPreferenceValue string
+
+// List of values that PaymentMethodConfigurationWechatPayDisplayPreferenceValue can take
+const (
+	PaymentMethodConfigurationWechatPayDisplayPreferenceValueOff PaymentMethodConfigurationWechatPayDisplayPreferenceValue = "off"
+	PaymentMethodConfigurationWechatPayDisplayPreferenceValueOn  PaymentMethodConfigurationWechatPayDisplayPreferenceValue = "on"
+)
+
+// List payment method configurations
+type PaymentMethodConfigurationListParams struct {
+	ListParams `form:"*"`
+	// The Connect application to filter by.
+	Application *string `form:"application"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationACSSDebitDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `acss_debit` payment method.
+type PaymentMethodConfigurationACSSDebitParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationACSSDebitDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationAffirmDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `affirm` payment method.
+type PaymentMethodConfigurationAffirmParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationAffirmDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `afterpay_clearpay` payment method.
+type PaymentMethodConfigurationAfterpayClearpayParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationAlipayDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `alipay` payment method.
+type PaymentMethodConfigurationAlipayParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationAlipayDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationApplePayDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `apple_pay` payment method.
+type PaymentMethodConfigurationApplePayParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationApplePayDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationAUBECSDebitDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `au_becs_debit` payment method.
+type PaymentMethodConfigurationAUBECSDebitParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationAUBECSDebitDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationBACSDebitDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `bacs_debit` payment method.
+type PaymentMethodConfigurationBACSDebitParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationBACSDebitDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationBancontactDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `bancontact` payment method.
+type PaymentMethodConfigurationBancontactParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationBancontactDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationBLIKDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `blik` payment method.
+type PaymentMethodConfigurationBLIKParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationBLIKDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationBoletoDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `boleto` payment method.
+type PaymentMethodConfigurationBoletoParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationBoletoDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationCardDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `card` payment method.
+type PaymentMethodConfigurationCardParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationCardDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationCartesBancairesDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `cartes_bancaires` payment method.
+type PaymentMethodConfigurationCartesBancairesParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationCartesBancairesDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationCashappDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `cashapp` payment method.
+type PaymentMethodConfigurationCashappParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationCashappDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationEPSDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `eps` payment method.
+type PaymentMethodConfigurationEPSParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationEPSDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationFPXDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `fpx` payment method.
+type PaymentMethodConfigurationFPXParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationFPXDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationGiropayDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `giropay` payment method.
+type PaymentMethodConfigurationGiropayParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationGiropayDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationGooglePayDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `google_pay` payment method.
+type PaymentMethodConfigurationGooglePayParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationGooglePayDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationGrabpayDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `grabpay` payment method.
+type PaymentMethodConfigurationGrabpayParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationGrabpayDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationIdealDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `ideal` payment method.
+type PaymentMethodConfigurationIdealParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationIdealDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationJCBDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `jcb` payment method.
+type PaymentMethodConfigurationJCBParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationJCBDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationKlarnaDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `klarna` payment method.
+type PaymentMethodConfigurationKlarnaParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationKlarnaDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationKonbiniDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `konbini` payment method.
+type PaymentMethodConfigurationKonbiniParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationKonbiniDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationLinkDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `link` payment method.
+type PaymentMethodConfigurationLinkParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationLinkDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationOXXODisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `oxxo` payment method.
+type PaymentMethodConfigurationOXXOParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationOXXODisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationP24DisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `p24` payment method.
+type PaymentMethodConfigurationP24Params struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationP24DisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationPayNowDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `paynow` payment method.
+type PaymentMethodConfigurationPayNowParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationPayNowDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationPromptPayDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `promptpay` payment method.
+type PaymentMethodConfigurationPromptPayParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationPromptPayDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationSepaDebitDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `sepa_debit` payment method.
+type PaymentMethodConfigurationSepaDebitParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationSepaDebitDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationSofortDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `sofort` payment method.
+type PaymentMethodConfigurationSofortParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationSofortDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationUSBankAccountDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `us_bank_account` payment method.
+type PaymentMethodConfigurationUSBankAccountParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationUSBankAccountDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Whether or not the payment method should be displayed.
+type PaymentMethodConfigurationWechatPayDisplayPreferenceParams struct {
+	// The account's preference for whether or not to display this payment method.
+	Preference *string `form:"preference"`
+}
+
+// Configuration for the `wechat_pay` payment method.
+type PaymentMethodConfigurationWechatPayParams struct {
+	// Whether or not the payment method should be displayed.
+	DisplayPreference *PaymentMethodConfigurationWechatPayDisplayPreferenceParams `form:"display_preference"`
+}
+
+// Creates a payment method configuration
+type PaymentMethodConfigurationParams struct {
+	Params `form:"*"`
+	// Configuration for the `acss_debit` payment method.
+	ACSSDebit *PaymentMethodConfigurationACSSDebitParams `form:"acss_debit"`
+	// Whether the configuration can be used for new payments.
+	Active *bool `form:"active"`
+	// Configuration for the `affirm` payment method.
+	Affirm *PaymentMethodConfigurationAffirmParams `form:"affirm"`
+	// Configuration for the `afterpay_clearpay` payment method.
+	AfterpayClearpay *PaymentMethodConfigurationAfterpayClearpayParams `form:"afterpay_clearpay"`
+	// Configuration for the `alipay` payment method.
+	Alipay *PaymentMethodConfigurationAlipayParams `form:"alipay"`
+	// Configuration for the `apple_pay` payment method.
+	ApplePay *PaymentMethodConfigurationApplePayParams `form:"apple_pay"`
+	// Configuration for the `au_becs_debit` payment method.
+	AUBECSDebit *PaymentMethodConfigurationAUBECSDebitParams `form:"au_becs_debit"`
+	// Configuration for the `bacs_debit` payment method.
+	BACSDebit *PaymentMethodConfigurationBACSDebitParams `form:"bacs_debit"`
+	// Configuration for the `bancontact` payment method.
+	Bancontact *PaymentMethodConfigurationBancontactParams `form:"bancontact"`
+	// Configuration for the `blik` payment method.
+	BLIK *PaymentMethodConfigurationBLIKParams `form:"blik"`
+	// Configuration for the `boleto` payment method.
+	Boleto *PaymentMethodConfigurationBoletoParams `form:"boleto"`
+	// Configuration for the `card` payment method.
+	Card *PaymentMethodConfigurationCardParams `form:"card"`
+	// Configuration for the `cartes_bancaires` payment method.
+	CartesBancaires *PaymentMethodConfigurationCartesBancairesParams `form:"cartes_bancaires"`
+	// Configuration for the `cashapp` payment method.
+	Cashapp *PaymentMethodConfigurationCashappParams `form:"cashapp"`
+	// Configuration for the `eps` payment method.
+	EPS *PaymentMethodConfigurationEPSParams `form:"eps"`
+	// Configuration for the `fpx` payment method.
+	FPX *PaymentMethodConfigurationFPXParams `form:"fpx"`
+	// Configuration for the `giropay` payment method.
+	Giropay *PaymentMethodConfigurationGiropayParams `form:"giropay"`
+	// Configuration for the `google_pay` payment method.
+	GooglePay *PaymentMethodConfigurationGooglePayParams `form:"google_pay"`
+	// Configuration for the `grabpay` payment method.
+	Grabpay *PaymentMethodConfigurationGrabpayParams `form:"grabpay"`
+	// Configuration for the `ideal` payment method.
+	Ideal *PaymentMethodConfigurationIdealParams `form:"ideal"`
+	// Configuration for the `jcb` payment method.
+	JCB *PaymentMethodConfigurationJCBParams `form:"jcb"`
+	// Configuration for the `klarna` payment method.
+	Klarna *PaymentMethodConfigurationKlarnaParams `form:"klarna"`
+	// Configuration for the `konbini` payment method.
+	Konbini *PaymentMethodConfigurationKonbiniParams `form:"konbini"`
+	// Configuration for the `link` payment method.
+	Link *PaymentMethodConfigurationLinkParams `form:"link"`
+	// Configuration name.
+	Name *string `form:"name"`
+	// Configuration for the `oxxo` payment method.
+	OXXO *PaymentMethodConfigurationOXXOParams `form:"oxxo"`
+	// Configuration for the `p24` payment method.
+	P24 *PaymentMethodConfigurationP24Params `form:"p24"`
+	// Configuration's parent configuration. Specify to create a child configuration.
+	Parent *string `form:"parent"`
+	// Configuration for the `paynow` payment method.
+	PayNow *PaymentMethodConfigurationPayNowParams `form:"paynow"`
+	// Configuration for the `promptpay` payment method.
+	PromptPay *PaymentMethodConfigurationPromptPayParams `form:"promptpay"`
+	// Configuration for the `sepa_debit` payment method.
+	SepaDebit *PaymentMethodConfigurationSepaDebitParams `form:"sepa_debit"`
+	// Configuration for the `sofort` payment method.
+	Sofort *PaymentMethodConfigurationSofortParams `form:"sofort"`
+	// Configuration for the `us_bank_account` payment method.
+	USBankAccount *PaymentMethodConfigurationUSBankAccountParams `form:"us_bank_account"`
+	// Configuration for the `wechat_pay` payment method.
+	WechatPay *PaymentMethodConfigurationWechatPayParams `form:"wechat_pay"`
+}
+
+type PaymentMethodConfigurationACSSDebitDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationACSSDebitDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationACSSDebitDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationACSSDebit struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                  `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationACSSDebitDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationAffirmDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationAffirmDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationAffirmDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationAffirm struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                               `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationAffirmDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationAfterpayClearpayDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationAfterpayClearpayDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationAfterpayClearpayDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationAfterpayClearpay struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                         `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationAfterpayClearpayDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationAlipayDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationAlipayDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationAlipayDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationAlipay struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                               `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationAlipayDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationApplePayDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationApplePayDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationApplePayDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationApplePay struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                 `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationApplePayDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationAUBECSDebitDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationAUBECSDebitDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationAUBECSDebitDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationAUBECSDebit struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                    `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationAUBECSDebitDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationBACSDebitDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationBACSDebitDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationBACSDebitDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationBACSDebit struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                  `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationBACSDebitDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationBancontactDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationBancontactDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationBancontactDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationBancontact struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                   `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationBancontactDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationBLIKDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationBLIKDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationBLIKDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationBLIK struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                             `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationBLIKDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationBoletoDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationBoletoDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationBoletoDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationBoleto struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                               `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationBoletoDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationCardDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationCardDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationCardDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationCard struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                             `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationCardDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationCartesBancairesDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationCartesBancairesDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationCartesBancairesDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationCartesBancaires struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                        `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationCartesBancairesDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationCashappDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationCashappDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationCashappDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationCashapp struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationCashappDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationEPSDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationEPSDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationEPSDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationEPS struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                            `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationEPSDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationFPXDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationFPXDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationFPXDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationFPX struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                            `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationFPXDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationGiropayDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationGiropayDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationGiropayDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationGiropay struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationGiropayDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationGooglePayDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationGooglePayDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationGooglePayDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationGooglePay struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                  `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationGooglePayDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationGrabpayDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationGrabpayDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationGrabpayDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationGrabpay struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationGrabpayDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationIdealDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationIdealDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationIdealDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationIdeal struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                              `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationIdealDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationJCBDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationJCBDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationJCBDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationJCB struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                            `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationJCBDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationKlarnaDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationKlarnaDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationKlarnaDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationKlarna struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                               `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationKlarnaDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationKonbiniDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationKonbiniDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationKonbiniDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationKonbini struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationKonbiniDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationLinkDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationLinkDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationLinkDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationLink struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                             `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationLinkDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationOXXODisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationOXXODisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationOXXODisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationOXXO struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                             `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationOXXODisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationP24DisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationP24DisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationP24DisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationP24 struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                            `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationP24DisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationPayNowDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationPayNowDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationPayNowDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationPayNow struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                               `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationPayNowDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationPromptPayDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationPromptPayDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationPromptPayDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationPromptPay struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                  `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationPromptPayDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationSepaDebitDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationSepaDebitDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationSepaDebitDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationSepaDebit struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                  `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationSepaDebitDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationSofortDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationSofortDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationSofortDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationSofort struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                               `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationSofortDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationUSBankAccountDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationUSBankAccountDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationUSBankAccountDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationUSBankAccount struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                      `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationUSBankAccountDisplayPreference `json:"display_preference"`
+}
+
+type PaymentMethodConfigurationWechatPayDisplayPreference struct {
+	// For child configs, whether or not the account's preference will be observed. If `false`, the parent configuration's default is used.
+	Overridable bool `json:"overridable"`
+	// The account's display preference.
+	Preference PaymentMethodConfigurationWechatPayDisplayPreferencePreference `json:"preference"`
+	// The effective display preference value.
+	Value PaymentMethodConfigurationWechatPayDisplayPreferenceValue `json:"value"`
+}
+type PaymentMethodConfigurationWechatPay struct {
+	// Whether this payment method may be offered at checkout. True if `display_preference` is `on` and the payment method's capability is active.
+	Available         bool                                                  `json:"available"`
+	DisplayPreference *PaymentMethodConfigurationWechatPayDisplayPreference `json:"display_preference"`
+}
+
+// PaymentMethodConfigurations control which payment methods are displayed to your customers when you don't explicitly specify payment method types. You can have multiple configurations with different sets of payment methods for different scenarios.
+//
+// There are two types of PaymentMethodConfigurations. Which is used depends on the [charge type](https://stripe.com/docs/connect/charges):
+//
+// **Direct** configurations apply to payments created on your account, including Connect destination charges, Connect separate charges and transfers, and payments not involving Connect.
+//
+// **Child** configurations apply to payments created on your connected accounts using direct charges, and charges with the on_behalf_of parameter.
+//
+// Child configurations have a `parent` that sets default values and controls which settings connected accounts may override. You can specify a parent ID at payment time, and Stripe will automatically resolve the connected account's associated child configuration. Parent configurations are [managed in the dashboard](https://dashboard.stripe.com/settings/payment_methods/connected_accounts) and are not available in this API.
+//
+// Related guides:
+// - [Payment Method Configurations API](https://stripe.com/docs/connect/payment-method-configurations)
+// - [Multiple configurations on dynamic payment methods](https://stripe.com/docs/payments/multiple-payment-method-configs)
+// - [Multiple configurations for your Connect accounts](https://stripe.com/docs/connect/multiple-payment-method-configurations)
+type PaymentMethodConfiguration struct {
+	APIResource
+	ACSSDebit *PaymentMethodConfigurationACSSDebit `json:"acss_debit"`
+	// Whether the configuration can be used for new payments.
+	Active           bool                                        `json:"active"`
+	Affirm           *PaymentMethodConfigurationAffirm           `json:"affirm"`
+	AfterpayClearpay *PaymentMethodConfigurationAfterpayClearpay `json:"afterpay_clearpay"`
+	Alipay           *PaymentMethodConfigurationAlipay           `json:"alipay"`
+	ApplePay         *PaymentMethodConfigurationApplePay         `json:"apple_pay"`
+	// For child configs, the Connect application associated with the configuration.
+	Application     string                                     `json:"application"`
+	AUBECSDebit     *PaymentMethodConfigurationAUBECSDebit     `json:"au_becs_debit"`
+	BACSDebit       *PaymentMethodConfigurationBACSDebit       `json:"bacs_debit"`
+	Bancontact      *PaymentMethodConfigurationBancontact      `json:"bancontact"`
+	BLIK            *PaymentMethodConfigurationBLIK            `json:"blik"`
+	Boleto          *PaymentMethodConfigurationBoleto          `json:"boleto"`
+	Card            *PaymentMethodConfigurationCard            `json:"card"`
+	CartesBancaires *PaymentMethodConfigurationCartesBancaires `json:"cartes_bancaires"`
+	Cashapp         *PaymentMethodConfigurationCashapp         `json:"cashapp"`
+	EPS             *PaymentMethodConfigurationEPS             `json:"eps"`
+	FPX             *PaymentMethodConfigurationFPX             `json:"fpx"`
+	Giropay         *PaymentMethodConfigurationGiropay         `json:"giropay"`
+	GooglePay       *PaymentMethodConfigurationGooglePay       `json:"google_pay"`
+	Grabpay         *PaymentMethodConfigurationGrabpay         `json:"grabpay"`
+	// Unique identifier for the object.
+	ID    string                           `json:"id"`
+	Ideal *PaymentMethodConfigurationIdeal `json:"ideal"`
+	// The default configuration is used whenever a payment method configuration is not specified.
+	IsDefault bool                               `json:"is_default"`
+	JCB       *PaymentMethodConfigurationJCB     `json:"jcb"`
+	Klarna    *PaymentMethodConfigurationKlarna  `json:"klarna"`
+	Konbini   *PaymentMethodConfigurationKonbini `json:"konbini"`
+	Link      *PaymentMethodConfigurationLink    `json:"link"`
+	// Has the value `true` if the object exists in live mode or the value `false` if the object exists in test mode.
+	Livemode bool `json:"livemode"`
+	// The configuration's name.
+	Name string `json:"name"`
+	// String representing the object's type. Objects of the same type share the same value.
+	Object string                          `json:"object"`
+	OXXO   *PaymentMethodConfigurationOXXO `json:"oxxo"`
+	P24    *PaymentMethodConfigurationP24  `json:"p24"`
+	// For child configs, the configuration's parent configuration.
+	Parent        string                                   `json:"parent"`
+	PayNow        *PaymentMethodConfigurationPayNow        `json:"paynow"`
+	PromptPay     *PaymentMethodConfigurationPromptPay     `json:"promptpay"`
+	SepaDebit     *PaymentMethodConfigurationSepaDebit     `json:"sepa_debit"`
+	Sofort        *PaymentMethodConfigurationSofort        `json:"sofort"`
+	USBankAccount *PaymentMethodConfigurationUSBankAccount `json:"us_bank_account"`
+	WechatPay     *PaymentMethodConfigurationWechatPay     `json:"wechat_pay"`
+}
+
+// PaymentMethodConfigurationList is a list of PaymentMethodConfigurations as retrieved from a list endpoint.
+type PaymentMethodConfigurationList struct {
+	APIResource
+	ListMeta
+	Data []*PaymentMethodConfiguration `json:"data"`
+}
@@ -0,0 +1,44 @@
+package paymentmethodconfiguration
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestPaymentMethodConfigurationUpdate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/payment_method_configurations/pmc_123", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "off", r.PostForm.Get("card[display_preference][preference]"))
+		w.Write([]byte(`{
+			"id": "pmc_123",
+			"object": "payment_method_configuration",
+			"active": true,
+			"card": {
+				"available": false,
+				"display_preference": {"overridable": true, "preference": "off", "value": "off"}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	pmc, err := c.Update("pmc_123", &stripe.PaymentMethodConfigurationParams{
+		Card: &stripe.PaymentMethodConfigurationCardParams{
+			DisplayPreference: &stripe.PaymentMethodConfigurationCardDisplayPreferenceParams{
+				Preference: stripe.String("off"),
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.True(t, pmc.Active)
+	assert.False(t, pmc.Card.Available)
+	assert.Equal(t, stripe.PaymentMethodConfigurationCardDisplayPreferenceValueOff, pmc.Card.DisplayPreference.Value)
+}
@@ -0,0 +1,37 @@
+package paymentmethoddomain
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestPaymentMethodDomainValidate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/payment_method_domains/pmd_123/validate", r.URL.Path)
+		w.Write([]byte(`{
+			"id": "pmd_123",
+			"object": "payment_method_domain",
+			"domain_name": "example.com",
+			"enabled": true,
+			"apple_pay": {
+				"status": "inactive",
+				"status_details": {"error_message": "Domain not verified"}
+			}
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	domain, err := c.Validate("pmd_123", &stripe.PaymentMethodDomainValidateParams{})
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com", domain.DomainName)
+	assert.Equal(t, stripe.PaymentMethodDomainApplePayStatusInactive, domain.ApplePay.Status)
+	assert.Equal(t, "Domain not verified", domain.ApplePay.StatusDetails.ErrorMessage)
+}
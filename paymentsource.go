@@ -146,6 +146,30 @@ type SourceList struct {
 	Data []*PaymentSource `json:"data"`
 }
 
+// TypedValue returns the payment instrument that the PaymentSource refers to,
+// which is a *Card, *BankAccount or *Source depending on its Type, so that it
+// can be handled with a type switch:
+//
+//	switch v := source.TypedValue().(type) {
+//	case *stripe.Card:
+//		...
+//	case *stripe.BankAccount:
+//		...
+//	}
+//
+// It returns nil for other types, and for sources that weren't expanded.
+func (s *PaymentSource) TypedValue() interface{} {
+	switch {
+	case s.Type == PaymentSourceTypeBankAccount && s.BankAccount != nil:
+		return s.BankAccount
+	case s.Type == PaymentSourceTypeCard && s.Card != nil:
+		return s.Card
+	case s.Type == PaymentSourceTypeObject && s.SourceObject != nil:
+		return s.SourceObject
+	}
+	return nil
+}
+
 // UnmarshalJSON handles deserialization of a PaymentSource.
 // This custom unmarshaling is needed because the specific
 // type of payment instrument it refers to is specified in the JSON
@@ -107,3 +107,24 @@ func TestPaymentSource_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, "ba_123", v.BankAccount.ID)
 	}
 }
+
+func TestPaymentSource_TypedValue(t *testing.T) {
+	var v PaymentSource
+	err := json.Unmarshal([]byte(`{"id":"card_123", "object":"card"}`), &v)
+	assert.NoError(t, err)
+	card, ok := v.TypedValue().(*Card)
+	assert.True(t, ok)
+	assert.Equal(t, "card_123", card.ID)
+
+	err = json.Unmarshal([]byte(`{"id":"src_123", "object":"source"}`), &v)
+	assert.NoError(t, err)
+	source, ok := v.TypedValue().(*Source)
+	assert.True(t, ok)
+	assert.Equal(t, "src_123", source.ID)
+
+	// A source that wasn't expanded has no value
+	v = PaymentSource{}
+	err = json.Unmarshal([]byte(`"ba_123"`), &v)
+	assert.NoError(t, err)
+	assert.Nil(t, v.TypedValue())
+}
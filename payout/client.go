@@ -124,5 +124,5 @@ func (i *Iter) PayoutList() *stripe.PayoutList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,68 @@
+package payout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+// waitInitialInterval and waitMaxInterval bound the time WaitPaid waits
+// between checks of a payout. They're variables so that tests can shorten
+// them.
+var (
+	waitInitialInterval = 10 * time.Second
+	waitMaxInterval     = 5 * time.Minute
+)
+
+// WaitPaid polls a payout until it has been paid and returns it. An error is
+// returned along with the payout if it fails or is canceled instead.
+//
+// Payouts can take several days to arrive, so the interval between checks
+// starts at ten seconds and doubles up to five minutes. Polling stops when ctx
+// is done, in which case the most recently fetched payout is returned along
+// with the context's error.
+func WaitPaid(ctx context.Context, id string) (*stripe.Payout, error) {
+	return getC().WaitPaid(ctx, id)
+}
+
+// WaitPaid polls a payout until it has been paid and returns it. An error is
+// returned along with the payout if it fails or is canceled instead.
+//
+// Payouts can take several days to arrive, so the interval between checks
+// starts at ten seconds and doubles up to five minutes. Polling stops when ctx
+// is done, in which case the most recently fetched payout is returned along
+// with the context's error.
+func (c Client) WaitPaid(ctx context.Context, id string) (*stripe.Payout, error) {
+	v, err := stripe.Poll(ctx,
+		func(ctx context.Context) (interface{}, error) {
+			params := &stripe.PayoutParams{}
+			params.Context = ctx
+			return c.Get(id, params)
+		},
+		func(v interface{}) bool {
+			switch v.(*stripe.Payout).Status {
+			case stripe.PayoutStatusCanceled, stripe.PayoutStatusFailed, stripe.PayoutStatusPaid:
+				return true
+			}
+			return false
+		},
+		&stripe.PollOptions{
+			InitialInterval: waitInitialInterval,
+			MaxInterval:     waitMaxInterval,
+		},
+	)
+	payout, _ := v.(*stripe.Payout)
+	if err != nil {
+		return payout, err
+	}
+
+	switch payout.Status {
+	case stripe.PayoutStatusFailed:
+		return payout, fmt.Errorf("payout %s failed: %s", payout.ID, payout.FailureMessage)
+	case stripe.PayoutStatusCanceled:
+		return payout, fmt.Errorf("payout %s was canceled", payout.ID)
+	}
+	return payout, nil
+}
@@ -0,0 +1,51 @@
+package payout
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestPayoutWaitPaid(t *testing.T) {
+	defer func(d time.Duration) { waitInitialInterval = d }(waitInitialInterval)
+	waitInitialInterval = time.Millisecond
+
+	gets := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/payouts/po_123", r.URL.Path)
+		gets++
+		switch gets {
+		case 1:
+			w.Write([]byte(`{"id":"po_123","status":"pending"}`))
+		case 2:
+			w.Write([]byte(`{"id":"po_123","status":"in_transit"}`))
+		default:
+			w.Write([]byte(`{"id":"po_123","status":"paid"}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	payout, err := c.WaitPaid(context.Background(), "po_123")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, gets)
+	assert.Equal(t, stripe.PayoutStatusPaid, payout.Status)
+}
+
+func TestPayoutWaitPaid_Failed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"po_123","status":"failed","failure_code":"account_closed","failure_message":"The bank account has been closed."}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	payout, err := c.WaitPaid(context.Background(), "po_123")
+	assert.EqualError(t, err, "payout po_123 failed: The bank account has been closed.")
+	assert.Equal(t, stripe.PayoutFailureCodeAccountClosed, payout.FailureCode)
+}
@@ -119,14 +119,15 @@ type PlanTierParams struct {
 	UpToInf *bool  `form:"-"` // See custom AppendTo
 }
 
-// AppendTo implements custom encoding logic for PlanTierParams.
+// AppendTo implements custom encoding logic for PlanTierParams. UpToInf takes
+// precedence over UpTo, and up_to is left out if neither is set.
 func (p *PlanTierParams) AppendTo(body *form.Values, keyParts []string) {
 	if BoolValue(p.UpToInf) {
 		body.Add(form.FormatKey(append(keyParts, "up_to")), "inf")
-	} else {
+	} else if p.UpTo != nil {
 		body.Add(
 			form.FormatKey(append(keyParts, "up_to")),
-			strconv.FormatInt(Int64Value(p.UpTo), 10),
+			strconv.FormatInt(*p.UpTo, 10),
 		)
 	}
 }
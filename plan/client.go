@@ -42,6 +42,9 @@ func (c Client) Get(id string, params *stripe.PlanParams) (*stripe.Plan, error)
 	path := stripe.FormatURLPath("/v1/plans/%s", id)
 	plan := &stripe.Plan{}
 	err := c.B.Call(http.MethodGet, path, c.Key, params, plan)
+	if err == nil && plan.Deleted {
+		err = &stripe.DeletedObjectError{ID: plan.ID, Object: "plan"}
+	}
 	return plan, err
 }
 
@@ -111,5 +114,5 @@ func (i *Iter) PlanList() *stripe.PlanList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -27,6 +27,41 @@ func TestPlan_Unmarshal(t *testing.T) {
 	assert.Equal(t, 0.0123456789, plan.AmountDecimal)
 }
 
+func TestPlan_UnmarshalTiers(t *testing.T) {
+	planData := map[string]interface{}{
+		"id":              "pl_123",
+		"object":          "plan",
+		"aggregate_usage": "sum",
+		"billing_scheme":  "tiered",
+		"tiers": []interface{}{
+			map[string]interface{}{"flat_amount": 500, "unit_amount": nil, "up_to": 10},
+			map[string]interface{}{"flat_amount": nil, "unit_amount": 100, "up_to": nil},
+		},
+		"tiers_mode":      "graduated",
+		"transform_usage": map[string]interface{}{"divide_by": 1000, "round": "up"},
+		"usage_type":      "metered",
+	}
+
+	bytes, err := json.Marshal(&planData)
+	assert.NoError(t, err)
+
+	var plan Plan
+	err = json.Unmarshal(bytes, &plan)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "sum", plan.AggregateUsage)
+	assert.Equal(t, PlanBillingSchemeTiered, plan.BillingScheme)
+	assert.Equal(t, 2, len(plan.Tiers))
+	assert.Equal(t, int64(500), plan.Tiers[0].FlatAmount)
+	assert.Equal(t, int64(10), plan.Tiers[0].UpTo)
+	assert.Equal(t, int64(100), plan.Tiers[1].UnitAmount)
+	assert.Equal(t, int64(0), plan.Tiers[1].UpTo)
+	assert.Equal(t, string(PlanTiersModeGraduated), plan.TiersMode)
+	assert.Equal(t, int64(1000), plan.TransformUsage.DivideBy)
+	assert.Equal(t, PlanTransformUsageRoundUp, plan.TransformUsage.Round)
+	assert.Equal(t, PlanUsageTypeMetered, plan.UsageType)
+}
+
 func TestPlanListParams_AppendTo(t *testing.T) {
 	testCases := []struct {
 		field  string
@@ -65,12 +100,14 @@ func TestPlanParams_AppendTo(t *testing.T) {
 	}
 	tiers := []*PlanTierParams{
 		{UnitAmount: Int64(123), UpTo: Int64(321)},
-		{UnitAmount: Int64(123), UpToInf: Bool(true)}}
+		{UnitAmount: Int64(123), UpToInf: Bool(true)},
+		{FlatAmount: Int64(500)}}
 	testCases := []struct {
 		field  string
 		params *PlanParams
 		want   interface{}
 	}{
+		{"aggregate_usage", &PlanParams{AggregateUsage: String(string(PlanAggregateUsageMax))}, "max"},
 		{"amount", &PlanParams{Amount: Int64(123)}, strconv.FormatUint(123, 10)},
 		{"currency", &PlanParams{Currency: String(string(CurrencyUSD))}, "usd"},
 		{"id", &PlanParams{ID: String("sapphire-elite")}, "sapphire-elite"},
@@ -85,6 +122,8 @@ func TestPlanParams_AppendTo(t *testing.T) {
 		{"tiers[0][up_to]", &PlanParams{Tiers: tiers}, strconv.FormatUint(321, 10)},
 		{"tiers[1][unit_amount]", &PlanParams{Tiers: tiers}, strconv.FormatUint(123, 10)},
 		{"tiers[1][up_to]", &PlanParams{Tiers: tiers}, "inf"},
+		{"tiers[2][flat_amount]", &PlanParams{Tiers: tiers}, strconv.FormatUint(500, 10)},
+		{"tiers[2][up_to]", &PlanParams{Tiers: tiers}, ""},
 		{"transform_usage[divide_by]", &PlanParams{TransformUsage: &PlanTransformUsageParams{DivideBy: Int64(123), Round: String("round_up")}}, strconv.FormatUint(123, 10)},
 		{"transform_usage[round]", &PlanParams{TransformUsage: &PlanTransformUsageParams{DivideBy: Int64(123), Round: String("round_up")}}, "round_up"},
 		{"trial_period_days", &PlanParams{TrialPeriodDays: Int64(123)}, strconv.FormatUint(123, 10)},
@@ -100,6 +139,15 @@ func TestPlanParams_AppendTo(t *testing.T) {
 	}
 }
 
+func TestPlanTierParams_AppendTo_UpToInf(t *testing.T) {
+	// UpToInf wins over UpTo, so that up_to is only sent once
+	body := &form.Values{}
+	form.AppendTo(body, &PlanParams{Tiers: []*PlanTierParams{
+		{UnitAmount: Int64(123), UpTo: Int64(321), UpToInf: Bool(true)},
+	}})
+	assert.Equal(t, []string{"inf"}, body.ToValues()["tiers[0][up_to]"])
+}
+
 func TestPlanParams_AppendTo_Empty(t *testing.T) {
 	body := &form.Values{}
 	params := &PlanParams{}
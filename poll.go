@@ -0,0 +1,119 @@
+package stripe
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+//
+// Public constants
+//
+
+const (
+	// DefaultPollInitialInterval is the time Poll waits after the first
+	// attempt if PollOptions doesn't specify one.
+	DefaultPollInitialInterval = 1 * time.Second
+
+	// DefaultPollMaxInterval is the longest time Poll waits between attempts
+	// if PollOptions doesn't specify one.
+	DefaultPollMaxInterval = 30 * time.Second
+)
+
+//
+// Public types
+//
+
+// PollOptions configures the interval between attempts made by Poll and for
+// how long it keeps trying.
+type PollOptions struct {
+	// InitialInterval is the time waited after the first attempt. Defaults to
+	// DefaultPollInitialInterval.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the time waited between attempts. Defaults to
+	// DefaultPollMaxInterval.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor by which the interval grows after every
+	// attempt. Defaults to 2. Use 1 to poll at a fixed interval.
+	Multiplier float64
+
+	// Timeout bounds the total time spent polling. If zero, Poll only stops
+	// when its context is done.
+	Timeout time.Duration
+}
+
+//
+// Public functions
+//
+
+// Poll calls fetch until done reports that the value it returned is final, and
+// then returns that value. It's useful for resources that change state
+// asynchronously, like report runs, identity verification sessions or
+// terminal reader actions:
+//
+//	v, err := stripe.Poll(ctx,
+//		func(ctx context.Context) (interface{}, error) {
+//			params := &stripe.ReportRunParams{}
+//			params.Context = ctx
+//			return reportrun.Get(id, params)
+//		},
+//		func(v interface{}) bool {
+//			return v.(*stripe.ReportRun).Status != stripe.ReportRunStatusPending
+//		},
+//		nil,
+//	)
+//
+// The interval between attempts grows exponentially according to opts, with
+// each wait randomly shortened by up to a quarter so that concurrent pollers
+// don't make requests in lockstep. An error returned by fetch stops polling
+// and is returned as is. If ctx is done or the timeout elapses first, the most
+// recently fetched value is returned along with the context's error.
+func Poll(ctx context.Context, fetch func(ctx context.Context) (interface{}, error), done func(v interface{}) bool, opts *PollOptions) (interface{}, error) {
+	if opts == nil {
+		opts = &PollOptions{}
+	}
+
+	interval := opts.InitialInterval
+	if interval <= 0 {
+		interval = DefaultPollInitialInterval
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultPollMaxInterval
+	}
+	multiplier := opts.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	for {
+		v, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if done(v) {
+			return v, nil
+		}
+
+		timer := time.NewTimer(interval - time.Duration(rand.Int63n(int64(interval/4)+1)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return v, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * multiplier)
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
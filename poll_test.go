@@ -0,0 +1,83 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestPoll(t *testing.T) {
+	attempts := 0
+	v, err := Poll(context.Background(),
+		func(ctx context.Context) (interface{}, error) {
+			attempts++
+			return &ReportRun{ID: "frr_123", Status: reportRunStatusForAttempt(attempts)}, nil
+		},
+		func(v interface{}) bool {
+			return v.(*ReportRun).Status != ReportRunStatusPending
+		},
+		&PollOptions{InitialInterval: time.Millisecond},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, ReportRunStatusSucceeded, v.(*ReportRun).Status)
+}
+
+func TestPoll_FetchError(t *testing.T) {
+	attempts := 0
+	v, err := Poll(context.Background(),
+		func(ctx context.Context) (interface{}, error) {
+			attempts++
+			return nil, errors.New("boom")
+		},
+		func(v interface{}) bool { return true },
+		nil,
+	)
+	assert.EqualError(t, err, "boom")
+	assert.Nil(t, v)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestPoll_Timeout(t *testing.T) {
+	start := time.Now()
+	v, err := Poll(context.Background(),
+		func(ctx context.Context) (interface{}, error) {
+			return &ReportRun{ID: "frr_123", Status: ReportRunStatusPending}, nil
+		},
+		func(v interface{}) bool { return false },
+		&PollOptions{
+			InitialInterval: time.Millisecond,
+			MaxInterval:     2 * time.Millisecond,
+			Timeout:         20 * time.Millisecond,
+		},
+	)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, ReportRunStatusPending, v.(*ReportRun).Status)
+	assert.True(t, time.Since(start) < time.Second)
+}
+
+func TestPoll_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	_, err := Poll(ctx,
+		func(ctx context.Context) (interface{}, error) {
+			attempts++
+			cancel()
+			return attempts, nil
+		},
+		func(v interface{}) bool { return false },
+		&PollOptions{InitialInterval: time.Hour},
+	)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func reportRunStatusForAttempt(attempt int) ReportRunStatus {
+	if attempt < 3 {
+		return ReportRunStatusPending
+	}
+	return ReportRunStatusSucceeded
+}
@@ -137,5 +137,5 @@ func (i *SearchIter) PriceSearchResult() *stripe.PriceSearchResult {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -66,3 +66,27 @@ func TestPriceTierParams_AppendTo(t *testing.T) {
 	t.Logf("body = %+v", body)
 	assert.Equal(t, []string{"inf"}, body.Get("up_to"))
 }
+
+func TestPriceParams_AppendTo_CurrencyOptions(t *testing.T) {
+	params := &PriceParams{
+		BillingScheme: String(string(PriceBillingSchemeTiered)),
+		Currency:      String(string(CurrencyUSD)),
+		CurrencyOptions: map[string]*PriceCurrencyOptionsParams{
+			"eur": {
+				Tiers: []*PriceCurrencyOptionsTierParams{
+					{UnitAmount: Int64(90), UpTo: Int64(10)},
+					{UnitAmount: Int64(80), UpToInf: Bool(true)},
+				},
+			},
+		},
+		TiersMode: String(string(PriceTiersModeVolume)),
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"90"}, body.Get("currency_options[eur][tiers][0][unit_amount]"))
+	assert.Equal(t, []string{"10"}, body.Get("currency_options[eur][tiers][0][up_to]"))
+	assert.Equal(t, []string{"inf"}, body.Get("currency_options[eur][tiers][1][up_to]"))
+	assert.Equal(t, []string{"volume"}, body.Get("tiers_mode"))
+}
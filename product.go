@@ -39,6 +39,8 @@ type ProductDefaultPriceDataRecurringParams struct {
 type ProductDefaultPriceDataParams struct {
 	// Three-letter [ISO currency code](https://www.iso.org/iso-4217-currency-codes.html), in lowercase. Must be a [supported currency](https://stripe.com/docs/currencies).
 	Currency *string `form:"currency"`
+	// Prices defined in each available currency option. Each key must be a three-letter [ISO currency code](https://www.iso.org/iso-4217-currency-codes.html) and a [supported currency](https://stripe.com/docs/currencies).
+	CurrencyOptions map[string]*PriceCurrencyOptionsParams `form:"currency_options"`
 	// The recurring components of a price such as `interval` and `interval_count`.
 	Recurring *ProductDefaultPriceDataRecurringParams `form:"recurring"`
 	// Specifies whether the price is considered inclusive of taxes or exclusive of taxes. One of `inclusive`, `exclusive`, or `unspecified`. Once specified as either `inclusive` or `exclusive`, it cannot be changed.
@@ -49,6 +51,12 @@ type ProductDefaultPriceDataParams struct {
 	UnitAmountDecimal *float64 `form:"unit_amount_decimal,high_precision"`
 }
 
+// A list of up to 15 marketing features for this product. These are displayed in [pricing tables](https://stripe.com/docs/payments/checkout/pricing-table).
+type ProductMarketingFeatureParams struct {
+	// The marketing feature name. Up to 80 characters long.
+	Name *string `form:"name"`
+}
+
 // The dimensions of this product for shipping purposes.
 type PackageDimensionsParams struct {
 	// Height, in inches. Maximum precision is 2 decimal places.
@@ -82,6 +90,8 @@ type ProductParams struct {
 	ID *string `form:"id"`
 	// A list of up to 8 URLs of images for this product, meant to be displayable to the customer.
 	Images []*string `form:"images"`
+	// A list of up to 15 marketing features for this product. These are displayed in [pricing tables](https://stripe.com/docs/payments/checkout/pricing-table).
+	MarketingFeatures []*ProductMarketingFeatureParams `form:"marketing_features"`
 	// The product's name, meant to be displayable to the customer.
 	Name *string `form:"name"`
 	// The dimensions of this product for shipping purposes.
@@ -122,6 +132,12 @@ type ProductListParams struct {
 	URL *string `form:"url"`
 }
 
+// A list of up to 15 marketing features for this product. These are displayed in [pricing tables](https://stripe.com/docs/payments/checkout/pricing-table).
+type ProductMarketingFeature struct {
+	// The marketing feature name. Up to 80 characters long.
+	Name string `json:"name"`
+}
+
 // The dimensions of this product for shipping purposes.
 type PackageDimensions struct {
 	// Height, in inches.
@@ -165,6 +181,8 @@ type Product struct {
 	Images []string `json:"images"`
 	// Has the value `true` if the object exists in live mode or the value `false` if the object exists in test mode.
 	Livemode bool `json:"livemode"`
+	// A list of up to 15 marketing features for this product. These are displayed in [pricing tables](https://stripe.com/docs/payments/checkout/pricing-table).
+	MarketingFeatures []*ProductMarketingFeature `json:"marketing_features"`
 	// Set of [key-value pairs](https://stripe.com/docs/api/metadata) that you can attach to an object. This can be useful for storing additional information about the object in a structured format.
 	Metadata map[string]string `json:"metadata"`
 	// The product's name, meant to be displayable to the customer.
@@ -42,6 +42,9 @@ func (c Client) Get(id string, params *stripe.ProductParams) (*stripe.Product, e
 	path := stripe.FormatURLPath("/v1/products/%s", id)
 	product := &stripe.Product{}
 	err := c.B.Call(http.MethodGet, path, c.Key, params, product)
+	if err == nil && product.Deleted {
+		err = &stripe.DeletedObjectError{ID: product.ID, Object: "product"}
+	}
 	return product, err
 }
 
@@ -150,5 +153,5 @@ func (i *SearchIter) ProductSearchResult() *stripe.ProductSearchResult {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
 )
 
 func TestProduct_UnmarshalJSON(t *testing.T) {
@@ -27,3 +28,42 @@ func TestProduct_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, "prod_123", v.ID)
 	}
 }
+
+func TestProductParams_AppendTo(t *testing.T) {
+	params := &ProductParams{
+		DefaultPriceData: &ProductDefaultPriceDataParams{
+			Currency: String(string(CurrencyUSD)),
+			CurrencyOptions: map[string]*PriceCurrencyOptionsParams{
+				"eur": {UnitAmount: Int64(900)},
+			},
+			UnitAmount: Int64(1000),
+		},
+		MarketingFeatures: []*ProductMarketingFeatureParams{
+			{Name: String("Organic cotton")},
+			{Name: String("Free shipping")},
+		},
+		Name: String("T-shirt"),
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	t.Logf("body = %+v", body)
+	assert.Equal(t, []string{"1000"}, body.Get("default_price_data[unit_amount]"))
+	assert.Equal(t, []string{"900"}, body.Get("default_price_data[currency_options][eur][unit_amount]"))
+	assert.Equal(t, []string{"Organic cotton"}, body.Get("marketing_features[0][name]"))
+	assert.Equal(t, []string{"Free shipping"}, body.Get("marketing_features[1][name]"))
+}
+
+func TestProduct_UnmarshalJSON_MarketingFeatures(t *testing.T) {
+	data := []byte(`{
+		"id": "prod_123",
+		"default_price": "price_123",
+		"marketing_features": [{"name": "Organic cotton"}]
+	}`)
+
+	var v Product
+	err := json.Unmarshal(data, &v)
+	assert.NoError(t, err)
+	assert.Equal(t, "price_123", v.DefaultPrice.ID)
+	assert.Equal(t, "Organic cotton", v.MarketingFeatures[0].Name)
+}
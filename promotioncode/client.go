@@ -104,5 +104,5 @@ func (i *Iter) PromotionCodeList() *stripe.PromotionCodeList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
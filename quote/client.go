@@ -8,6 +8,7 @@
 package quote
 
 import (
+	"io"
 	"net/http"
 
 	stripe "github.com/stripe/stripe-go/v72"
@@ -111,6 +112,18 @@ func (c Client) PDF(id string, params *stripe.QuotePDFParams) (*stripe.APIStream
 	return stream, err
 }
 
+// DownloadPDF streams the PDF for a finalized quote into w.
+func DownloadPDF(id string, w io.Writer, params *stripe.QuotePDFParams) error {
+	return getC().DownloadPDF(id, w, params)
+}
+
+// DownloadPDF streams the PDF for a finalized quote into w.
+func (c Client) DownloadPDF(id string, w io.Writer, params *stripe.QuotePDFParams) error {
+	path := stripe.FormatURLPath("/v1/quotes/%s/pdf", id)
+	_, err := stripe.CallStreamingTo(c.PDFBackend, http.MethodGet, path, c.Key, params, w, nil)
+	return err
+}
+
 // List returns a list of quotes.
 func List(params *stripe.QuoteListParams) *Iter {
 	return getC().List(params)
@@ -220,5 +233,5 @@ func (i *LineItemIter) LineItemList() *stripe.LineItemList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetBackend(stripe.UploadsBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetBackend(stripe.UploadsBackend), stripe.GetKey()}
 }
@@ -1,6 +1,7 @@
 package quote
 
 import (
+	"bytes"
 	"io/ioutil"
 	"testing"
 
@@ -68,6 +69,13 @@ func TestQuotePDF(t *testing.T) {
 	assert.Equal(t, []byte("Stripe binary response"), body)
 }
 
+func TestQuoteDownloadPDF(t *testing.T) {
+	var buf bytes.Buffer
+	err := DownloadPDF("qt_123", &buf, &stripe.QuotePDFParams{})
+	assert.Nil(t, err)
+	assert.Equal(t, "Stripe binary response", buf.String())
+}
+
 func TestQuoteUpdate(t *testing.T) {
 	quote, err := Update("qt_123", &stripe.QuoteParams{
 		Params: stripe.Params{
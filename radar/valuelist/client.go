@@ -117,5 +117,5 @@ func (i *Iter) RadarValueListList() *stripe.RadarValueListList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -105,5 +105,5 @@ func (i *Iter) RadarValueListItemList() *stripe.RadarValueListItemList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
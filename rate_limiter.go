@@ -0,0 +1,130 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//
+// Public types
+//
+
+// RateLimiter throttles the requests of the backends it's configured on
+// with BackendConfig.RateLimiter, to help bulk jobs stay under Stripe's rate
+// limits instead of having many of their requests rejected. It caps the
+// number of requests in flight at once, and after a request is rejected with
+// a 429 status code, it holds back new requests until the time given by the
+// response's `Retry-After` header has passed.
+//
+// A RateLimiter is safe for concurrent use, and can be shared by several
+// backends to throttle them together. Its fields must not be changed once
+// it's in use.
+type RateLimiter struct {
+	// MaxConcurrency caps the number of requests in flight at once. Requests
+	// over the cap wait for one of the requests in flight to complete.
+	//
+	// Defaults to 0, which doesn't cap the number of requests.
+	MaxConcurrency int
+
+	// Pause is how long new requests are held back after a request is
+	// rejected with a 429 status code whose response has no `Retry-After`
+	// header.
+	//
+	// Defaults to 1 second.
+	Pause time.Duration
+
+	initOnce    sync.Once
+	mu          sync.Mutex
+	pausedUntil time.Time
+	slots       chan struct{}
+}
+
+//
+// Private constants
+//
+
+// defaultRateLimiterPause is the default value of RateLimiter.Pause.
+const defaultRateLimiterPause = 1 * time.Second
+
+//
+// Private functions
+//
+
+// acquire waits until a request can be sent, or until ctx is done. The
+// returned function must be called when the request has completed.
+func (l *RateLimiter) acquire(ctx context.Context) (func(), error) {
+	l.initOnce.Do(func() {
+		if l.MaxConcurrency > 0 {
+			l.slots = make(chan struct{}, l.MaxConcurrency)
+		}
+	})
+
+	// A pause may start or be extended while waiting, so check again once
+	// it's over
+	for {
+		l.mu.Lock()
+		wait := time.Until(l.pausedUntil)
+		l.mu.Unlock()
+		if wait <= 0 {
+			break
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+
+	if l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// observe pauses new requests if resp shows that a request was rate limited.
+func (l *RateLimiter) observe(resp *http.Response) {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	pause := retryAfter(resp, time.Now())
+	if pause <= 0 {
+		pause = l.Pause
+	}
+	if pause <= 0 {
+		pause = defaultRateLimiterPause
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(pause); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// retryAfter returns the delay given by the `Retry-After` header of resp,
+// which is either a number of seconds or an HTTP date, or 0 if there's none.
+func retryAfter(resp *http.Response, now time.Time) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return date.Sub(now)
+	}
+	return 0
+}
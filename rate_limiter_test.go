@@ -0,0 +1,104 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_MaxConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.Write([]byte(`{}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(APIBackend, &BackendConfig{
+		LeveledLogger: nullLeveledLogger,
+		RateLimiter:   &RateLimiter{MaxConcurrency: 2},
+		URL:           String(testServer.URL),
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := backend.Call(http.MethodGet, "/v1/charges", "sk_test_123", nil, &Charge{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, maxInFlight)
+}
+
+func TestRateLimiter_Pause(t *testing.T) {
+	var requests []time.Time
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, time.Now())
+		if len(requests) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error":{"message":"slow down","type":"invalid_request_error","code":"rate_limit"}}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer testServer.Close()
+
+	limiter := &RateLimiter{Pause: 50 * time.Millisecond}
+	backend := GetBackendWithConfig(APIBackend, &BackendConfig{
+		LeveledLogger: nullLeveledLogger,
+		RateLimiter:   limiter,
+		URL:           String(testServer.URL),
+	})
+
+	err := backend.Call(http.MethodGet, "/v1/charges", "sk_test_123", nil, &Charge{})
+	assert.True(t, IsRateLimitError(err))
+
+	// Requests are held back while paused, unless their context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = backend.Call(http.MethodGet, "/v1/charges", "sk_test_123", &Params{Context: ctx}, &Charge{})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, len(requests))
+
+	err = backend.Call(http.MethodGet, "/v1/charges", "sk_test_123", nil, &Charge{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(requests))
+	assert.True(t, requests[1].Sub(requests[0]) >= limiter.Pause)
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2022, time.March, 1, 12, 0, 0, 0, time.UTC)
+	resp := &http.Response{Header: http.Header{}}
+
+	assert.Equal(t, time.Duration(0), retryAfter(resp, now))
+
+	resp.Header.Set("Retry-After", "3")
+	assert.Equal(t, 3*time.Second, retryAfter(resp, now))
+
+	resp.Header.Set("Retry-After", now.Add(5*time.Second).Format(http.TimeFormat))
+	assert.Equal(t, 5*time.Second, retryAfter(resp, now))
+
+	resp.Header.Set("Retry-After", "soon")
+	assert.Equal(t, time.Duration(0), retryAfter(resp, now))
+}
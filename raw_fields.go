@@ -0,0 +1,249 @@
+package stripe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+//
+// Public types
+//
+
+// RawFields holds the fields of an object's JSON that its type doesn't keep,
+// like fields added to the API after the library was released, keyed by
+// name.
+//
+// Unknown fields of nested objects are held under the field that contains
+// them, as an object with only those fields. For example, an unknown
+// `invoice_settings.rendering` field of a customer is held as
+// {"rendering": ...} under "invoice_settings". Lists are held as arrays with
+// an element for each element of the list, which is null for elements that
+// don't have any unknown fields.
+type RawFields map[string]json.RawMessage
+
+//
+// Public functions
+//
+
+// DecodeWithRawFields decodes data into v, a pointer to a resource like
+// *Customer, and returns the fields of data, including those of nested
+// objects, that v's type doesn't keep. Passing them to EncodeWithRawFields
+// keeps them when the resource is encoded again, so that resources and events
+// can be stored and later decoded, possibly by a newer version of the
+// library, without losing what the API returned.
+func DecodeWithRawFields(data []byte, v interface{}) (RawFields, error) {
+	if err := checkStructPointer(v); err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	// The fields that v keeps are the ones it encodes back to
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var encodedFields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &encodedFields); err != nil {
+		return nil, err
+	}
+
+	var raw RawFields
+	for name, value := range fields {
+		unknown, ok := value, true
+		if encodedValue, known := encodedFields[name]; known {
+			unknown, ok = unknownJSON(value, encodedValue)
+		}
+		if !ok {
+			continue
+		}
+		if raw == nil {
+			raw = make(RawFields)
+		}
+		raw[name] = unknown
+	}
+	return raw, nil
+}
+
+// EncodeWithRawFields encodes v, a pointer to a resource, to JSON, with the
+// fields in raw that v's type doesn't keep added back to it, including
+// nested ones. Fields that v encodes take precedence over those in raw. The
+// fields of the result are sorted by name, so that encoding the same resource
+// always gives the same result.
+//
+// The result decodes to the same resource and raw fields with
+// DecodeWithRawFields, but it isn't byte for byte what the API returned: for
+// example, expandable fields that weren't expanded are encoded as objects
+// with only an ID. Keep APIResponse.RawJSON or EventData.Raw when the exact
+// JSON is needed.
+func EncodeWithRawFields(v interface{}, raw RawFields) ([]byte, error) {
+	if err := checkStructPointer(v); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range raw {
+		if encodedValue, ok := fields[name]; ok {
+			merged, err := mergeJSON(encodedValue, value)
+			if err != nil {
+				return nil, err
+			}
+			fields[name] = merged
+			continue
+		}
+		fields[name] = value
+	}
+	return json.Marshal(fields)
+}
+
+//
+// Private functions
+//
+
+func checkStructPointer(v interface{}) error {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a pointer to a struct, got %T", v)
+	}
+	return nil
+}
+
+// jsonKind returns the first character of a JSON value, which tells objects
+// ('{') and arrays ('[') apart from other values.
+func jsonKind(value json.RawMessage) byte {
+	value = bytes.TrimLeft(value, " \t\r\n")
+	if len(value) == 0 {
+		return 0
+	}
+	return value[0]
+}
+
+// mergeJSON adds the fields of raw, as returned by unknownJSON, that encoded
+// doesn't have to it. Values that aren't objects or arrays in both are kept
+// as encoded.
+func mergeJSON(encoded, raw json.RawMessage) (json.RawMessage, error) {
+	switch {
+	case jsonKind(encoded) == '{' && jsonKind(raw) == '{':
+		var encodedFields, rawFields map[string]json.RawMessage
+		if err := json.Unmarshal(encoded, &encodedFields); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &rawFields); err != nil {
+			return nil, err
+		}
+		for name, value := range rawFields {
+			if encodedValue, ok := encodedFields[name]; ok {
+				merged, err := mergeJSON(encodedValue, value)
+				if err != nil {
+					return nil, err
+				}
+				encodedFields[name] = merged
+				continue
+			}
+			encodedFields[name] = value
+		}
+		return json.Marshal(encodedFields)
+
+	case jsonKind(encoded) == '[' && jsonKind(raw) == '[':
+		var encodedElements, rawElements []json.RawMessage
+		if err := json.Unmarshal(encoded, &encodedElements); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &rawElements); err != nil {
+			return nil, err
+		}
+		// The list changed since it was decoded, so its elements can't be
+		// matched up with the raw ones
+		if len(encodedElements) != len(rawElements) {
+			return encoded, nil
+		}
+		for i, value := range rawElements {
+			if jsonKind(value) == 'n' {
+				continue
+			}
+			merged, err := mergeJSON(encodedElements[i], value)
+			if err != nil {
+				return nil, err
+			}
+			encodedElements[i] = merged
+		}
+		return json.Marshal(encodedElements)
+	}
+
+	return encoded, nil
+}
+
+// unknownJSON returns the parts of value, as decoded, that are missing from
+// encoded, the same value once decoded into a type and encoded back. The
+// second return value is false if nothing is missing.
+func unknownJSON(value, encoded json.RawMessage) (json.RawMessage, bool) {
+	switch {
+	case jsonKind(value) == '{' && jsonKind(encoded) == '{':
+		var fields, encodedFields map[string]json.RawMessage
+		if json.Unmarshal(value, &fields) != nil || json.Unmarshal(encoded, &encodedFields) != nil {
+			return nil, false
+		}
+
+		unknown := make(map[string]json.RawMessage)
+		for name, fieldValue := range fields {
+			encodedValue, ok := encodedFields[name]
+			if !ok {
+				unknown[name] = fieldValue
+				continue
+			}
+			if fieldUnknown, ok := unknownJSON(fieldValue, encodedValue); ok {
+				unknown[name] = fieldUnknown
+			}
+		}
+		if len(unknown) == 0 {
+			return nil, false
+		}
+		data, err := json.Marshal(unknown)
+		return data, err == nil
+
+	case jsonKind(value) == '[' && jsonKind(encoded) == '[':
+		var elements, encodedElements []json.RawMessage
+		if json.Unmarshal(value, &elements) != nil || json.Unmarshal(encoded, &encodedElements) != nil {
+			return nil, false
+		}
+		if len(elements) != len(encodedElements) {
+			return nil, false
+		}
+
+		unknown := make([]json.RawMessage, len(elements))
+		found := false
+		for i := range elements {
+			unknown[i] = json.RawMessage("null")
+			if elementUnknown, ok := unknownJSON(elements[i], encodedElements[i]); ok {
+				unknown[i] = elementUnknown
+				found = true
+			}
+		}
+		if !found {
+			return nil, false
+		}
+		data, err := json.Marshal(unknown)
+		return data, err == nil
+	}
+
+	return nil, false
+}
@@ -0,0 +1,152 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestDecodeWithRawFields(t *testing.T) {
+	data := []byte(`{
+		"id": "cus_123",
+		"object": "customer",
+		"email": "jenny@example.com",
+		"metadata": {"foo": "bar"},
+		"loyalty": {"tier": "gold", "points": 1200},
+		"preferred_name": "Jen"
+	}`)
+
+	var customer Customer
+	raw, err := DecodeWithRawFields(data, &customer)
+	assert.NoError(t, err)
+	assert.Equal(t, "cus_123", customer.ID)
+	assert.Equal(t, "jenny@example.com", customer.Email)
+	assert.Equal(t, RawFields{
+		"loyalty":        json.RawMessage(`{"tier": "gold", "points": 1200}`),
+		"preferred_name": json.RawMessage(`"Jen"`),
+	}, raw)
+
+	encoded, err := EncodeWithRawFields(&customer, raw)
+	assert.NoError(t, err)
+
+	var decoded Customer
+	decodedRaw, err := DecodeWithRawFields(encoded, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, customer, decoded)
+	assert.Equal(t, map[string]interface{}{"tier": "gold", "points": float64(1200)}, decodeRawField(t, decodedRaw["loyalty"]))
+	assert.Equal(t, "Jen", decodeRawField(t, decodedRaw["preferred_name"]))
+
+	// Encoding is stable
+	again, err := EncodeWithRawFields(&decoded, decodedRaw)
+	assert.NoError(t, err)
+	assert.Equal(t, string(encoded), string(again))
+}
+
+func TestDecodeWithRawFields_Nested(t *testing.T) {
+	data := []byte(`{
+		"id": "cus_123",
+		"object": "customer",
+		"invoice_settings": {
+			"footer": "Thanks",
+			"rendering": {"template": "inrtem_123"}
+		},
+		"subscriptions": {
+			"object": "list",
+			"data": [
+				{"id": "sub_123", "object": "subscription"},
+				{"id": "sub_456", "object": "subscription", "billing_mode": "flexible"}
+			]
+		}
+	}`)
+
+	var customer Customer
+	raw, err := DecodeWithRawFields(data, &customer)
+	assert.NoError(t, err)
+	assert.Equal(t, "Thanks", customer.InvoiceSettings.Footer)
+	assert.Equal(t, map[string]interface{}{
+		"rendering": map[string]interface{}{"template": "inrtem_123"},
+	}, decodeRawField(t, raw["invoice_settings"]))
+	// ListMeta doesn't have a field for the list's object name either
+	assert.Equal(t, map[string]interface{}{
+		"data":   []interface{}{nil, map[string]interface{}{"billing_mode": "flexible"}},
+		"object": "list",
+	}, decodeRawField(t, raw["subscriptions"]))
+
+	encoded, err := EncodeWithRawFields(&customer, raw)
+	assert.NoError(t, err)
+
+	var fields struct {
+		InvoiceSettings map[string]interface{} `json:"invoice_settings"`
+		Subscriptions   struct {
+			Data []map[string]interface{} `json:"data"`
+		} `json:"subscriptions"`
+	}
+	assert.NoError(t, json.Unmarshal(encoded, &fields))
+	assert.Equal(t, "Thanks", fields.InvoiceSettings["footer"])
+	assert.Equal(t, map[string]interface{}{"template": "inrtem_123"}, fields.InvoiceSettings["rendering"])
+	assert.Equal(t, 2, len(fields.Subscriptions.Data))
+	assert.Equal(t, "sub_123", fields.Subscriptions.Data[0]["id"])
+	assert.Nil(t, fields.Subscriptions.Data[0]["billing_mode"])
+	assert.Equal(t, "flexible", fields.Subscriptions.Data[1]["billing_mode"])
+
+	// Decoding the result gives the same resource and raw fields again
+	var decoded Customer
+	decodedRaw, err := DecodeWithRawFields(encoded, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, customer, decoded)
+	assert.Equal(t, raw, decodedRaw)
+}
+
+func TestDecodeWithRawFields_Event(t *testing.T) {
+	data := []byte(`{
+		"id": "evt_123",
+		"object": "event",
+		"type": "customer.created",
+		"data": {"object": {"id": "cus_123", "object": "customer", "preferred_name": "Jen"}},
+		"context": "acct_123"
+	}`)
+
+	var event Event
+	raw, err := DecodeWithRawFields(data, &event)
+	assert.NoError(t, err)
+	assert.Equal(t, RawFields{"context": json.RawMessage(`"acct_123"`)}, raw)
+
+	encoded, err := EncodeWithRawFields(&event, raw)
+	assert.NoError(t, err)
+
+	var decoded Event
+	decodedRaw, err := DecodeWithRawFields(encoded, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, "evt_123", decoded.ID)
+	assert.Equal(t, "Jen", decoded.Data.Object["preferred_name"])
+	assert.Equal(t, "acct_123", decodeRawField(t, decodedRaw["context"]))
+}
+
+func TestDecodeWithRawFields_Invalid(t *testing.T) {
+	var customer Customer
+	_, err := DecodeWithRawFields([]byte(`{"id": "cus_123"}`), customer)
+	assert.Error(t, err)
+
+	_, err = DecodeWithRawFields([]byte(`not json`), &customer)
+	assert.Error(t, err)
+
+	_, err = EncodeWithRawFields("cus_123", nil)
+	assert.Error(t, err)
+}
+
+func TestEncodeWithRawFields_KnownFieldsWin(t *testing.T) {
+	encoded, err := EncodeWithRawFields(&Customer{ID: "cus_123"}, RawFields{"id": json.RawMessage(`"cus_456"`)})
+	assert.NoError(t, err)
+
+	var decoded Customer
+	_, err = DecodeWithRawFields(encoded, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, "cus_123", decoded.ID)
+}
+
+func decodeRawField(t *testing.T, raw json.RawMessage) interface{} {
+	var v interface{}
+	assert.NoError(t, json.Unmarshal(raw, &v))
+	return v
+}
@@ -0,0 +1,90 @@
+// Package rawrequest provides a way to call API endpoints that the library
+// doesn't have types for yet, like preview and beta endpoints.
+package rawrequest
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+// Client is used to invoke arbitrary API endpoints.
+type Client struct {
+	B   stripe.Backend
+	Key string
+}
+
+// Do sends a request with the given method to path, like
+// `/v1/preview_resources`, and returns the API's response, whose RawJSON is
+// the response body. content is the form encoded parameters of the request,
+// which are sent in the URL for `GET` requests and in the body otherwise. It
+// can be built from a map with Encode.
+//
+// params sets the request's headers, like its idempotency key and the
+// connected account it's made on behalf of. It can be nil.
+func Do(method, path, content string, params *stripe.Params) (*stripe.APIResponse, error) {
+	return getC().Do(method, path, content, params)
+}
+
+// Do sends a request with the given method to path, like
+// `/v1/preview_resources`, and returns the API's response, whose RawJSON is
+// the response body. content is the form encoded parameters of the request,
+// which are sent in the URL for `GET` requests and in the body otherwise. It
+// can be built from a map with Encode.
+//
+// params sets the request's headers, like its idempotency key and the
+// connected account it's made on behalf of. It can be nil.
+func (c Client) Do(method, path, content string, params *stripe.Params) (*stripe.APIResponse, error) {
+	body, err := parseContent(content)
+	if err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &stripe.Params{}
+	}
+
+	resource := &stripe.APIResource{}
+	err = c.B.CallRaw(method, path, c.Key, body, params, resource)
+	return resource.LastResponse, err
+}
+
+// Encode form encodes params the way the library encodes the parameters of
+// its own requests, so that it can be passed to Do. Values can be nested maps
+// and slices.
+func Encode(params map[string]interface{}) string {
+	values := &form.Values{}
+	form.AppendTo(values, params)
+	return values.Encode()
+}
+
+func getC() Client {
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
+}
+
+// parseContent decodes form encoded content into values, keeping the order of
+// its keys.
+func parseContent(content string) (*form.Values, error) {
+	values := &form.Values{}
+	for _, pair := range strings.Split(content, "&") {
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		key, err := url.QueryUnescape(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid form encoded content: %v", err)
+		}
+		var value string
+		if len(parts) == 2 {
+			value, err = url.QueryUnescape(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid form encoded content: %v", err)
+			}
+		}
+		values.Add(key, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,63 @@
+package rawrequest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestDo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/preview_resources", r.URL.Path)
+		assert.Equal(t, "acct_123", r.Header.Get("Stripe-Account"))
+
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "limit=3", r.URL.RawQuery)
+			w.Write([]byte(`{"object":"list","data":[]}`))
+
+		case http.MethodPost:
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "bar", r.PostForm.Get("metadata[foo]"))
+			assert.Equal(t, "a b", r.PostForm.Get("name"))
+			w.Write([]byte(`{"id":"pr_123","object":"preview_resource"}`))
+		}
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	params := &stripe.Params{}
+	params.SetStripeAccount("acct_123")
+
+	resp, err := c.Do(http.MethodGet, "/v1/preview_resources", "limit=3", params)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"object":"list","data":[]}`, string(resp.RawJSON))
+
+	resp, err = c.Do(http.MethodPost, "/v1/preview_resources", "metadata%5Bfoo%5D=bar&name=a+b", params)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"id":"pr_123","object":"preview_resource"}`, string(resp.RawJSON))
+}
+
+func TestDo_InvalidContent(t *testing.T) {
+	_, err := Client{}.Do(http.MethodPost, "/v1/preview_resources", "name=%zz", nil)
+	assert.Error(t, err)
+}
+
+func TestEncode(t *testing.T) {
+	// Keys of maps are encoded in no particular order
+	values, err := url.ParseQuery(Encode(map[string]interface{}{
+		"items":    []interface{}{map[string]interface{}{"price": "price_123"}},
+		"metadata": map[string]string{"foo": "bar"},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"items[0][price]": {"price_123"},
+		"metadata[foo]":   {"bar"},
+	}, values)
+}
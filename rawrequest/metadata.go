@@ -0,0 +1,106 @@
+package rawrequest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public functions
+//
+
+// MetadataResourceTypes returns the object types, like `customer` or
+// `issuing.card`, whose metadata can be updated with UpdateMetadata, in
+// alphabetical order.
+func MetadataResourceTypes() []string {
+	types := make([]string, 0, len(metadataPaths))
+	for typ := range metadataPaths {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// UpdateMetadata sets the metadata of the resource with the given object
+// type, like `customer`, and ID, without changing any of its other
+// attributes. Keys that aren't in metadata are kept, and keys set to an empty
+// string are removed.
+//
+// params sets the request's headers, like the connected account the resource
+// belongs to. It can be nil.
+func UpdateMetadata(resourceType, id string, metadata map[string]string, params *stripe.Params) (*stripe.APIResponse, error) {
+	return getC().UpdateMetadata(resourceType, id, metadata, params)
+}
+
+// UpdateMetadata sets the metadata of the resource with the given object
+// type, like `customer`, and ID, without changing any of its other
+// attributes. Keys that aren't in metadata are kept, and keys set to an empty
+// string are removed.
+//
+// params sets the request's headers, like the connected account the resource
+// belongs to. It can be nil.
+func (c Client) UpdateMetadata(resourceType, id string, metadata map[string]string, params *stripe.Params) (*stripe.APIResponse, error) {
+	path, ok := metadataPaths[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("metadata of %s resources can't be updated with UpdateMetadata", resourceType)
+	}
+	if id == "" {
+		return nil, fmt.Errorf("invalid %s ID: an ID is required to update its metadata", resourceType)
+	}
+	if len(metadata) == 0 {
+		return nil, fmt.Errorf("no metadata to update on %s %s", resourceType, id)
+	}
+
+	content := Encode(map[string]interface{}{"metadata": metadata})
+	return c.Do(http.MethodPost, path+"/"+url.PathEscape(id), content, params)
+}
+
+//
+// Private variables
+//
+
+// metadataPaths maps the object types whose metadata can be updated to the
+// path of their collection. Each resource is updated with a `POST` to its
+// collection's path followed by its ID.
+var metadataPaths = map[string]string{
+	"account":                      "/v1/accounts",
+	"billing_portal.configuration": "/v1/billing_portal/configurations",
+	"charge":                       "/v1/charges",
+	"coupon":                       "/v1/coupons",
+	"customer":                     "/v1/customers",
+	"dispute":                      "/v1/disputes",
+	"file_link":                    "/v1/file_links",
+	"invoice":                      "/v1/invoices",
+	"invoiceitem":                  "/v1/invoiceitems",
+	"issuing.authorization":        "/v1/issuing/authorizations",
+	"issuing.card":                 "/v1/issuing/cards",
+	"issuing.cardholder":           "/v1/issuing/cardholders",
+	"issuing.dispute":              "/v1/issuing/disputes",
+	"issuing.transaction":          "/v1/issuing/transactions",
+	"payment_intent":               "/v1/payment_intents",
+	"payment_link":                 "/v1/payment_links",
+	"payment_method":               "/v1/payment_methods",
+	"payout":                       "/v1/payouts",
+	"plan":                         "/v1/plans",
+	"price":                        "/v1/prices",
+	"product":                      "/v1/products",
+	"promotion_code":               "/v1/promotion_codes",
+	"quote":                        "/v1/quotes",
+	"radar.value_list":             "/v1/radar/value_lists",
+	"refund":                       "/v1/refunds",
+	"setup_intent":                 "/v1/setup_intents",
+	"shipping_rate":                "/v1/shipping_rates",
+	"source":                       "/v1/sources",
+	"subscription":                 "/v1/subscriptions",
+	"subscription_schedule":        "/v1/subscription_schedules",
+	"tax_rate":                     "/v1/tax_rates",
+	"terminal.location":            "/v1/terminal/locations",
+	"terminal.reader":              "/v1/terminal/readers",
+	"topup":                        "/v1/topups",
+	"transfer":                     "/v1/transfers",
+	"webhook_endpoint":             "/v1/webhook_endpoints",
+}
@@ -0,0 +1,57 @@
+package rawrequest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestUpdateMetadata(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/issuing/cards/ic_123", r.URL.Path)
+		assert.Equal(t, "acct_123", r.Header.Get("Stripe-Account"))
+
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, url.Values{
+			"metadata[batch]": {"42"},
+			"metadata[old]":   {""},
+		}, r.PostForm)
+		w.Write([]byte(`{"id":"ic_123","object":"issuing.card"}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	params := &stripe.Params{}
+	params.SetStripeAccount("acct_123")
+
+	resp, err := c.UpdateMetadata("issuing.card", "ic_123", map[string]string{"batch": "42", "old": ""}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"ic_123","object":"issuing.card"}`, string(resp.RawJSON))
+}
+
+func TestUpdateMetadata_Invalid(t *testing.T) {
+	metadata := map[string]string{"foo": "bar"}
+
+	_, err := Client{}.UpdateMetadata("balance_transaction", "txn_123", metadata, nil)
+	assert.Error(t, err)
+
+	_, err = Client{}.UpdateMetadata("customer", "", metadata, nil)
+	assert.Error(t, err)
+
+	_, err = Client{}.UpdateMetadata("customer", "cus_123", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestMetadataResourceTypes(t *testing.T) {
+	types := MetadataResourceTypes()
+	assert.Contains(t, types, "customer")
+	assert.Contains(t, types, "issuing.card")
+	assert.True(t, sort.StringsAreSorted(types))
+}
@@ -18,6 +18,9 @@ import (
 type Client struct {
 	B   stripe.Backend
 	Key string
+	// FilesBackend is the backend result files are downloaded from by
+	// DownloadResult. Defaults to the global UploadsBackend.
+	FilesBackend stripe.Backend
 }
 
 // New creates a new report run.
@@ -91,5 +94,5 @@ func (i *Iter) ReportRunList() *stripe.ReportRunList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{B: stripe.GetBackend(stripe.APIBackend), Key: stripe.GetKey()}
 }
@@ -0,0 +1,94 @@
+package reportrun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/file"
+)
+
+// resultPollInterval is the time DownloadResult waits between checks of a
+// pending report run. It's a variable so that tests can shorten it.
+var resultPollInterval = 5 * time.Second
+
+// resultTimeout bounds the total time DownloadResult waits for a pending
+// report run to finish.
+var resultTimeout = 10 * time.Minute
+
+// DownloadResult waits for a report run to succeed and then streams its result
+// file into w. Pending runs are checked every few seconds until ctx is done,
+// or for up to ten minutes, after which an error is returned; larger reports
+// may take longer, in which case DownloadResult can be called again. An error
+// is also returned if the run failed.
+func DownloadResult(ctx context.Context, run *stripe.ReportRun, w io.Writer) error {
+	return getC().DownloadResult(ctx, run, w)
+}
+
+// DownloadResult waits for a report run to succeed and then streams its result
+// file into w. Pending runs are checked every few seconds until ctx is done,
+// or for up to ten minutes, after which an error is returned; larger reports
+// may take longer, in which case DownloadResult can be called again. An error
+// is also returned if the run failed.
+func (c Client) DownloadResult(ctx context.Context, run *stripe.ReportRun, w io.Writer) error {
+	if run == nil {
+		return errors.New("report run cannot be nil")
+	}
+
+	// Only the ID of the run is required, so a run without a status is
+	// fetched before it's checked.
+	if run.Status == "" || run.Status == stripe.ReportRunStatusPending {
+		v, err := stripe.Poll(ctx,
+			func(ctx context.Context) (interface{}, error) {
+				params := &stripe.ReportRunParams{}
+				params.Context = ctx
+				return c.Get(run.ID, params)
+			},
+			func(v interface{}) bool {
+				return v.(*stripe.ReportRun).Status != stripe.ReportRunStatusPending
+			},
+			&stripe.PollOptions{
+				InitialInterval: resultPollInterval,
+				Multiplier:      1,
+				Timeout:         resultTimeout,
+			},
+		)
+		if err != nil && interrupted(ctx, err) {
+			return fmt.Errorf("report run %s still pending: %w", run.ID, err)
+		}
+		if err != nil {
+			return err
+		}
+		run = v.(*stripe.ReportRun)
+	}
+
+	if run.Status == stripe.ReportRunStatusFailed {
+		return fmt.Errorf("report run %s failed: %s", run.ID, run.Error)
+	}
+	if run.Result == nil {
+		return fmt.Errorf("report run %s has no result", run.ID)
+	}
+
+	filesBackend := c.FilesBackend
+	if filesBackend == nil {
+		filesBackend = stripe.GetBackend(stripe.UploadsBackend)
+	}
+	files := file.Client{B: filesBackend, Key: c.Key}
+	params := &stripe.FileParams{}
+	params.Context = ctx
+	_, err := files.Download(run.Result.ID, w, params)
+	if err != nil && interrupted(ctx, err) {
+		return fmt.Errorf("download of the result of report run %s interrupted: %w", run.ID, err)
+	}
+	return err
+}
+
+// interrupted reports whether err was caused by ctx, or by a context derived
+// from it, being done. Errors of requests are wrapped, for example in a
+// *url.Error, so they're unwrapped to find the context's error.
+func interrupted(ctx context.Context, err error) bool {
+	return ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,102 @@
+package reportrun
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestReportRunDownloadResult(t *testing.T) {
+	defer func(d time.Duration) { resultPollInterval = d }(resultPollInterval)
+	resultPollInterval = time.Millisecond
+
+	gets := 0
+	var serverURL string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/reporting/report_runs/frr_123":
+			gets++
+			if gets < 3 {
+				w.Write([]byte(`{"id":"frr_123","status":"pending"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"frr_123","status":"succeeded","result":{"id":"file_123"}}`))
+		case "/v1/files/file_123":
+			w.Write([]byte(`{"id":"file_123","url":"` + serverURL + `/v1/files/file_123/contents"}`))
+		case "/v1/files/file_123/contents":
+			w.Write([]byte("category,amount\n"))
+		default:
+			assert.Fail(t, "unexpected request to "+r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+	serverURL = ts.URL
+
+	backend := stripetest.NewBackend(ts.URL)
+	c := Client{B: backend, FilesBackend: backend, Key: "sk_test_123"}
+
+	var buf bytes.Buffer
+	err := c.DownloadResult(context.Background(), &stripe.ReportRun{ID: "frr_123"}, &buf)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, gets)
+	assert.Equal(t, "category,amount\n", buf.String())
+}
+
+func TestReportRunDownloadResult_Failed(t *testing.T) {
+	c := Client{Key: "sk_test_123"}
+	err := c.DownloadResult(context.Background(), &stripe.ReportRun{
+		ID:     "frr_123",
+		Status: stripe.ReportRunStatusFailed,
+		Error:  "boom",
+	}, &bytes.Buffer{})
+	assert.EqualError(t, err, "report run frr_123 failed: boom")
+}
+
+func TestReportRunDownloadResult_Canceled(t *testing.T) {
+	defer func(d time.Duration) { resultPollInterval = d }(resultPollInterval)
+	resultPollInterval = time.Hour
+
+	// The run is still pending when the context is canceled while waiting
+	// to check it again
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"frr_123","status":"pending"}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	err := c.DownloadResult(ctx, &stripe.ReportRun{ID: "frr_123"}, &bytes.Buffer{})
+	assert.EqualError(t, err, "report run frr_123 still pending: context canceled")
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestReportRunDownloadResult_DeadlineDuringDownload(t *testing.T) {
+	// The deadline passes while the result file is being fetched
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	backend := stripetest.NewBackend(ts.URL)
+	c := Client{B: backend, FilesBackend: backend, Key: "sk_test_123"}
+
+	err := c.DownloadResult(ctx, &stripe.ReportRun{
+		ID:     "frr_123",
+		Result: &stripe.File{ID: "file_123"},
+		Status: stripe.ReportRunStatusSucceeded,
+	}, &bytes.Buffer{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "unexpected error: %v", err)
+	assert.Contains(t, err.Error(), "download of the result of report run frr_123 interrupted")
+}
@@ -0,0 +1,290 @@
+package stripe
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+//
+// Public types
+//
+
+// RequestMetrics describes a request made to Stripe. It's passed to
+// BackendConfig.RequestMetricsHook once a request has completed, including
+// all of its retries.
+type RequestMetrics struct {
+	// Duration is the time taken by the last attempt at the request, from
+	// when it was sent until its response body was read.
+	Duration time.Duration
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Path is the path of the request, like `/v1/charges/ch_123`.
+	Path string
+
+	// PathTemplate is Path with its IDs replaced by `{id}`, like
+	// `/v1/charges/{id}`, which makes it suitable as a metric label. See
+	// PathTemplate.
+	PathTemplate string
+
+	// RequestID is the value of the `Request-Id` header of the response, if
+	// any.
+	RequestID string
+
+	// Retries is the number of times the request was retried before its
+	// last attempt.
+	Retries int
+
+	// StatusCode is the HTTP status code of the response, or 0 if no response
+	// was received.
+	StatusCode int
+
+	// Timing is a breakdown of Duration. It's only set when
+	// BackendConfig.EnableRequestTiming is true.
+	Timing *RequestTiming
+}
+
+// RequestTiming is a breakdown of the time spent making a request, collected
+// with net/http/httptrace. Phases that didn't happen, like the DNS lookup,
+// connection and TLS handshake of a request that reused a connection, have a
+// zero duration.
+type RequestTiming struct {
+	// BodyRead is the time spent reading and decoding the response body after
+	// the response headers were received.
+	BodyRead time.Duration
+
+	// Connect is the time spent establishing a TCP connection.
+	Connect time.Duration
+
+	// ConnReused is true if the request was sent on a connection that was
+	// already open.
+	ConnReused bool
+
+	// DNSLookup is the time spent resolving the API's host name.
+	DNSLookup time.Duration
+
+	// ServerProcessing is the time between the request being fully written
+	// and the first byte of the response being received. It's roughly the
+	// time Stripe spent processing the request, plus one network round trip.
+	ServerProcessing time.Duration
+
+	// TimeToFirstByte is the time between the start of the request and the
+	// first byte of the response being received.
+	TimeToFirstByte time.Duration
+
+	// TLSHandshake is the time spent on the TLS handshake.
+	TLSHandshake time.Duration
+}
+
+//
+// Public functions
+//
+
+// PathTemplate returns path with its IDs replaced by `{id}`, like
+// RequestMetrics.PathTemplate.
+//
+// If path was recently built by FormatURLPath, which is how the library
+// builds the paths of its requests, the template is its format string with
+// every parameter replaced by `{id}`. This also replaces custom IDs that are
+// made of lowercase letters, like a coupon named `gold`. Otherwise, the
+// segments that look like IDs are replaced, which are those that contain a
+// digit or an uppercase letter. The leading API version, like `v1`, is kept.
+func PathTemplate(path string) string {
+	if template, ok := pathTemplates.get(path); ok {
+		return template
+	}
+	return guessPathTemplate(path)
+}
+
+//
+// Private types
+//
+
+// pathTemplateCache remembers the templates of the paths built by
+// FormatURLPath for which guessPathTemplate gives a different result.
+type pathTemplateCache struct {
+	mu        sync.RWMutex
+	templates map[string]string
+}
+
+// requestTimer collects a RequestTiming for a single attempt at a request.
+// Trace hooks may be called concurrently, so all fields are guarded by mu.
+type requestTimer struct {
+	mu sync.Mutex
+
+	connectStart time.Time
+	dnsStart     time.Time
+	start        time.Time
+	timing       RequestTiming
+	tlsStart     time.Time
+	wroteRequest time.Time
+}
+
+//
+// Private variables
+//
+
+// apiVersionSegment matches the version segment that API paths start with.
+var apiVersionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// maxPathTemplates bounds the number of paths that pathTemplates remembers.
+// Paths contain IDs, so there's no bound on how many are built.
+const maxPathTemplates = 1000
+
+var pathTemplates = pathTemplateCache{templates: make(map[string]string)}
+
+//
+// Private functions
+//
+
+func newRequestTimer() *requestTimer {
+	return &requestTimer{start: time.Now()}
+}
+
+// result returns the collected timing, given the time it took to handle the
+// response body.
+func (t *requestTimer) result(bodyRead time.Duration) *RequestTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	timing := t.timing
+	timing.BodyRead = bodyRead
+	return &timing
+}
+
+// trace returns ctx with a client trace that records the timing of each phase
+// of the request.
+func (t *requestTimer) trace(ctx context.Context) context.Context {
+	record := func(f func(now time.Time)) {
+		now := time.Now()
+		t.mu.Lock()
+		f(now)
+		t.mu.Unlock()
+	}
+
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			record(func(now time.Time) { t.dnsStart = now })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			record(func(now time.Time) { t.timing.DNSLookup = now.Sub(t.dnsStart) })
+		},
+		ConnectStart: func(network, addr string) {
+			record(func(now time.Time) {
+				// Several addresses may be dialed in parallel, so keep the
+				// first start time
+				if t.connectStart.IsZero() {
+					t.connectStart = now
+				}
+			})
+		},
+		ConnectDone: func(network, addr string, err error) {
+			if err != nil {
+				return
+			}
+			record(func(now time.Time) { t.timing.Connect = now.Sub(t.connectStart) })
+		},
+		TLSHandshakeStart: func() {
+			record(func(now time.Time) { t.tlsStart = now })
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			record(func(now time.Time) { t.timing.TLSHandshake = now.Sub(t.tlsStart) })
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			record(func(now time.Time) { t.timing.ConnReused = info.Reused })
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			record(func(now time.Time) { t.wroteRequest = now })
+		},
+		GotFirstResponseByte: func() {
+			record(func(now time.Time) {
+				t.timing.TimeToFirstByte = now.Sub(t.start)
+				if !t.wroteRequest.IsZero() {
+					t.timing.ServerProcessing = now.Sub(t.wroteRequest)
+				}
+			})
+		},
+	})
+}
+
+// maybeCallRequestMetricsHook reports the last attempt at a request to the
+// configured hook, if there's one.
+func (s *BackendImplementation) maybeCallRequestMetricsHook(req *http.Request, res *http.Response, duration time.Duration, timing *RequestTiming, retries int) {
+	if s.requestMetricsHook == nil {
+		return
+	}
+
+	metrics := &RequestMetrics{
+		Duration:     duration,
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		PathTemplate: PathTemplate(req.URL.Path),
+		Retries:      retries,
+		Timing:       timing,
+	}
+	if res != nil {
+		metrics.RequestID = res.Header.Get("Request-Id")
+		metrics.StatusCode = res.StatusCode
+	}
+	s.requestMetricsHook(metrics)
+}
+
+func (c *pathTemplateCache) get(path string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	template, ok := c.templates[path]
+	return template, ok
+}
+
+// record remembers the template of a path built by FormatURLPath from the
+// given format, unless guessPathTemplate finds it anyway.
+func (c *pathTemplateCache) record(format, path string) {
+	template := strings.Replace(format, "%s", "{id}", -1)
+
+	// Requests have their path unescaped by the time they're reported
+	if unescaped, err := url.PathUnescape(path); err == nil {
+		path = unescaped
+	}
+	if guessPathTemplate(path) == template {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The cache is only cleared on rare occasions, so the templates of the
+	// paths that were just built are almost always available
+	if len(c.templates) >= maxPathTemplates {
+		c.templates = make(map[string]string)
+	}
+	c.templates[path] = template
+}
+
+// guessPathTemplate replaces the segments of path that look like IDs by
+// `{id}`.
+func guessPathTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if i == 1 && apiVersionSegment.MatchString(segment) {
+			continue
+		}
+		if strings.IndexFunc(segment, isIDRune) >= 0 {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isIDRune reports whether r is only found in path segments that are IDs.
+func isIDRune(r rune) bool {
+	return ('0' <= r && r <= '9') || ('A' <= r && r <= 'Z')
+}
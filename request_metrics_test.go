@@ -0,0 +1,173 @@
+package stripe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestDo_RequestMetricsHook(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Request-Id", "req_123")
+		_, err := w.Write([]byte(`{}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	var metrics []*RequestMetrics
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			LeveledLogger:     debugLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			RequestMetricsHook: func(m *RequestMetrics) {
+				metrics = append(metrics, m)
+			},
+			URL: String(testServer.URL),
+		},
+	).(*BackendImplementation)
+
+	request, err := backend.NewRequest(http.MethodGet, "/v1/charges/ch_123", "sk_test_123", "application/x-www-form-urlencoded", nil)
+	assert.NoError(t, err)
+
+	var response APIResource
+	assert.NoError(t, backend.Do(request, nil, &response))
+
+	assert.Equal(t, 1, len(metrics))
+	assert.Equal(t, http.MethodGet, metrics[0].Method)
+	assert.Equal(t, "/v1/charges/ch_123", metrics[0].Path)
+	assert.Equal(t, "/v1/charges/{id}", metrics[0].PathTemplate)
+	assert.Equal(t, 0, metrics[0].Retries)
+	assert.Equal(t, "req_123", metrics[0].RequestID)
+	assert.Equal(t, http.StatusOK, metrics[0].StatusCode)
+	assert.True(t, metrics[0].Duration > 0)
+
+	// Timing isn't collected unless it's enabled
+	assert.Nil(t, metrics[0].Timing)
+}
+
+func TestDo_RequestMetricsHookWithTiming(t *testing.T) {
+	requestNum := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestNum++
+		// Fail the first attempt so that the hook is only called for the retry
+		if requestNum == 1 {
+			w.WriteHeader(http.StatusConflict)
+			_, err := w.Write([]byte(`{"error":{"message":"conflict"}}`))
+			assert.NoError(t, err)
+			return
+		}
+		_, err := w.Write([]byte(`{}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	var metrics []*RequestMetrics
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			EnableRequestTiming: Bool(true),
+			LeveledLogger:       debugLeveledLogger,
+			MaxNetworkRetries:   Int64(1),
+			RequestMetricsHook: func(m *RequestMetrics) {
+				metrics = append(metrics, m)
+			},
+			URL: String(testServer.URL),
+		},
+	).(*BackendImplementation)
+	backend.SetNetworkRetriesSleep(false)
+
+	for i := 0; i < 2; i++ {
+		request, err := backend.NewRequest(http.MethodGet, "/v1/charges", "sk_test_123", "application/x-www-form-urlencoded", nil)
+		assert.NoError(t, err)
+
+		var response APIResource
+		assert.NoError(t, backend.Do(request, nil, &response))
+	}
+
+	assert.Equal(t, 3, requestNum)
+	assert.Equal(t, 2, len(metrics))
+
+	// Only the first request is retried
+	assert.Equal(t, 1, metrics[0].Retries)
+	assert.Equal(t, 0, metrics[1].Retries)
+
+	timing := metrics[0].Timing
+	assert.NotNil(t, timing)
+	assert.Equal(t, http.StatusOK, metrics[0].StatusCode)
+	assert.True(t, timing.TimeToFirstByte > 0)
+	assert.True(t, timing.TimeToFirstByte >= timing.ServerProcessing)
+	assert.True(t, metrics[0].Duration >= timing.TimeToFirstByte)
+
+	// The connection is kept alive between requests
+	assert.True(t, metrics[1].Timing.ConnReused)
+	assert.Equal(t, int64(0), int64(metrics[1].Timing.Connect))
+}
+
+func TestPathTemplate(t *testing.T) {
+	assert.Equal(t, "/v1/charges", PathTemplate("/v1/charges"))
+	assert.Equal(t, "/v1/customers/{id}/sources/{id}", PathTemplate("/v1/customers/cus_123/sources/card_ABC"))
+	assert.Equal(t, "/v1/payment_intents/{id}/confirm", PathTemplate("/v1/payment_intents/pi_123/confirm"))
+	assert.Equal(t, "/v1/country_specs/{id}", PathTemplate("/v1/country_specs/US"))
+	assert.Equal(t, "/v1/reporting/report_types/{id}", PathTemplate("/v1/reporting/report_types/balance.summary.1"))
+}
+
+func TestPathTemplate_FormatURLPath(t *testing.T) {
+	// Custom IDs made of lowercase letters are only replaced in paths built
+	// by FormatURLPath
+	assert.Equal(t, "/v1/coupons/gold", PathTemplate("/v1/coupons/gold"))
+	FormatURLPath("/v1/coupons/%s", "gold")
+	assert.Equal(t, "/v1/coupons/{id}", PathTemplate("/v1/coupons/gold"))
+
+	// The path of a request is unescaped by the time it's reported
+	FormatURLPath("/v1/plans/%s", "gold/plan")
+	assert.Equal(t, "/v1/plans/{id}", PathTemplate("/v1/plans/gold/plan"))
+
+	FormatURLPath("/v1/products/%s/features/%s", "gold", "feature_123")
+	assert.Equal(t, "/v1/products/{id}/features/{id}", PathTemplate("/v1/products/gold/features/feature_123"))
+
+	// Literal segments are still kept
+	assert.Equal(t, "/v1/customers/search", PathTemplate("/v1/customers/search"))
+}
+
+func TestDo_RequestMetricsHook_CustomID(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"silver","object":"coupon"}`))
+	}))
+	defer testServer.Close()
+
+	var metrics []*RequestMetrics
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			LeveledLogger: debugLeveledLogger,
+			RequestMetricsHook: func(m *RequestMetrics) {
+				metrics = append(metrics, m)
+			},
+			URL: String(testServer.URL),
+		},
+	)
+
+	var coupon Coupon
+	err := backend.Call(http.MethodGet, FormatURLPath("/v1/coupons/%s", "silver"), "sk_test_123", nil, &coupon)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(metrics))
+	assert.Equal(t, "/v1/coupons/silver", metrics[0].Path)
+	assert.Equal(t, "/v1/coupons/{id}", metrics[0].PathTemplate)
+}
+
+func TestPathTemplateCache_Bounded(t *testing.T) {
+	c := pathTemplateCache{templates: make(map[string]string)}
+	for i := 0; i < maxPathTemplates+10; i++ {
+		c.record("/v1/coupons/%s", "/v1/coupons/gold"+strings.Repeat("x", i))
+	}
+	assert.True(t, len(c.templates) <= maxPathTemplates)
+
+	template, ok := c.get("/v1/coupons/gold" + strings.Repeat("x", maxPathTemplates+9))
+	assert.True(t, ok)
+	assert.Equal(t, "/v1/coupons/{id}", template)
+}
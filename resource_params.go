@@ -0,0 +1,304 @@
+package stripe
+
+// This file contains ToParams implementations that build a params struct from
+// a live resource. They're useful for tooling that copies objects between
+// accounts (e.g. from a test mode account to a live one) or that enforces a
+// "golden" configuration by comparing resources against the params they
+// should have been created with.
+//
+// Only fields that can be set by the user are copied. Read-only fields like
+// Created or Livemode are dropped, and optional fields holding their zero
+// value are left unset so that the API applies its own defaults.
+
+//
+// Public functions
+//
+
+// ToParams returns params that can be used to create a coupon equivalent to
+// this one, including its ID.
+func (c *Coupon) ToParams() *CouponParams {
+	params := &CouponParams{
+		Duration: stringIfSet(string(c.Duration)),
+		ID:       stringIfSet(c.ID),
+		Name:     stringIfSet(c.Name),
+	}
+	params.Metadata = copyMetadata(c.Metadata)
+
+	if c.PercentOff != 0 {
+		params.PercentOff = Float64(c.PercentOff)
+	} else {
+		params.AmountOff = Int64(c.AmountOff)
+		params.Currency = stringIfSet(string(c.Currency))
+
+		for currency, options := range c.CurrencyOptions {
+			if params.CurrencyOptions == nil {
+				params.CurrencyOptions = make(map[string]*CouponCurrencyOptionsParams)
+			}
+			params.CurrencyOptions[currency] = &CouponCurrencyOptionsParams{
+				AmountOff: Int64(options.AmountOff),
+			}
+		}
+	}
+	if c.Duration == CouponDurationRepeating {
+		params.DurationInMonths = Int64(c.DurationInMonths)
+	}
+	if c.MaxRedemptions != 0 {
+		params.MaxRedemptions = Int64(c.MaxRedemptions)
+	}
+	if c.RedeemBy != 0 {
+		params.RedeemBy = Int64(c.RedeemBy)
+	}
+	if c.AppliesTo != nil && len(c.AppliesTo.Products) > 0 {
+		params.AppliesTo = &CouponAppliesToParams{
+			Products: StringSlice(c.AppliesTo.Products),
+		}
+	}
+
+	return params
+}
+
+// ToParams returns params that can be used to create a price equivalent to
+// this one. The price's product is referenced by ID, so it must already exist
+// in the account where the price is created.
+func (p *Price) ToParams() *PriceParams {
+	params := &PriceParams{
+		Active:        Bool(p.Active),
+		BillingScheme: stringIfSet(string(p.BillingScheme)),
+		Currency:      stringIfSet(string(p.Currency)),
+		LookupKey:     stringIfSet(p.LookupKey),
+		Nickname:      stringIfSet(p.Nickname),
+		TaxBehavior:   stringIfSet(string(p.TaxBehavior)),
+		TiersMode:     stringIfSet(string(p.TiersMode)),
+	}
+	params.Metadata = copyMetadata(p.Metadata)
+
+	if p.Product != nil {
+		params.Product = stringIfSet(p.Product.ID)
+	}
+
+	if p.Recurring != nil {
+		params.Recurring = &PriceRecurringParams{
+			AggregateUsage: stringIfSet(string(p.Recurring.AggregateUsage)),
+			Interval:       stringIfSet(string(p.Recurring.Interval)),
+			IntervalCount:  Int64(p.Recurring.IntervalCount),
+			UsageType:      stringIfSet(string(p.Recurring.UsageType)),
+		}
+		if p.Recurring.TrialPeriodDays != 0 {
+			params.Recurring.TrialPeriodDays = Int64(p.Recurring.TrialPeriodDays)
+		}
+	}
+
+	if p.TransformQuantity != nil {
+		params.TransformQuantity = &PriceTransformQuantityParams{
+			DivideBy: Int64(p.TransformQuantity.DivideBy),
+			Round:    stringIfSet(string(p.TransformQuantity.Round)),
+		}
+	}
+
+	for currency, options := range p.CurrencyOptions {
+		if params.CurrencyOptions == nil {
+			params.CurrencyOptions = make(map[string]*PriceCurrencyOptionsParams)
+		}
+		params.CurrencyOptions[currency] = currencyOptionsParams(p.BillingScheme, options)
+	}
+
+	if p.CustomUnitAmount != nil {
+		params.CustomUnitAmount = &PriceCustomUnitAmountParams{
+			Enabled: Bool(true),
+			Maximum: int64IfSet(p.CustomUnitAmount.Maximum),
+			Minimum: int64IfSet(p.CustomUnitAmount.Minimum),
+			Preset:  int64IfSet(p.CustomUnitAmount.Preset),
+		}
+	} else if p.BillingScheme == PriceBillingSchemeTiered {
+		for _, tier := range p.Tiers {
+			tierParams := &PriceTierParams{}
+			tierParams.FlatAmount, tierParams.FlatAmountDecimal =
+				amountParams(tier.FlatAmount, tier.FlatAmountDecimal)
+			tierParams.UnitAmount, tierParams.UnitAmountDecimal =
+				amountParams(tier.UnitAmount, tier.UnitAmountDecimal)
+
+			// The last tier is returned with a null `up_to`, which must be
+			// sent back as `inf`.
+			if tier.UpTo == 0 {
+				tierParams.UpToInf = Bool(true)
+			} else {
+				tierParams.UpTo = Int64(tier.UpTo)
+			}
+
+			params.Tiers = append(params.Tiers, tierParams)
+		}
+	} else {
+		params.UnitAmount, params.UnitAmountDecimal =
+			amountParams(p.UnitAmount, p.UnitAmountDecimal)
+	}
+
+	return params
+}
+
+// ToParams returns params that can be used to create a product equivalent to
+// this one, including its ID.
+//
+// The product's default price isn't included because it can only be set once
+// the price exists. Copy prices separately and then update the product's
+// DefaultPrice.
+func (p *Product) ToParams() *ProductParams {
+	params := &ProductParams{
+		Active:              Bool(p.Active),
+		Caption:             stringIfSet(p.Caption),
+		Description:         stringIfSet(p.Description),
+		ID:                  stringIfSet(p.ID),
+		Name:                stringIfSet(p.Name),
+		StatementDescriptor: stringIfSet(p.StatementDescriptor),
+		Type:                stringIfSet(string(p.Type)),
+		UnitLabel:           stringIfSet(p.UnitLabel),
+		URL:                 stringIfSet(p.URL),
+	}
+	params.Metadata = copyMetadata(p.Metadata)
+
+	if len(p.Attributes) > 0 {
+		params.Attributes = StringSlice(p.Attributes)
+	}
+	if len(p.DeactivateOn) > 0 {
+		params.DeactivateOn = StringSlice(p.DeactivateOn)
+	}
+	if len(p.Images) > 0 {
+		params.Images = StringSlice(p.Images)
+	}
+	for _, feature := range p.MarketingFeatures {
+		params.MarketingFeatures = append(params.MarketingFeatures, &ProductMarketingFeatureParams{
+			Name: String(feature.Name),
+		})
+	}
+	if p.PackageDimensions != nil {
+		params.PackageDimensions = &PackageDimensionsParams{
+			Height: Float64(p.PackageDimensions.Height),
+			Length: Float64(p.PackageDimensions.Length),
+			Weight: Float64(p.PackageDimensions.Weight),
+			Width:  Float64(p.PackageDimensions.Width),
+		}
+	}
+	if p.Type == ProductTypeGood {
+		params.Shippable = Bool(p.Shippable)
+	}
+	if p.TaxCode != nil {
+		params.TaxCode = stringIfSet(p.TaxCode.ID)
+	}
+
+	return params
+}
+
+// ToParams returns params that can be used to create a tax rate equivalent
+// to this one.
+func (t *TaxRate) ToParams() *TaxRateParams {
+	params := &TaxRateParams{
+		Active:       Bool(t.Active),
+		Country:      stringIfSet(t.Country),
+		Description:  stringIfSet(t.Description),
+		DisplayName:  stringIfSet(t.DisplayName),
+		Inclusive:    Bool(t.Inclusive),
+		Jurisdiction: stringIfSet(t.Jurisdiction),
+		Percentage:   Float64(t.Percentage),
+		State:        stringIfSet(t.State),
+		TaxType:      stringIfSet(string(t.TaxType)),
+	}
+	params.Metadata = copyMetadata(t.Metadata)
+
+	return params
+}
+
+// ToParams returns params that can be used to create a webhook endpoint
+// equivalent to this one. Note that the new endpoint will be assigned a new
+// signing secret.
+func (w *WebhookEndpoint) ToParams() *WebhookEndpointParams {
+	params := &WebhookEndpointParams{
+		APIVersion:  stringIfSet(w.APIVersion),
+		Connect:     Bool(w.Connect),
+		Description: stringIfSet(w.Description),
+		URL:         stringIfSet(w.URL),
+	}
+	params.Metadata = copyMetadata(w.Metadata)
+
+	if len(w.EnabledEvents) > 0 {
+		params.EnabledEvents = StringSlice(w.EnabledEvents)
+	}
+
+	return params
+}
+
+//
+// Private functions
+//
+
+// amountParams picks between an integer amount and its decimal equivalent,
+// only using the latter when it can't be represented exactly as an integer.
+func amountParams(amount int64, decimal float64) (*int64, *float64) {
+	if decimal != 0 && decimal != float64(int64(decimal)) {
+		return nil, Float64(decimal)
+	}
+	if decimal != 0 {
+		return Int64(int64(decimal)), nil
+	}
+	return Int64(amount), nil
+}
+
+// currencyOptionsParams builds the params for one of a price's currency
+// options, following the same rules as the price's own amounts.
+func currencyOptionsParams(scheme PriceBillingScheme, options *PriceCurrencyOptions) *PriceCurrencyOptionsParams {
+	params := &PriceCurrencyOptionsParams{
+		TaxBehavior: stringIfSet(string(options.TaxBehavior)),
+	}
+
+	if options.CustomUnitAmount != nil {
+		params.CustomUnitAmount = &PriceCurrencyOptionsCustomUnitAmountParams{
+			Enabled: Bool(true),
+			Maximum: int64IfSet(options.CustomUnitAmount.Maximum),
+			Minimum: int64IfSet(options.CustomUnitAmount.Minimum),
+			Preset:  int64IfSet(options.CustomUnitAmount.Preset),
+		}
+	} else if scheme == PriceBillingSchemeTiered {
+		for _, tier := range options.Tiers {
+			tierParams := &PriceCurrencyOptionsTierParams{}
+			tierParams.FlatAmount, tierParams.FlatAmountDecimal =
+				amountParams(tier.FlatAmount, tier.FlatAmountDecimal)
+			tierParams.UnitAmount, tierParams.UnitAmountDecimal =
+				amountParams(tier.UnitAmount, tier.UnitAmountDecimal)
+			if tier.UpTo == 0 {
+				tierParams.UpToInf = Bool(true)
+			} else {
+				tierParams.UpTo = Int64(tier.UpTo)
+			}
+			params.Tiers = append(params.Tiers, tierParams)
+		}
+	} else {
+		params.UnitAmount, params.UnitAmountDecimal =
+			amountParams(options.UnitAmount, options.UnitAmountDecimal)
+	}
+
+	return params
+}
+
+func copyMetadata(metadata map[string]string) map[string]string {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	copied := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		copied[k] = v
+	}
+	return copied
+}
+
+func int64IfSet(i int64) *int64 {
+	if i == 0 {
+		return nil
+	}
+	return Int64(i)
+}
+
+func stringIfSet(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return String(s)
+}
@@ -0,0 +1,179 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+func TestCouponToParams(t *testing.T) {
+	coupon := &Coupon{
+		Duration:         CouponDurationRepeating,
+		DurationInMonths: 3,
+		ID:               "SPRING",
+		Metadata:         map[string]string{"campaign": "spring"},
+		PercentOff:       25,
+		TimesRedeemed:    10,
+	}
+
+	params := coupon.ToParams()
+	assert.Equal(t, "repeating", *params.Duration)
+	assert.Equal(t, int64(3), *params.DurationInMonths)
+	assert.Equal(t, "SPRING", *params.ID)
+	assert.Equal(t, 25.0, *params.PercentOff)
+	assert.Nil(t, params.AmountOff)
+	assert.Nil(t, params.MaxRedemptions)
+	assert.Equal(t, "spring", params.Metadata["campaign"])
+
+	// The metadata is copied rather than shared
+	params.Metadata["campaign"] = "summer"
+	assert.Equal(t, "spring", coupon.Metadata["campaign"])
+}
+
+func TestCouponToParams_CurrencyOptions(t *testing.T) {
+	coupon := &Coupon{
+		AmountOff: 500,
+		Currency:  CurrencyUSD,
+		CurrencyOptions: map[string]*CouponCurrencyOptions{
+			"eur": {AmountOff: 450},
+			"gbp": {AmountOff: 400},
+		},
+		Duration: CouponDurationOnce,
+	}
+
+	params := coupon.ToParams()
+	assert.Equal(t, int64(500), *params.AmountOff)
+	assert.Equal(t, 2, len(params.CurrencyOptions))
+	assert.Equal(t, int64(450), *params.CurrencyOptions["eur"].AmountOff)
+	assert.Equal(t, int64(400), *params.CurrencyOptions["gbp"].AmountOff)
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"450"}, body.Get("currency_options[eur][amount_off]"))
+
+	// Percentage coupons have no per-currency amounts
+	coupon = &Coupon{Duration: CouponDurationOnce, PercentOff: 25}
+	assert.Nil(t, coupon.ToParams().CurrencyOptions)
+}
+
+func TestPriceToParams(t *testing.T) {
+	var price Price
+	err := json.Unmarshal([]byte(`{
+		"id": "price_123",
+		"active": true,
+		"billing_scheme": "tiered",
+		"currency": "usd",
+		"product": "prod_123",
+		"recurring": {"interval": "month", "interval_count": 1, "usage_type": "metered", "aggregate_usage": "sum"},
+		"tiers_mode": "graduated",
+		"tiers": [
+			{"up_to": 10, "unit_amount": 100, "unit_amount_decimal": "100"},
+			{"up_to": null, "unit_amount": null, "unit_amount_decimal": "0.5"}
+		]
+	}`), &price)
+	assert.NoError(t, err)
+
+	params := price.ToParams()
+	assert.Equal(t, "prod_123", *params.Product)
+	assert.Nil(t, params.UnitAmount)
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"100"}, body.Get("tiers[0][unit_amount]"))
+	assert.Equal(t, []string{"10"}, body.Get("tiers[0][up_to]"))
+	assert.Equal(t, []string{"0.5"}, body.Get("tiers[1][unit_amount_decimal]"))
+	assert.Equal(t, []string{"inf"}, body.Get("tiers[1][up_to]"))
+	assert.Equal(t, []string{"month"}, body.Get("recurring[interval]"))
+	assert.Equal(t, []string{"sum"}, body.Get("recurring[aggregate_usage]"))
+}
+
+func TestPriceToParams_CurrencyOptions(t *testing.T) {
+	var price Price
+	err := json.Unmarshal([]byte(`{
+		"id": "price_123",
+		"billing_scheme": "per_unit",
+		"currency": "usd",
+		"currency_options": {
+			"eur": {"tax_behavior": "inclusive", "unit_amount": 900, "unit_amount_decimal": "900"},
+			"usd": {"tax_behavior": "exclusive", "unit_amount": 1000, "unit_amount_decimal": "1000"}
+		},
+		"unit_amount": 1000,
+		"unit_amount_decimal": "1000"
+	}`), &price)
+	assert.NoError(t, err)
+	assert.Equal(t, PriceCurrencyOptionsTaxBehaviorInclusive, price.CurrencyOptions["eur"].TaxBehavior)
+
+	params := price.ToParams()
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"900"}, body.Get("currency_options[eur][unit_amount]"))
+	assert.Equal(t, []string{"inclusive"}, body.Get("currency_options[eur][tax_behavior]"))
+	assert.Equal(t, []string{"1000"}, body.Get("currency_options[usd][unit_amount]"))
+	assert.Equal(t, []string{"1000"}, body.Get("unit_amount"))
+}
+
+func TestPriceToParams_CustomUnitAmount(t *testing.T) {
+	var price Price
+	err := json.Unmarshal([]byte(`{
+		"id": "price_123",
+		"billing_scheme": "per_unit",
+		"currency": "usd",
+		"custom_unit_amount": {"maximum": null, "minimum": 500, "preset": 1000},
+		"unit_amount": null,
+		"unit_amount_decimal": null
+	}`), &price)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), price.CustomUnitAmount.Minimum)
+
+	params := price.ToParams()
+	assert.Nil(t, params.UnitAmount)
+	assert.Nil(t, params.UnitAmountDecimal)
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"true"}, body.Get("custom_unit_amount[enabled]"))
+	assert.Equal(t, []string{"500"}, body.Get("custom_unit_amount[minimum]"))
+	assert.Equal(t, []string{"1000"}, body.Get("custom_unit_amount[preset]"))
+	assert.Nil(t, body.Get("custom_unit_amount[maximum]"))
+}
+
+func TestProductToParams(t *testing.T) {
+	product := &Product{
+		Active:       true,
+		Created:      1234567890,
+		DefaultPrice: &Price{ID: "price_123"},
+		ID:           "prod_123",
+		Images:       []string{"https://example.com/image.png"},
+		MarketingFeatures: []*ProductMarketingFeature{
+			{Name: "Organic cotton"},
+		},
+		Name:    "T-shirt",
+		TaxCode: &TaxCode{ID: "txcd_123"},
+		Type:    ProductTypeService,
+	}
+
+	params := product.ToParams()
+	assert.Equal(t, "prod_123", *params.ID)
+	assert.Equal(t, "T-shirt", *params.Name)
+	assert.Equal(t, "txcd_123", *params.TaxCode)
+	assert.Equal(t, []*string{String("https://example.com/image.png")}, params.Images)
+	assert.Equal(t, "Organic cotton", *params.MarketingFeatures[0].Name)
+	assert.Nil(t, params.DefaultPrice)
+	assert.Nil(t, params.Description)
+	assert.Nil(t, params.Shippable)
+}
+
+func TestWebhookEndpointToParams(t *testing.T) {
+	endpoint := &WebhookEndpoint{
+		EnabledEvents: []string{"charge.succeeded"},
+		Secret:        "whsec_123",
+		URL:           "https://example.com/webhook",
+	}
+
+	params := endpoint.ToParams()
+	assert.Equal(t, "https://example.com/webhook", *params.URL)
+	assert.Equal(t, []*string{String("charge.succeeded")}, params.EnabledEvents)
+	assert.Nil(t, params.APIVersion)
+}
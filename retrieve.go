@@ -0,0 +1,189 @@
+package stripe
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+//
+// Public types
+//
+
+// UnknownIDPrefixError is returned by Retrieve when the type of resource
+// can't be inferred from an ID.
+type UnknownIDPrefixError struct {
+	ID string
+}
+
+// Error serializes the error object to a string.
+func (e *UnknownIDPrefixError) Error() string {
+	return fmt.Sprintf("can't infer the type of resource for ID %q", e.ID)
+}
+
+//
+// Public functions
+//
+
+// ObjectForID returns the `object` name of the resource that the given ID
+// belongs to (e.g. "customer" for "cus_123"), inferred from its prefix. The
+// second return value is false if the prefix isn't recognized.
+func ObjectForID(id string) (string, bool) {
+	r := retrievableForID(id)
+	if r == nil {
+		return "", false
+	}
+	return r.object, true
+}
+
+// Retrieve fetches the resource identified by the given ID, inferring its
+// type from the ID's prefix, and returns it decoded into the corresponding
+// type from this package. It's intended for tooling like support consoles
+// that are given arbitrary IDs, and its result is usually inspected with a
+// type switch:
+//
+//	obj, err := stripe.Retrieve(ctx, backend, key, id)
+//	if err != nil {
+//		return err
+//	}
+//	switch v := obj.(type) {
+//	case *stripe.Customer:
+//		fmt.Println("customer", v.Email)
+//	case *stripe.Charge:
+//		fmt.Println("charge", v.Amount)
+//	}
+//
+// Retrieve takes the backend and key that a client is made of rather than a
+// client, since every client is defined in a subpackage that imports this
+// one. With a client.API, the ones of any of its clients can be used, like
+// sc.Customers.B and sc.Customers.Key.
+//
+// An UnknownIDPrefixError is returned if the ID's prefix isn't recognized.
+func Retrieve(ctx context.Context, b Backend, key, id string) (interface{}, error) {
+	r := retrievableForID(id)
+	if r == nil {
+		return nil, &UnknownIDPrefixError{ID: id}
+	}
+
+	v := objectConstructors[r.object]().(LastResponseSetter)
+	params := &Params{Context: ctx}
+	err := b.Call(http.MethodGet, FormatURLPath(r.path, id), key, params, v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+//
+// Private types
+//
+
+type retrievable struct {
+	prefix string
+	object string
+	path   string
+}
+
+//
+// Private variables
+//
+
+// retrievables lists the ID prefixes that Retrieve knows about. Prefixes that
+// share a beginning with another (like "sub_sched_" and "sub_") are resolved
+// by picking the longest match.
+var retrievables = []retrievable{
+	{"acct_", "account", "/v1/accounts/%s"},
+	{"apwc_", "apple_pay_domain", "/v1/apple_pay/domains/%s"},
+	{"bpc_", "billing_portal.configuration", "/v1/billing_portal/configurations/%s"},
+	{"btok_", "token", "/v1/tokens/%s"},
+	{"ch_", "charge", "/v1/charges/%s"},
+	{"clock_", "test_helpers.test_clock", "/v1/test_helpers/test_clocks/%s"},
+	{"cn_", "credit_note", "/v1/credit_notes/%s"},
+	{"credrev_", "treasury.credit_reversal", "/v1/treasury/credit_reversals/%s"},
+	{"cs_", "checkout.session", "/v1/checkout/sessions/%s"},
+	{"cus_", "customer", "/v1/customers/%s"},
+	{"debrev_", "treasury.debit_reversal", "/v1/treasury/debit_reversals/%s"},
+	{"dp_", "dispute", "/v1/disputes/%s"},
+	{"du_", "dispute", "/v1/disputes/%s"},
+	{"evt_", "event", "/v1/events/%s"},
+	{"fa_", "treasury.financial_account", "/v1/treasury/financial_accounts/%s"},
+	{"fca_", "financial_connections.account", "/v1/financial_connections/accounts/%s"},
+	{"fcsess_", "financial_connections.session", "/v1/financial_connections/sessions/%s"},
+	{"fee_", "application_fee", "/v1/application_fees/%s"},
+	{"file_", "file", "/v1/files/%s"},
+	{"frr_", "reporting.report_run", "/v1/reporting/report_runs/%s"},
+	{"iauth_", "issuing.authorization", "/v1/issuing/authorizations/%s"},
+	{"ibt_", "treasury.inbound_transfer", "/v1/treasury/inbound_transfers/%s"},
+	{"ic_", "issuing.card", "/v1/issuing/cards/%s"},
+	{"ich_", "issuing.cardholder", "/v1/issuing/cardholders/%s"},
+	{"idp_", "issuing.dispute", "/v1/issuing/disputes/%s"},
+	{"ii_", "invoiceitem", "/v1/invoiceitems/%s"},
+	{"in_", "invoice", "/v1/invoices/%s"},
+	{"intok_", "issuing.token", "/v1/issuing/tokens/%s"},
+	{"ipi_", "issuing.transaction", "/v1/issuing/transactions/%s"},
+	{"issfr_", "radar.early_fraud_warning", "/v1/radar/early_fraud_warnings/%s"},
+	{"link_", "file_link", "/v1/file_links/%s"},
+	{"mandate_", "mandate", "/v1/mandates/%s"},
+	{"obp_", "treasury.outbound_payment", "/v1/treasury/outbound_payments/%s"},
+	{"obt_", "treasury.outbound_transfer", "/v1/treasury/outbound_transfers/%s"},
+	{"order_", "order", "/v1/orders/%s"},
+	{"pi_", "payment_intent", "/v1/payment_intents/%s"},
+	{"plink_", "payment_link", "/v1/payment_links/%s"},
+	{"pm_", "payment_method", "/v1/payment_methods/%s"},
+	{"pmc_", "payment_method_configuration", "/v1/payment_method_configurations/%s"},
+	{"pmd_", "payment_method_domain", "/v1/payment_method_domains/%s"},
+	{"po_", "payout", "/v1/payouts/%s"},
+	{"price_", "price", "/v1/prices/%s"},
+	{"prod_", "product", "/v1/products/%s"},
+	{"promo_", "promotion_code", "/v1/promotion_codes/%s"},
+	{"prv_", "review", "/v1/reviews/%s"},
+	{"py_", "charge", "/v1/charges/%s"},
+	{"pyr_", "refund", "/v1/refunds/%s"},
+	{"qt_", "quote", "/v1/quotes/%s"},
+	{"rc_", "treasury.received_credit", "/v1/treasury/received_credits/%s"},
+	{"rd_", "treasury.received_debit", "/v1/treasury/received_debits/%s"},
+	{"re_", "refund", "/v1/refunds/%s"},
+	{"rsl_", "radar.value_list", "/v1/radar/value_lists/%s"},
+	{"rsli_", "radar.value_list_item", "/v1/radar/value_list_items/%s"},
+	{"seti_", "setup_intent", "/v1/setup_intents/%s"},
+	{"shr_", "shipping_rate", "/v1/shipping_rates/%s"},
+	{"si_", "subscription_item", "/v1/subscription_items/%s"},
+	{"sku_", "sku", "/v1/skus/%s"},
+	{"sqr_", "scheduled_query_run", "/v1/sigma/scheduled_query_runs/%s"},
+	{"src_", "source", "/v1/sources/%s"},
+	{"sub_", "subscription", "/v1/subscriptions/%s"},
+	{"sub_sched_", "subscription_schedule", "/v1/subscription_schedules/%s"},
+	{"tax_", "tax.transaction", "/v1/tax/transactions/%s"},
+	{"tmc_", "terminal.configuration", "/v1/terminal/configurations/%s"},
+	{"tml_", "terminal.location", "/v1/terminal/locations/%s"},
+	{"tmr_", "terminal.reader", "/v1/terminal/readers/%s"},
+	{"tok_", "token", "/v1/tokens/%s"},
+	{"tr_", "transfer", "/v1/transfers/%s"},
+	{"trxn_", "treasury.transaction", "/v1/treasury/transactions/%s"},
+	{"trxne_", "treasury.transaction_entry", "/v1/treasury/transaction_entries/%s"},
+	{"tu_", "topup", "/v1/topups/%s"},
+	{"txcd_", "tax_code", "/v1/tax_codes/%s"},
+	{"txn_", "balance_transaction", "/v1/balance_transactions/%s"},
+	{"txr_", "tax_rate", "/v1/tax_rates/%s"},
+	{"vr_", "identity.verification_report", "/v1/identity/verification_reports/%s"},
+	{"vs_", "identity.verification_session", "/v1/identity/verification_sessions/%s"},
+	{"we_", "webhook_endpoint", "/v1/webhook_endpoints/%s"},
+}
+
+//
+// Private functions
+//
+
+func retrievableForID(id string) *retrievable {
+	var match *retrievable
+	for i, r := range retrievables {
+		if !strings.HasPrefix(id, r.prefix) || len(id) == len(r.prefix) {
+			continue
+		}
+		if match == nil || len(r.prefix) > len(match.prefix) {
+			match = &retrievables[i]
+		}
+	}
+	return match
+}
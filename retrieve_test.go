@@ -0,0 +1,67 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestObjectForID(t *testing.T) {
+	object, ok := ObjectForID("cus_123")
+	assert.True(t, ok)
+	assert.Equal(t, "customer", object)
+
+	object, ok = ObjectForID("sub_sched_123")
+	assert.True(t, ok)
+	assert.Equal(t, "subscription_schedule", object)
+
+	object, ok = ObjectForID("sub_123")
+	assert.True(t, ok)
+	assert.Equal(t, "subscription", object)
+
+	object, ok = ObjectForID("trxne_123")
+	assert.True(t, ok)
+	assert.Equal(t, "treasury.transaction_entry", object)
+
+	_, ok = ObjectForID("xyz_123")
+	assert.False(t, ok)
+}
+
+func TestRetrieve(t *testing.T) {
+	var path string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"id":"pi_123","object":"payment_intent","amount":100}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(APIBackend, &BackendConfig{
+		LeveledLogger: nullLeveledLogger,
+		URL:           String(testServer.URL),
+	})
+
+	obj, err := Retrieve(context.Background(), backend, "sk_test_123", "pi_123")
+	assert.NoError(t, err)
+	assert.Equal(t, "/v1/payment_intents/pi_123", path)
+
+	pi, ok := obj.(*PaymentIntent)
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), pi.Amount)
+	assert.NotNil(t, pi.LastResponse)
+
+	_, err = Retrieve(context.Background(), backend, "sk_test_123", "xyz_123")
+	assert.EqualError(t, err, `can't infer the type of resource for ID "xyz_123"`)
+}
+
+func TestRetrievables(t *testing.T) {
+	for _, r := range retrievables {
+		newObject, ok := objectConstructors[r.object]
+		assert.True(t, ok, "no constructor for %s", r.object)
+
+		_, ok = newObject().(LastResponseSetter)
+		assert.True(t, ok, "%s can't be retrieved", r.object)
+	}
+}
@@ -86,5 +86,5 @@ func (i *Iter) ReviewList() *stripe.ReviewList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -34,6 +34,7 @@ type SearchIter struct {
 	searchContainer SearchContainer
 	searchParams    SearchParams
 	meta            *SearchMeta
+	progress        PageProgress
 	query           SearchQuery
 	values          []interface{}
 }
@@ -63,6 +64,11 @@ func (it *SearchIter) Meta() *SearchMeta {
 	return it.meta
 }
 
+// Progress returns information about the pages fetched so far.
+func (it *SearchIter) Progress() PageProgress {
+	return it.progress
+}
+
 // Next advances the SearchIter to the next item in the search results,
 // which will then be available
 // through the Current method.
@@ -84,8 +90,20 @@ func (it *SearchIter) Next() bool {
 }
 
 func (it *SearchIter) getPage() {
-	it.values, it.searchContainer, it.err = it.query(it.searchParams.GetParams(), it.formValues)
+	it.err = fetchWithPageRetries(it.searchParams.Context, it.searchParams.PageRetries, func() error {
+		var err error
+		it.values, it.searchContainer, err = it.query(it.searchParams.GetParams(), it.formValues)
+		return err
+	})
 	it.meta = it.searchContainer.GetSearchMeta()
+
+	if it.err == nil {
+		it.progress.advance(len(it.values), it.meta.HasMore)
+		it.progress.TotalCount = it.meta.TotalCount
+		if it.searchParams.OnPage != nil {
+			it.searchParams.OnPage(it.progress)
+		}
+	}
 }
 
 // SearchQuery is the function used to get search results.
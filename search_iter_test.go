@@ -144,6 +144,29 @@ func TestSearchIterMultiplePages(t *testing.T) {
 	assert.Equal(t, 4, cnt)
 }
 
+func TestSearchIterProgress(t *testing.T) {
+	total := uint32(3)
+	tq := testSearchQuery{
+		{[]interface{}{1, 2}, &SearchMeta{HasMore: true, NextPage: &nextPageTestToken, TotalCount: &total}, nil},
+		{[]interface{}{3}, &SearchMeta{}, nil},
+	}
+
+	var pages []PageProgress
+	it := GetSearchIter(&SearchParams{
+		OnPage: func(p PageProgress) {
+			pages = append(pages, p)
+		},
+	}, tq.query)
+	for it.Next() {
+	}
+	assert.NoError(t, it.Err())
+
+	assert.Equal(t, 2, len(pages))
+	assert.Equal(t, PageProgress{HasMore: true, ItemsFetched: 2, Page: 1, TotalCount: &total}, pages[0])
+	assert.Equal(t, PageProgress{HasMore: false, ItemsFetched: 3, Page: 2}, pages[1])
+	assert.Equal(t, pages[1], it.Progress())
+}
+
 type testSearchQuery []struct {
 	v []interface{}
 	m SearchContainer
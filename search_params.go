@@ -51,6 +51,15 @@ type SearchParams struct {
 	Page   *string   `form:"page"`
 	Expand []*string `form:"expand"`
 
+	// OnPage, if set, is invoked by the iterator after each page of results
+	// is fetched, which is useful for reporting the progress of long running
+	// jobs that walk through many results.
+	OnPage func(PageProgress) `form:"-"` // Not an API parameter
+
+	// PageRetries is the number of times the iterator fetches a page again
+	// after it fails with a retryable error. See ListParams.PageRetries.
+	PageRetries *int64 `form:"-"` // Not an API parameter
+
 	// Single specifies whether this is a single page iterator. By default,
 	// listing through an iterator will automatically grab additional pages as
 	// the query progresses. To change this behavior and just load a single
@@ -62,6 +71,10 @@ type SearchParams struct {
 	// account instead of under the account of the owner of the configured
 	// Stripe key.
 	StripeAccount *string `form:"-"` // Passed as header
+
+	// StripeVersion, if set, overrides the API version sent in the
+	// `Stripe-Version` header of this request. See Params.StripeVersion.
+	StripeVersion *string `form:"-"` // Passed as header
 }
 
 // AddExpand appends a new field to expand.
@@ -96,6 +109,7 @@ func (p *SearchParams) ToParams() *Params {
 	return &Params{
 		Context:       p.Context,
 		StripeAccount: p.StripeAccount,
+		StripeVersion: p.StripeVersion,
 	}
 }
 
@@ -61,5 +61,5 @@ func (i *Iter) SetupAttemptList() *stripe.SetupAttemptList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -143,5 +143,5 @@ func (i *Iter) SetupIntentList() *stripe.SetupIntentList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72/form"
 )
 
 func TestSetupIntentNextAction_UnmarshalJSON(t *testing.T) {
@@ -27,3 +28,49 @@ func TestSetupIntentNextAction_UnmarshalJSON(t *testing.T) {
 	assert.Equal(t, "https://stripe.com", action.RedirectToURL.URL)
 	assert.Equal(t, "https://stripe.com/return", action.RedirectToURL.ReturnURL)
 }
+
+func TestSetupIntentPaymentMethodOptions_MandateOptions(t *testing.T) {
+	params := &SetupIntentParams{
+		PaymentMethodOptions: &SetupIntentPaymentMethodOptionsParams{
+			ACSSDebit: &SetupIntentPaymentMethodOptionsACSSDebitParams{
+				MandateOptions: &SetupIntentPaymentMethodOptionsACSSDebitMandateOptionsParams{
+					IntervalDescription: String("First of every month"),
+					PaymentSchedule:     String(string(SetupIntentPaymentMethodOptionsACSSDebitMandateOptionsPaymentScheduleInterval)),
+					TransactionType:     String(string(SetupIntentPaymentMethodOptionsACSSDebitMandateOptionsTransactionTypeBusiness)),
+				},
+			},
+			BACSDebit: &SetupIntentPaymentMethodOptionsBACSDebitParams{
+				MandateOptions: &SetupIntentPaymentMethodOptionsBACSDebitMandateOptionsParams{
+					ReferencePrefix: String("ACME"),
+				},
+			},
+			SepaDebit: &SetupIntentPaymentMethodOptionsSepaDebitParams{
+				MandateOptions: &SetupIntentPaymentMethodOptionsSepaDebitMandateOptionsParams{
+					ReferencePrefix: String("ACME"),
+				},
+			},
+		},
+	}
+
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	assert.Equal(t, []string{"interval"}, body.Get("payment_method_options[acss_debit][mandate_options][payment_schedule]"))
+	assert.Equal(t, []string{"business"}, body.Get("payment_method_options[acss_debit][mandate_options][transaction_type]"))
+	assert.Equal(t, []string{"ACME"}, body.Get("payment_method_options[bacs_debit][mandate_options][reference_prefix]"))
+	assert.Equal(t, []string{"ACME"}, body.Get("payment_method_options[sepa_debit][mandate_options][reference_prefix]"))
+
+	data := []byte(`{
+		"id": "seti_123",
+		"mandate": {"id": "mandate_123", "payment_method_details": {"type": "bacs_debit", "bacs_debit": {"reference": "ACME-123456"}}},
+		"payment_method_options": {
+			"bacs_debit": {"mandate_options": {"reference_prefix": "ACME"}},
+			"sepa_debit": {"mandate_options": {"reference_prefix": "ACME"}}
+		}
+	}`)
+	var v SetupIntent
+	err := json.Unmarshal(data, &v)
+	assert.NoError(t, err)
+	assert.Equal(t, "ACME", v.PaymentMethodOptions.BACSDebit.MandateOptions.ReferencePrefix)
+	assert.Equal(t, "ACME", v.PaymentMethodOptions.SepaDebit.MandateOptions.ReferencePrefix)
+	assert.Equal(t, "ACME-123456", v.Mandate.PaymentMethodDetails.BACSDebit.Reference)
+}
@@ -74,5 +74,5 @@ func (i *Iter) SigmaScheduledQueryRunList() *stripe.SigmaScheduledQueryRunList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
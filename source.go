@@ -123,6 +123,14 @@ const (
 	SourceUsageSingleUse SourceUsage = "single_use"
 )
 
+// Attaches a source to a customer, making it available to charge that customer.
+type SourceObjectAttachParams struct {
+	Params   `form:"*"`
+	Customer *string `form:"-"` // Included in URL
+	// The ID of the source to attach.
+	Source *string `form:"source"`
+}
+
 // Delete a specified source for a given customer.
 type SourceObjectDetachParams struct {
 	Params   `form:"*"`
@@ -12,6 +12,7 @@ import (
 	"net/http"
 
 	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/sourcetransaction"
 )
 
 // Client is used to invoke /sources APIs.
@@ -58,6 +59,27 @@ func (c Client) Update(id string, params *stripe.SourceObjectParams) (*stripe.So
 	return source, err
 }
 
+// Attach is the method for the `POST /v1/customers/{customer}/sources` API.
+func Attach(params *stripe.SourceObjectAttachParams) (*stripe.Source, error) {
+	return getC().Attach(params)
+}
+
+// Attach is the method for the `POST /v1/customers/{customer}/sources` API.
+func (c Client) Attach(params *stripe.SourceObjectAttachParams) (*stripe.Source, error) {
+	if params == nil || params.Customer == nil || params.Source == nil {
+		return nil, fmt.Errorf(
+			"Invalid source attach params: Customer and Source need to be set",
+		)
+	}
+	path := stripe.FormatURLPath(
+		"/v1/customers/%s/sources",
+		stripe.StringValue(params.Customer),
+	)
+	source := &stripe.Source{}
+	err := c.B.Call(http.MethodPost, path, c.Key, params, source)
+	return source, err
+}
+
 // Detach is the method for the `DELETE /v1/customers/{customer}/sources/{id}` API.
 func Detach(id string, params *stripe.SourceObjectDetachParams) (*stripe.Source, error) {
 	return getC().Detach(id, params)
@@ -80,6 +102,24 @@ func (c Client) Detach(id string, params *stripe.SourceObjectDetachParams) (*str
 	return source, err
 }
 
+// ListSourceTransactions is the method for the `GET /v1/sources/{source}/source_transactions` API.
+func ListSourceTransactions(id string, params *stripe.SourceTransactionListParams) *SourceTransactionIter {
+	return getC().ListSourceTransactions(id, params)
+}
+
+// ListSourceTransactions is the method for the `GET /v1/sources/{source}/source_transactions` API.
+func (c Client) ListSourceTransactions(id string, listParams *stripe.SourceTransactionListParams) *SourceTransactionIter {
+	params := &stripe.SourceTransactionListParams{}
+	if listParams != nil {
+		*params = *listParams
+	}
+	params.Source = stripe.String(id)
+	return sourcetransaction.Client{B: c.B, Key: c.Key}.List(params)
+}
+
+// SourceTransactionIter is an iterator for the transactions of a source.
+type SourceTransactionIter = sourcetransaction.Iter
+
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -1,10 +1,13 @@
 package source
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
 	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
 	_ "github.com/stripe/stripe-go/v72/testing"
 )
 
@@ -78,3 +81,50 @@ func TestSourceSharing(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, source)
 }
+
+func TestSourceAttach(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/customers/cus_123/sources", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "src_123", r.PostForm.Get("source"))
+		w.Write([]byte(`{"id": "src_123", "object": "source", "customer": "cus_123"}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	source, err := c.Attach(&stripe.SourceObjectAttachParams{
+		Customer: stripe.String("cus_123"),
+		Source:   stripe.String("src_123"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "cus_123", source.Customer)
+}
+
+func TestSourceAttach_MissingParams(t *testing.T) {
+	_, err := Attach(&stripe.SourceObjectAttachParams{Customer: stripe.String("cus_123")})
+	assert.Error(t, err)
+}
+
+func TestSourceListSourceTransactions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/sources/src_123/source_transactions", r.URL.Path)
+		w.Write([]byte(`{
+			"object": "list",
+			"has_more": false,
+			"data": [
+				{"id": "srctxn_123", "object": "source_transaction", "amount": 1000, "source": "src_123", "type": "sepa_credit_transfer"}
+			]
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	i := c.ListSourceTransactions("src_123", nil)
+
+	assert.True(t, i.Next())
+	assert.Equal(t, int64(1000), i.SourceTransaction().Amount)
+	assert.NotNil(t, i.SourceTransactionList())
+	assert.False(t, i.Next())
+	assert.Nil(t, i.Err())
+}
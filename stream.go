@@ -0,0 +1,119 @@
+package stripe
+
+import (
+	"context"
+	"io"
+	"strconv"
+)
+
+//
+// Public types
+//
+
+// ProgressFunc is called periodically while a request or response body is
+// being transferred with the number of bytes transferred so far and the total
+// number of bytes expected, which is -1 if it isn't known in advance.
+type ProgressFunc func(transferred, total int64)
+
+// WriteTo copies the body of the streaming response into w and closes it,
+// returning the number of bytes written. It implements io.WriterTo.
+func (r *APIStream) WriteTo(w io.Writer) (int64, error) {
+	return r.writeTo(w, nil)
+}
+
+//
+// Public functions
+//
+
+// ContextWithUploadProgress returns a copy of ctx that makes the backend report
+// the progress of sending request bodies to progress. It's meant for large
+// multipart uploads like dispute evidence, where it can drive a progress bar
+// or detect a stalled upload, which can then be aborted by canceling the
+// context. Use the returned context as the Context of an upload's params.
+//
+// progress is called again from the start if a request is retried.
+func ContextWithUploadProgress(ctx context.Context, progress ProgressFunc) context.Context {
+	return context.WithValue(ctx, uploadProgressKey{}, progress)
+}
+
+// CallStreamingTo invokes an API that returns file content, like the PDF of a
+// quote, and streams the response body into w without buffering it into
+// memory, which makes it suitable for multi-hundred-megabyte report files. If
+// progress is not nil, it's called every time a chunk of the body is written.
+//
+// The body is fully written and closed by the time CallStreamingTo returns.
+// The returned response's Body must not be used.
+func CallStreamingTo(b Backend, method, path, key string, params ParamsContainer, w io.Writer, progress ProgressFunc) (*StreamingAPIResponse, error) {
+	stream := &APIStream{}
+	if err := b.CallStreaming(method, path, key, params, stream); err != nil {
+		return nil, err
+	}
+
+	_, err := stream.writeTo(w, progress)
+	return stream.LastResponse, err
+}
+
+//
+// Private types
+//
+
+// progressReader is an io.Reader that reports the bytes read from it to a
+// ProgressFunc.
+type progressReader struct {
+	r           io.Reader
+	progress    ProgressFunc
+	total       int64
+	transferred int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.transferred += int64(n)
+		p.progress(p.transferred, p.total)
+	}
+	return n, err
+}
+
+// progressWriter is an io.Writer that reports the bytes written to it to a
+// ProgressFunc.
+type progressWriter struct {
+	w           io.Writer
+	progress    ProgressFunc
+	total       int64
+	transferred int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.transferred += int64(n)
+	if p.progress != nil {
+		p.progress(p.transferred, p.total)
+	}
+	return n, err
+}
+
+type uploadProgressKey struct{}
+
+//
+// Private functions
+//
+
+func uploadProgressFromContext(ctx context.Context) ProgressFunc {
+	progress, _ := ctx.Value(uploadProgressKey{}).(ProgressFunc)
+	return progress
+}
+
+func (r *APIStream) writeTo(w io.Writer, progress ProgressFunc) (int64, error) {
+	body := r.LastResponse.Body
+	defer body.Close()
+
+	total := int64(-1)
+	if length := r.LastResponse.Header.Get("Content-Length"); length != "" {
+		if n, err := strconv.ParseInt(length, 10, 64); err == nil {
+			total = n
+		}
+	}
+
+	return io.Copy(&progressWriter{w: w, progress: progress, total: total}, body)
+}
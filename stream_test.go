@@ -0,0 +1,103 @@
+package stripe
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestCallStreamingTo(t *testing.T) {
+	data := strings.Repeat("pdf", 10000)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/quotes/qt_123/pdf", r.URL.Path)
+		w.Header().Set("Content-Length", "30000")
+		w.Header().Set("Request-Id", "req_123")
+		w.Write([]byte(data))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	)
+
+	var buf bytes.Buffer
+	var lastTransferred, lastTotal int64
+	res, err := CallStreamingTo(backend, http.MethodGet, "/v1/quotes/qt_123/pdf", "sk_test_123", nil, &buf,
+		func(transferred, total int64) {
+			assert.True(t, transferred > lastTransferred)
+			lastTransferred, lastTotal = transferred, total
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, data, buf.String())
+	assert.Equal(t, "req_123", res.RequestID)
+	assert.Equal(t, int64(30000), lastTransferred)
+	assert.Equal(t, int64(30000), lastTotal)
+}
+
+func TestCallStreamingTo_Error(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"message":"No such quote"}}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	)
+
+	var buf bytes.Buffer
+	_, err := CallStreamingTo(backend, http.MethodGet, "/v1/quotes/qt_123/pdf", "sk_test_123", nil, &buf, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 0, buf.Len())
+}
+
+func TestContextWithUploadProgress(t *testing.T) {
+	data := strings.Repeat("evidence", 10000)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, data, string(body))
+		w.Write([]byte(`{}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		UploadsBackend,
+		&BackendConfig{
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	)
+
+	var lastTransferred, lastTotal int64
+	params := &Params{
+		Context: ContextWithUploadProgress(context.Background(), func(transferred, total int64) {
+			assert.True(t, transferred > lastTransferred)
+			lastTransferred, lastTotal = transferred, total
+		}),
+	}
+
+	var file File
+	err := backend.CallMultipart(http.MethodPost, "/v1/files", "sk_test_123", "boundary",
+		bytes.NewBufferString(data), params, &file)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(data)), lastTransferred)
+	assert.Equal(t, int64(len(data)), lastTotal)
+}
@@ -11,7 +11,6 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"math/rand"
 	"net/http"
 	"net/url"
 	"os/exec"
@@ -75,6 +74,10 @@ const (
 var EnableTelemetry = true
 
 // Key is the Stripe API key used globally in the binding.
+//
+// Assigning Key while requests are being made is a data race. Use SetKey
+// instead when the key may change at runtime, like in tests that run in
+// parallel.
 var Key string
 
 //
@@ -93,6 +96,11 @@ type APIResponse struct {
 	// requests that fail and need to be retried are not duplicated.
 	IdempotencyKey string
 
+	// IdempotentReplayed is true if the response is a replay of the response
+	// to an earlier request made with the same idempotency key, rather than
+	// the result of executing the request again.
+	IdempotentReplayed bool
+
 	// RawJSON contains the response body as raw bytes.
 	RawJSON []byte
 
@@ -123,12 +131,13 @@ type StreamingAPIResponse struct {
 
 func newAPIResponse(res *http.Response, resBody []byte) *APIResponse {
 	return &APIResponse{
-		Header:         res.Header,
-		IdempotencyKey: res.Header.Get("Idempotency-Key"),
-		RawJSON:        resBody,
-		RequestID:      res.Header.Get("Request-Id"),
-		Status:         res.Status,
-		StatusCode:     res.StatusCode,
+		Header:             res.Header,
+		IdempotencyKey:     res.Header.Get("Idempotency-Key"),
+		IdempotentReplayed: res.Header.Get("Idempotent-Replayed") == "true",
+		RawJSON:            resBody,
+		RequestID:          res.Header.Get("Request-Id"),
+		Status:             res.Status,
+		StatusCode:         res.StatusCode,
 	}
 }
 
@@ -200,6 +209,34 @@ type Backend interface {
 
 // BackendConfig is used to configure a new Stripe backend.
 type BackendConfig struct {
+	// APIVersion is the API version sent in the `Stripe-Version` header of
+	// the backend's requests. It can be overridden for a single request with
+	// Params.StripeVersion.
+	//
+	// This value is a pointer to allow us to differentiate an unset versus
+	// empty value. Use stripe.String for an easy way to set this value.
+	//
+	// Defaults to APIVersion, the version the library's types are built
+	// for.
+	APIVersion *string
+
+	// AppInfo identifies the plugin or platform the backend's requests are
+	// made for, in their `User-Agent` and `X-Stripe-Client-User-Agent`
+	// headers. It overrides the app info set with SetAppInfo, so that several
+	// integrations built on the library can identify themselves separately
+	// from the same program.
+	//
+	// Defaults to the app info set with SetAppInfo, if any.
+	AppInfo *AppInfo
+
+	// Backoff determines how long to wait before retrying a request that
+	// failed. DecorrelatedJitterBackoff, ExponentialBackoff and FixedBackoff
+	// are provided, but any implementation of Backoff can be used.
+	//
+	// If left unset, the delay grows from 500 milliseconds up to 5 seconds,
+	// with jitter.
+	Backoff Backoff
+
 	// EnableTelemetry allows request metrics (request id and duration) to be sent
 	// to Stripe in subsequent requests via the `X-Stripe-Client-Telemetry` header.
 	//
@@ -209,11 +246,34 @@ type BackendConfig struct {
 	// Defaults to false.
 	EnableTelemetry *bool
 
+	// EnableRequestTiming enables collecting a breakdown of the time spent on
+	// each request, like DNS lookups, TLS handshakes and waiting for Stripe to
+	// respond, which is reported in RequestMetrics.Timing. It has no effect
+	// unless RequestMetricsHook is set.
+	//
+	// This value is a pointer to allow us to differentiate an unset versus
+	// empty value. Use stripe.Bool for an easy way to set this value.
+	//
+	// Defaults to false.
+	EnableRequestTiming *bool
+
+	// AttemptTimeout limits the time taken by each attempt at a request,
+	// including reading its response. An attempt that times out is retried
+	// like one that failed because of a network error.
+	//
+	// Defaults to 0, which leaves attempts limited by HTTPClient's own
+	// timeout only, which is 80 seconds for the default client.
+	AttemptTimeout time.Duration
+
 	// HTTPClient is an HTTP client instance to use when making API requests.
 	//
 	// If left unset, it'll be set to a default HTTP client for the package.
 	HTTPClient *http.Client
 
+	// Interceptors wrap every request sent by the backend, in order, with the
+	// first one being the outermost. See RequestInterceptor for details.
+	Interceptors []RequestInterceptor
+
 	// LeveledLogger is the logger that the backend will use to log errors,
 	// warnings, and informational messages.
 	//
@@ -240,6 +300,44 @@ type BackendConfig struct {
 	// Defaults to DefaultMaxNetworkRetries (2).
 	MaxNetworkRetries *int64
 
+	// RateLimiter, if set, throttles the backend's requests to stay under
+	// Stripe's rate limits. See RateLimiter for details.
+	RateLimiter *RateLimiter
+
+	// RequestMetricsHook, if set, is called with metrics about every request
+	// made by the backend once it has completed. It's called synchronously,
+	// so it should return quickly.
+	RequestMetricsHook func(metrics *RequestMetrics)
+
+	// StripeAccount is the connected account that the backend's requests are
+	// made on behalf of, by way of the `Stripe-Account` header, unless a
+	// request's Params.StripeAccount is set. It makes it possible to build
+	// clients scoped to a connected account:
+	//
+	//	c := customer.Client{
+	//		B: stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{
+	//			StripeAccount: stripe.String("acct_123"),
+	//		}),
+	//		Key: "sk_test_123",
+	//	}
+	//
+	// This value is a pointer to allow us to differentiate an unset versus
+	// empty value. Use stripe.String for an easy way to set this value.
+	//
+	// Defaults to no account, which makes requests on the platform's own
+	// account.
+	StripeAccount *string
+
+	// Timeout limits the overall time taken by a request, including all of
+	// its retries and the time waited between them. A request isn't retried
+	// if waiting for the retry would exceed it. Since each backend is
+	// configured separately, the uploads backend can be given a longer
+	// timeout than the API backend. A single request can be given a shorter
+	// deadline with Params.Context.
+	//
+	// Defaults to 0, which doesn't limit the overall time.
+	Timeout time.Duration
+
 	// URL is the base URL to use for API paths.
 	//
 	// This value is a pointer to allow us to differentiate an unset versus
@@ -261,7 +359,12 @@ type BackendImplementation struct {
 	LeveledLogger     LeveledLoggerInterface
 	MaxNetworkRetries int64
 
-	enableTelemetry bool
+	apiVersion          string
+	attemptTimeout      time.Duration
+	backoff             Backoff
+	enableRequestTiming bool
+	enableTelemetry     bool
+	interceptors        []RequestInterceptor
 
 	// networkRetriesSleep indicates whether the backend should use the normal
 	// sleep between retries.
@@ -269,7 +372,17 @@ type BackendImplementation struct {
 	// See also SetNetworkRetriesSleep.
 	networkRetriesSleep bool
 
+	rateLimiter          *RateLimiter
 	requestMetricsBuffer chan requestMetrics
+	requestMetricsHook   func(metrics *RequestMetrics)
+	stripeAccount        string
+	timeout              time.Duration
+
+	// userAgent and stripeUserAgent are set when the backend is configured
+	// with its own app info, and override encodedUserAgent and
+	// encodedStripeUserAgent.
+	stripeUserAgent string
+	userAgent       string
 }
 
 func extractParams(params ParamsContainer) (*form.Values, *Params) {
@@ -347,21 +460,97 @@ func (s *BackendImplementation) CallMultipart(method, path, key, boundary string
 	return nil
 }
 
-// CallRaw is the implementation for invoking Stripe APIs internally without a backend.
-func (s *BackendImplementation) CallRaw(method, path, key string, form *form.Values, params *Params, v LastResponseSetter) error {
-	var body string
-	if form != nil && !form.Empty() {
-		body = form.Encode()
+// CallMultipartReader is like CallMultipart, but streams the body from a
+// reader instead of holding it all in memory, which is preferable for large
+// uploads. Before every attempt, including retries, the body is seeked back
+// to its position when CallMultipartReader was called so that a transient
+// failure midway through an upload doesn't lose data. size is the number of
+// bytes to be read from the body.
+func (s *BackendImplementation) CallMultipartReader(method, path, key, boundary string, body io.ReadSeeker, size int64, params *Params, v LastResponseSetter) error {
+	contentType := "multipart/form-data; boundary=" + boundary
 
-		// On `GET`, move the payload into the URL
-		if method == http.MethodGet {
-			path += "?" + body
-			body = ""
+	req, err := s.NewRequest(method, path, key, contentType, params)
+	if err != nil {
+		return err
+	}
+
+	start, err := body.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+
+	progress := uploadProgressFromContext(req.Context())
+	resetBody := func(req *http.Request) error {
+		if _, err := body.Seek(start, io.SeekStart); err != nil {
+			return err
 		}
+
+		var reader io.Reader = io.LimitReader(body, size)
+		if progress != nil {
+			reader = &progressReader{r: reader, progress: progress, total: size}
+		}
+		req.Body = ioutil.NopCloser(reader)
+		req.ContentLength = size
+		return nil
 	}
-	bodyBuffer := bytes.NewBufferString(body)
 
-	req, err := s.NewRequest(method, path, key, "application/x-www-form-urlencoded", params)
+	if _, err := s.doFunc(req, resetBody, v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CallMultipartStream is like CallMultipartReader, but streams the body from
+// a reader that can only be read once. The body is sent with a Content-Length
+// header if its size is known, or with chunked transfer encoding if size is
+// -1. The request is never retried since the body can't be sent again. If
+// body is an io.Closer, it's closed once the request has been sent.
+func (s *BackendImplementation) CallMultipartStream(method, path, key, boundary string, body io.Reader, size int64, params *Params, v LastResponseSetter) error {
+	contentType := "multipart/form-data; boundary=" + boundary
+
+	req, err := s.NewRequest(method, path, key, contentType, params)
+	if err != nil {
+		return err
+	}
+
+	noRetries := int64(0)
+	req = req.WithContext(context.WithValue(req.Context(), retryPolicyKey{}, &retryPolicy{
+		maxNetworkRetries: &noRetries,
+	}))
+
+	progress := uploadProgressFromContext(req.Context())
+	sent := false
+	resetBody := func(req *http.Request) error {
+		if sent {
+			return errors.New("a streamed request body can't be sent again")
+		}
+		sent = true
+
+		reader := body
+		if progress != nil {
+			reader = &progressReader{r: reader, progress: progress, total: size}
+		}
+		if closer, ok := body.(io.Closer); ok {
+			req.Body = readCloser{Reader: reader, Closer: closer}
+		} else {
+			req.Body = ioutil.NopCloser(reader)
+		}
+		// A negative length makes the request use chunked transfer encoding
+		req.ContentLength = size
+		return nil
+	}
+
+	if _, err := s.doFunc(req, resetBody, v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CallRaw is the implementation for invoking Stripe APIs internally without a backend.
+func (s *BackendImplementation) CallRaw(method, path, key string, form *form.Values, params *Params, v LastResponseSetter) error {
+	req, bodyBuffer, err := s.newFormRequest(method, path, key, form, params)
 	if err != nil {
 		return err
 	}
@@ -373,6 +562,22 @@ func (s *BackendImplementation) CallRaw(method, path, key string, form *form.Val
 	return nil
 }
 
+// CallWithResponse works like Call, but also returns the raw *http.Response,
+// for advanced uses like inspecting headers or trailers that aren't surfaced
+// elsewhere. Unlike v's LastResponse, the response is returned for requests
+// that the API rejected too, as long as one was received. Its body has
+// already been read and closed.
+func (s *BackendImplementation) CallWithResponse(method, path, key string, params ParamsContainer, v LastResponseSetter) (*http.Response, error) {
+	formValues, commonParams := extractParams(params)
+
+	req, bodyBuffer, err := s.newFormRequest(method, path, key, formValues, commonParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.do(req, bodyBuffer, v)
+}
+
 // NewRequest is used by Call to generate an http.Request. It handles encoding
 // parameters and attaching the appropriate headers.
 func (s *BackendImplementation) NewRequest(method, path, key, contentType string, params *Params) (*http.Request, error) {
@@ -393,9 +598,20 @@ func (s *BackendImplementation) NewRequest(method, path, key, contentType string
 
 	req.Header.Add("Authorization", authorization)
 	req.Header.Add("Content-Type", contentType)
-	req.Header.Add("Stripe-Version", APIVersion)
-	req.Header.Add("User-Agent", encodedUserAgent)
-	req.Header.Add("X-Stripe-Client-User-Agent", encodedStripeUserAgent)
+	req.Header.Add("Stripe-Version", s.stripeVersion(params))
+	userAgent, stripeUserAgent := s.userAgent, s.stripeUserAgent
+	if userAgent == "" {
+		globalsMu.RLock()
+		userAgent, stripeUserAgent = encodedUserAgent, encodedStripeUserAgent
+		globalsMu.RUnlock()
+	}
+	req.Header.Add("User-Agent", userAgent)
+	req.Header.Add("X-Stripe-Client-User-Agent", stripeUserAgent)
+
+	// Params.StripeAccount overrides this below
+	if s.stripeAccount != "" {
+		req.Header.Set("Stripe-Account", s.stripeAccount)
+	}
 
 	if params != nil {
 		if params.Context != nil {
@@ -414,7 +630,14 @@ func (s *BackendImplementation) NewRequest(method, path, key, contentType string
 		}
 
 		if params.StripeAccount != nil {
-			req.Header.Add("Stripe-Account", strings.TrimSpace(*params.StripeAccount))
+			req.Header.Set("Stripe-Account", strings.TrimSpace(*params.StripeAccount))
+		}
+
+		if params.MaxNetworkRetries != nil || len(params.RetryStatusCodes) > 0 {
+			req = req.WithContext(context.WithValue(req.Context(), retryPolicyKey{}, &retryPolicy{
+				maxNetworkRetries: params.MaxNetworkRetries,
+				statusCodes:       params.RetryStatusCodes,
+			}))
 		}
 
 		for k, v := range params.Headers {
@@ -428,6 +651,40 @@ func (s *BackendImplementation) NewRequest(method, path, key, contentType string
 	return req, nil
 }
 
+// stripeVersion returns the API version to send with a request made with
+// params, which may be nil.
+func (s *BackendImplementation) stripeVersion(params *Params) string {
+	if params != nil && params.StripeVersion != nil {
+		return strings.TrimSpace(*params.StripeVersion)
+	}
+	if s.apiVersion != "" {
+		return s.apiVersion
+	}
+	return APIVersion
+}
+
+// newFormRequest builds a request whose parameters are form encoded, either
+// into the body or, for `GET`, into the URL.
+func (s *BackendImplementation) newFormRequest(method, path, key string, form *form.Values, params *Params) (*http.Request, *bytes.Buffer, error) {
+	var body string
+	if form != nil && !form.Empty() {
+		body = form.Encode()
+
+		// On `GET`, move the payload into the URL
+		if method == http.MethodGet {
+			path += "?" + body
+			body = ""
+		}
+	}
+
+	req, err := s.NewRequest(method, path, key, "application/x-www-form-urlencoded", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return req, bytes.NewBufferString(body), nil
+}
+
 func (s *BackendImplementation) maybeSetTelemetryHeader(req *http.Request) {
 	if s.enableTelemetry {
 		select {
@@ -492,7 +749,15 @@ func resetBodyReader(body *bytes.Buffer, req *http.Request) {
 		// the beginning.
 		reader := bytes.NewReader(body.Bytes())
 
-		req.Body = nopReadCloser{reader}
+		if progress := uploadProgressFromContext(req.Context()); progress != nil {
+			req.Body = nopReadCloser{&progressReader{
+				r:        reader,
+				progress: progress,
+				total:    int64(body.Len()),
+			}}
+		} else {
+			req.Body = nopReadCloser{reader}
+		}
 
 		// And also add the same thing to `Request.GetBody`, which allows
 		// `net/http` to get a new body in cases like a redirect. This is
@@ -519,24 +784,83 @@ func (s *BackendImplementation) requestWithRetriesAndTelemetry(
 	req *http.Request,
 	body *bytes.Buffer,
 	handleResponse func(*http.Response, error) (interface{}, error),
+) (*http.Response, interface{}, error) {
+	resetBody := func(req *http.Request) error {
+		resetBodyReader(body, req)
+		return nil
+	}
+	return s.requestWithRetriesAndTelemetryFunc(req, resetBody, handleResponse)
+}
+
+// requestWithRetriesAndTelemetryFunc is like requestWithRetriesAndTelemetry,
+// but calls resetBody before every attempt to put a fresh body onto the
+// request.
+func (s *BackendImplementation) requestWithRetriesAndTelemetryFunc(
+	req *http.Request,
+	resetBody func(*http.Request) error,
+	handleResponse func(*http.Response, error) (interface{}, error),
 ) (*http.Response, interface{}, error) {
 	s.LeveledLogger.Infof("Requesting %v %v%v", req.Method, req.URL.Host, req.URL.Path)
 	s.maybeSetTelemetryHeader(req)
 	var resp *http.Response
 	var err error
-	var requestDuration time.Duration
+	var requestDuration, attemptDuration time.Duration
+	var timing *RequestTiming
 	var result interface{}
-	for retry := 0; ; {
+	var retry int
+
+	// The overall timeout covers all attempts at the request, and the time
+	// spent waiting between them
+	var deadline time.Time
+	if s.timeout > 0 {
+		deadline = time.Now().Add(s.timeout)
+	}
+
+	for {
+		// Waiting for the rate limiter isn't counted in the request's
+		// duration
+		release := func() {}
+		if s.rateLimiter != nil {
+			if release, err = s.rateLimiter.acquire(req.Context()); err != nil {
+				return nil, nil, err
+			}
+		}
+
 		start := time.Now()
-		resetBodyReader(body, req)
+		if err = resetBody(req); err != nil {
+			release()
+			return nil, nil, err
+		}
 
-		resp, err = s.HTTPClient.Do(req)
+		attemptReq, cancel := s.withAttemptTimeout(req, deadline)
+		var timer *requestTimer
+		if s.enableRequestTiming && s.requestMetricsHook != nil {
+			timer = newRequestTimer()
+			attemptReq = attemptReq.WithContext(timer.trace(attemptReq.Context()))
+		}
+
+		if len(s.interceptors) > 0 && retry > 0 {
+			attemptReq = attemptReq.WithContext(context.WithValue(attemptReq.Context(), requestRetriesKey{}, retry))
+		}
+
+		resp, err = s.sendRequest(attemptReq)
+		releaseAttempt(resp, cancel)
 
 		requestDuration = time.Since(start)
 		s.LeveledLogger.Infof("Request completed in %v (retry: %v)", requestDuration, retry)
 
 		result, err = handleResponse(resp, err)
 
+		release()
+		if s.rateLimiter != nil {
+			s.rateLimiter.observe(resp)
+		}
+
+		attemptDuration = time.Since(start)
+		if timer != nil {
+			timing = timer.result(attemptDuration - requestDuration)
+		}
+
 		// If the response was okay, or an error that shouldn't be retried,
 		// we're done, and it's safe to leave the retry loop.
 		shouldRetry, noRetryReason := s.shouldRetry(err, req, resp, retry)
@@ -546,7 +870,11 @@ func (s *BackendImplementation) requestWithRetriesAndTelemetry(
 			break
 		}
 
-		sleepDuration := s.sleepTime(retry)
+		sleepDuration := s.sleepTime(retry, err, resp)
+		if !deadline.IsZero() && time.Now().Add(sleepDuration).After(deadline) {
+			s.LeveledLogger.Infof("Not retrying request: timeout would be exceeded")
+			break
+		}
 		retry++
 
 		s.LeveledLogger.Warnf("Initiating retry %v for request %v %v%v after sleeping %v",
@@ -556,9 +884,10 @@ func (s *BackendImplementation) requestWithRetriesAndTelemetry(
 	}
 
 	s.maybeEnqueueTelemetryMetrics(resp, requestDuration)
+	s.maybeCallRequestMetricsHook(req, resp, attemptDuration, timing, retry)
 
 	if err != nil {
-		return nil, nil, err
+		return resp, nil, err
 	}
 
 	return resp, result, nil
@@ -635,6 +964,19 @@ func (s *BackendImplementation) DoStreaming(req *http.Request, body *bytes.Buffe
 // the backend's HTTP client to execute the request and unmarshals the response
 // into v. It also handles unmarshaling errors returned by the API.
 func (s *BackendImplementation) Do(req *http.Request, body *bytes.Buffer, v LastResponseSetter) error {
+	_, err := s.do(req, body, v)
+	return err
+}
+
+func (s *BackendImplementation) do(req *http.Request, body *bytes.Buffer, v LastResponseSetter) (*http.Response, error) {
+	resetBody := func(req *http.Request) error {
+		resetBodyReader(body, req)
+		return nil
+	}
+	return s.doFunc(req, resetBody, v)
+}
+
+func (s *BackendImplementation) doFunc(req *http.Request, resetBody func(*http.Request) error, v LastResponseSetter) (*http.Response, error) {
 	handleResponse := func(res *http.Response, err error) (interface{}, error) {
 		var resBody []byte
 		if err == nil {
@@ -653,15 +995,19 @@ func (s *BackendImplementation) Do(req *http.Request, body *bytes.Buffer, v Last
 		return resBody, err
 	}
 
-	res, result, err := s.requestWithRetriesAndTelemetry(req, body, handleResponse)
+	res, result, err := s.requestWithRetriesAndTelemetryFunc(req, resetBody, handleResponse)
 	if err != nil {
-		return err
+		return res, err
 	}
 	resBody := result.([]byte)
-	s.LeveledLogger.Debugf("Response: %s", string(resBody))
+	if sensitive, _ := req.Context().Value(sensitiveResponseKey{}).(bool); sensitive {
+		s.LeveledLogger.Debugf("Response: [REDACTED]")
+	} else {
+		s.LeveledLogger.Debugf("Response: %s", string(resBody))
+	}
 	err = s.UnmarshalJSONVerbose(res.StatusCode, resBody, v)
 	v.SetLastResponse(newAPIResponse(res, resBody))
-	return err
+	return res, err
 }
 
 // ResponseToError converts a stripe response to an Error.
@@ -740,6 +1086,13 @@ func (s *BackendImplementation) SetNetworkRetriesSleep(sleep bool) {
 	s.networkRetriesSleep = sleep
 }
 
+// StripeAccount returns the connected account that the backend's requests are
+// made on behalf of, as set with BackendConfig.StripeAccount, or an empty
+// string if there's none.
+func (s *BackendImplementation) StripeAccount() string {
+	return s.stripeAccount
+}
+
 // UnmarshalJSONVerbose unmarshals JSON, but in case of a failure logs and
 // produces a more descriptive error.
 func (s *BackendImplementation) UnmarshalJSONVerbose(statusCode int, body []byte, v interface{}) error {
@@ -782,7 +1135,14 @@ var (
 // second string parameter is also returned with a short message indicating why
 // no retry should occur. This can be used for logging/informational purposes.
 func (s *BackendImplementation) shouldRetry(err error, req *http.Request, resp *http.Response, numRetries int) (bool, string) {
-	if numRetries >= int(s.MaxNetworkRetries) {
+	// Params may override the retry behavior of a single request.
+	policy, _ := req.Context().Value(retryPolicyKey{}).(*retryPolicy)
+
+	maxNetworkRetries := s.MaxNetworkRetries
+	if policy != nil && policy.maxNetworkRetries != nil {
+		maxNetworkRetries = *policy.maxNetworkRetries
+	}
+	if numRetries >= int(maxNetworkRetries) {
 		return false, "max retries exceeded"
 	}
 
@@ -839,6 +1199,14 @@ func (s *BackendImplementation) shouldRetry(err error, req *http.Request, resp *
 		return true, ""
 	}
 
+	if policy != nil {
+		for _, code := range policy.statusCodes {
+			if resp.StatusCode == code {
+				return true, ""
+			}
+		}
+	}
+
 	// 409 Conflict
 	if resp.StatusCode == http.StatusConflict {
 		return true, ""
@@ -874,32 +1242,20 @@ func (s *BackendImplementation) shouldRetry(err error, req *http.Request, resp *
 	return false, "response not known to be safe for retry"
 }
 
-// sleepTime calculates sleeping/delay time in milliseconds between failure and a new one request.
-func (s *BackendImplementation) sleepTime(numRetries int) time.Duration {
+// sleepTime calculates sleeping/delay time between failure and a new one
+// request, given the number of retries made so far and the outcome of the
+// last attempt.
+func (s *BackendImplementation) sleepTime(numRetries int, err error, resp *http.Response) time.Duration {
 	// We disable sleeping in some cases for tests.
 	if !s.networkRetriesSleep {
 		return 0 * time.Second
 	}
 
-	// Apply exponential backoff with minNetworkRetriesDelay on the
-	// number of num_retries so far as inputs.
-	delay := minNetworkRetriesDelay + minNetworkRetriesDelay*time.Duration(numRetries*numRetries)
-
-	// Do not allow the number to exceed maxNetworkRetriesDelay.
-	if delay > maxNetworkRetriesDelay {
-		delay = maxNetworkRetriesDelay
+	backoff := s.backoff
+	if backoff == nil {
+		backoff = defaultBackoff{}
 	}
-
-	// Apply some jitter by randomizing the value in the range of 75%-100%.
-	jitter := rand.Int63n(int64(delay / 4))
-	delay -= time.Duration(jitter)
-
-	// But never sleep less than the base sleep seconds.
-	if delay < minNetworkRetriesDelay {
-		delay = minNetworkRetriesDelay
-	}
-
-	return delay
+	return backoff.NextDelay(numRetries+1, err, resp)
 }
 
 // Backends are the currently supported endpoints.
@@ -908,6 +1264,26 @@ type Backends struct {
 	mu                    sync.RWMutex
 }
 
+// BackendsConfig configures each of the backends created by
+// NewBackendsWithConfig and SetBackendsWithConfig separately, so that one class
+// of traffic can, for example, be sent through a recording proxy with its own
+// URL and HTTP client while the others go straight to Stripe.
+//
+// A nil BackendConfig gives its backend the same defaults as GetBackend.
+type BackendsConfig struct {
+	// API configures the backend for most API requests, sent to APIURL by
+	// default.
+	API *BackendConfig
+
+	// Connect configures the backend for Connect OAuth requests, sent to
+	// ConnectURL by default.
+	Connect *BackendConfig
+
+	// Uploads configures the backend for file uploads and downloads, sent to
+	// UploadsURL by default.
+	Uploads *BackendConfig
+}
+
 // LastResponseSetter defines a type that contains an HTTP response from a Stripe
 // API endpoint.
 type LastResponseSetter interface {
@@ -985,6 +1361,9 @@ func Float64Slice(v []float64) []*float64 {
 // It also URL-escapes every given parameter. This usually isn't necessary for
 // a standard Stripe ID, but is needed in places where user-provided IDs are
 // allowed, like in coupons or plans. We apply it broadly for extra safety.
+//
+// The format of the path is remembered so that PathTemplate can replace its
+// parameters in metrics, even when they don't look like IDs.
 func FormatURLPath(format string, params ...string) string {
 	// Convert parameters to interface{} and URL-escape them
 	untypedParams := make([]interface{}, len(params))
@@ -992,7 +1371,9 @@ func FormatURLPath(format string, params ...string) string {
 		untypedParams[i] = interface{}(url.QueryEscape(param))
 	}
 
-	return fmt.Sprintf(format, untypedParams...)
+	path := fmt.Sprintf(format, untypedParams...)
+	pathTemplates.record(format, path)
+	return path
 }
 
 // GetBackend returns one of the library's supported backends based off of the
@@ -1019,7 +1400,7 @@ func GetBackend(backendType SupportedBackend) Backend {
 	backend = GetBackendWithConfig(
 		backendType,
 		&BackendConfig{
-			HTTPClient:        httpClient,
+			HTTPClient:        getHTTPClient(),
 			LeveledLogger:     nil, // Set by GetBackendWithConfiguation when nil
 			MaxNetworkRetries: nil, // Set by GetBackendWithConfiguation when nil
 			URL:               nil, // Set by GetBackendWithConfiguation when nil
@@ -1036,11 +1417,11 @@ func GetBackend(backendType SupportedBackend) Backend {
 // that's return.
 func GetBackendWithConfig(backendType SupportedBackend, config *BackendConfig) Backend {
 	if config.HTTPClient == nil {
-		config.HTTPClient = httpClient
+		config.HTTPClient = getHTTPClient()
 	}
 
 	if config.LeveledLogger == nil {
-		config.LeveledLogger = DefaultLeveledLogger
+		config.LeveledLogger = GetDefaultLeveledLogger()
 	}
 
 	if config.MaxNetworkRetries == nil {
@@ -1079,6 +1460,27 @@ func GetBackendWithConfig(backendType SupportedBackend, config *BackendConfig) B
 	return nil
 }
 
+// GetDefaultLeveledLogger returns DefaultLeveledLogger, the logger used by
+// backends that aren't configured with their own. It's safe to call
+// concurrently with SetDefaultLeveledLogger.
+func GetDefaultLeveledLogger() LeveledLoggerInterface {
+	globalsMu.RLock()
+	defer globalsMu.RUnlock()
+	return DefaultLeveledLogger
+}
+
+// GetKey returns the API key used globally in the binding, which is the one
+// last set with SetKey, or Key if SetKey was never called. It's safe to call
+// concurrently with SetKey.
+func GetKey() string {
+	globalsMu.RLock()
+	defer globalsMu.RUnlock()
+	if globalKeySet {
+		return globalKey
+	}
+	return Key
+}
+
 // Int64 returns a pointer to the int64 value passed in.
 func Int64(v int64) *int64 {
 	return &v
@@ -1115,6 +1517,20 @@ func NewBackends(httpClient *http.Client) *Backends {
 	}
 }
 
+// NewBackendsWithConfig creates a new set of backends, each configured with
+// its own BackendConfig from config. It's like NewBackends, but allows the
+// backends to have different URLs, HTTP clients and other settings.
+func NewBackendsWithConfig(config *BackendsConfig) *Backends {
+	if config == nil {
+		config = &BackendsConfig{}
+	}
+	return &Backends{
+		API:     GetBackendWithConfig(APIBackend, backendConfigOrDefault(config.API)),
+		Connect: GetBackendWithConfig(ConnectBackend, backendConfigOrDefault(config.Connect)),
+		Uploads: GetBackendWithConfig(UploadsBackend, backendConfigOrDefault(config.Uploads)),
+	}
+}
+
 // ParseID attempts to parse a string scalar from a given JSON value which is
 // still encoded as []byte. If the value was a string, it returns the string
 // along with true as the second return value. If not, false is returned as the
@@ -1142,10 +1558,13 @@ func ParseID(data []byte) (string, bool) {
 }
 
 // SetAppInfo sets app information. See AppInfo.
+//
+// It's safe to call while requests are being made.
 func SetAppInfo(info *AppInfo) {
-	if info != nil && info.Name == "" {
-		panic(fmt.Errorf("App info name cannot be empty"))
-	}
+	validateAppInfo(info)
+
+	globalsMu.Lock()
+	defer globalsMu.Unlock()
 	appInfo = info
 
 	// This is run in init, but we need to reinitialize it now that we have
@@ -1168,13 +1587,48 @@ func SetBackend(backend SupportedBackend, b Backend) {
 	}
 }
 
+// SetBackendsWithConfig creates backends with NewBackendsWithConfig and sets
+// them all as the ones used in the binding at once.
+func SetBackendsWithConfig(config *BackendsConfig) {
+	b := NewBackendsWithConfig(config)
+
+	backends.mu.Lock()
+	defer backends.mu.Unlock()
+
+	backends.API = b.API
+	backends.Connect = b.Connect
+	backends.Uploads = b.Uploads
+}
+
+// SetDefaultLeveledLogger sets the logger used by backends that aren't
+// configured with their own. Unlike assigning DefaultLeveledLogger, it's
+// safe to call while backends are being created.
+func SetDefaultLeveledLogger(logger LeveledLoggerInterface) {
+	globalsMu.Lock()
+	defer globalsMu.Unlock()
+	DefaultLeveledLogger = logger
+}
+
 // SetHTTPClient overrides the default HTTP client.
 // This is useful if you're running in a Google AppEngine environment
 // where the http.DefaultClient is not available.
 func SetHTTPClient(client *http.Client) {
+	httpClientMu.Lock()
+	defer httpClientMu.Unlock()
 	httpClient = client
 }
 
+// SetKey sets the API key used globally in the binding. Unlike assigning Key,
+// it's safe to call while requests are being made. Once it's been called,
+// Key is ignored, so a program should either assign Key once at startup or
+// only use SetKey.
+func SetKey(key string) {
+	globalsMu.Lock()
+	defer globalsMu.Unlock()
+	globalKey = key
+	globalKeySet = true
+}
+
 // String returns a pointer to the string value passed in.
 func String(v string) *string {
 	return &v
@@ -1198,6 +1652,13 @@ func StringSlice(v []string) []*string {
 	return out
 }
 
+// WithSensitiveResponse returns a copy of ctx that marks requests made with it
+// as returning sensitive data, like the full number of an issued card. The
+// bodies of their responses are never logged, even at LevelDebug.
+func WithSensitiveResponse(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sensitiveResponseKey{}, true)
+}
+
 //
 // Private constants
 //
@@ -1233,6 +1694,23 @@ type nopReadCloser struct {
 
 func (nopReadCloser) Close() error { return nil }
 
+// readCloser combines a reader with the closer of the reader it wraps.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// retryPolicy holds the retry settings of Params that override those of the
+// backend for a single request. It's carried by the request's context.
+type retryPolicy struct {
+	maxNetworkRetries *int64
+	statusCodes       []int
+}
+
+type retryPolicyKey struct{}
+
+type sensitiveResponseKey struct{}
+
 // stripeClientUserAgent contains information about the current runtime which
 // is serialized and sent in the `X-Stripe-Client-User-Agent` as additional
 // debugging information.
@@ -1266,6 +1744,18 @@ var backends Backends
 var encodedStripeUserAgent string
 var encodedUserAgent string
 
+// globalKey is the API key set with SetKey, if globalKeySet is true.
+var globalKey string
+var globalKeySet bool
+
+// globalsMu guards the global configuration that can be changed while
+// requests are being made: appInfo, DefaultLeveledLogger, encodedUserAgent,
+// encodedStripeUserAgent, globalKey and globalKeySet.
+var globalsMu sync.RWMutex
+
+// httpClientMu guards httpClient.
+var httpClientMu sync.RWMutex
+
 // The default HTTP client used for communication with any of Stripe's
 // backends.
 //
@@ -1315,6 +1805,23 @@ var httpClient = &http.Client{
 // Private functions
 //
 
+// backendConfigOrDefault returns a copy of config, or an empty config if it's
+// nil. GetBackendWithConfig fills in the defaults of the config it's given, so
+// copying it leaves the caller's BackendsConfig untouched.
+func backendConfigOrDefault(config *BackendConfig) *BackendConfig {
+	if config == nil {
+		return &BackendConfig{}
+	}
+	c := *config
+	return &c
+}
+
+func getHTTPClient() *http.Client {
+	httpClientMu.RLock()
+	defer httpClientMu.RUnlock()
+	return httpClient
+}
+
 // getUname tries to get a uname from the system, but not that hard. It tries
 // to execute `uname -a`, but swallows any errors in case that didn't work
 // (i.e. non-Unix non-Mac system or some other reason).
@@ -1340,14 +1847,21 @@ func init() {
 	initUserAgent()
 }
 
+// initUserAgent must be called with globalsMu held, except from init.
 func initUserAgent() {
-	encodedUserAgent = "Stripe/v1 GoBindings/" + clientversion
-	if appInfo != nil {
-		encodedUserAgent += " " + appInfo.formatUserAgent()
+	encodedUserAgent, encodedStripeUserAgent = formatUserAgents(appInfo)
+}
+
+// formatUserAgents returns the `User-Agent` and `X-Stripe-Client-User-Agent`
+// headers of requests made for the given app, which may be nil.
+func formatUserAgents(info *AppInfo) (string, string) {
+	userAgent := "Stripe/v1 GoBindings/" + clientversion
+	if info != nil {
+		userAgent += " " + info.formatUserAgent()
 	}
 
 	stripeUserAgent := &stripeClientUserAgent{
-		Application:     appInfo,
+		Application:     info,
 		BindingsVersion: clientversion,
 		Language:        "go",
 		LanguageVersion: runtime.Version(),
@@ -1360,7 +1874,7 @@ func initUserAgent() {
 	if err != nil {
 		panic(err)
 	}
-	encodedStripeUserAgent = string(marshaled)
+	return userAgent, string(marshaled)
 }
 
 func isHTTPWriteMethod(method string) bool {
@@ -1385,15 +1899,39 @@ func newBackendImplementation(backendType SupportedBackend, config *BackendConfi
 		requestMetricsBuffer = make(chan requestMetrics, telemetryBufferSize)
 	}
 
+	var userAgent, stripeUserAgent string
+	if config.AppInfo != nil {
+		validateAppInfo(config.AppInfo)
+		userAgent, stripeUserAgent = formatUserAgents(config.AppInfo)
+	}
+
 	return &BackendImplementation{
 		HTTPClient:           config.HTTPClient,
 		LeveledLogger:        config.LeveledLogger,
 		MaxNetworkRetries:    *config.MaxNetworkRetries,
 		Type:                 backendType,
 		URL:                  *config.URL,
+		apiVersion:           StringValue(config.APIVersion),
+		attemptTimeout:       config.AttemptTimeout,
+		backoff:              config.Backoff,
+		enableRequestTiming:  BoolValue(config.EnableRequestTiming),
 		enableTelemetry:      enableTelemetry,
+		interceptors:         config.Interceptors,
 		networkRetriesSleep:  true,
+		rateLimiter:          config.RateLimiter,
 		requestMetricsBuffer: requestMetricsBuffer,
+		requestMetricsHook:   config.RequestMetricsHook,
+		stripeAccount:        strings.TrimSpace(StringValue(config.StripeAccount)),
+		stripeUserAgent:      stripeUserAgent,
+		timeout:              config.Timeout,
+		userAgent:            userAgent,
+	}
+}
+
+// validateAppInfo panics if info is set without a name.
+func validateAppInfo(info *AppInfo) {
+	if info != nil && info.Name == "" {
+		panic(fmt.Errorf("App info name cannot be empty"))
 	}
 }
 
@@ -333,6 +333,60 @@ func TestShouldRetry(t *testing.T) {
 		)
 		assert.True(t, shouldRetry)
 	})
+
+	// Params can override the maximum number of retries of a request
+	t.Run("RetryPolicyMaxNetworkRetries", func(t *testing.T) {
+		req, err := c.NewRequest(http.MethodGet, "/v1/charges", "sk_test_123", "application/x-www-form-urlencoded",
+			&Params{MaxNetworkRetries: Int64(0)})
+		assert.NoError(t, err)
+
+		shouldRetry, reason := c.shouldRetry(
+			nil,
+			req,
+			&http.Response{StatusCode: http.StatusServiceUnavailable},
+			0,
+		)
+		assert.False(t, shouldRetry)
+		assert.Equal(t, "max retries exceeded", reason)
+
+		req, err = c.NewRequest(http.MethodGet, "/v1/charges", "sk_test_123", "application/x-www-form-urlencoded",
+			&Params{MaxNetworkRetries: Int64(5)})
+		assert.NoError(t, err)
+
+		shouldRetry, _ = c.shouldRetry(
+			nil,
+			req,
+			&http.Response{StatusCode: http.StatusServiceUnavailable},
+			int(MaxNetworkRetries),
+		)
+		assert.True(t, shouldRetry)
+	})
+
+	// Params can make additional status codes retryable
+	t.Run("RetryPolicyStatusCodes", func(t *testing.T) {
+		req, err := c.NewRequest(http.MethodGet, "/v1/charges", "sk_test_123", "application/x-www-form-urlencoded",
+			&Params{RetryStatusCodes: []int{http.StatusTooManyRequests}})
+		assert.NoError(t, err)
+
+		shouldRetry, _ := c.shouldRetry(
+			&Error{Code: ErrorCodeRateLimit},
+			req,
+			&http.Response{StatusCode: http.StatusTooManyRequests},
+			0,
+		)
+		assert.True(t, shouldRetry)
+
+		// But the API can still ask not to retry
+		header := http.Header{}
+		header.Set("Stripe-Should-Retry", "false")
+		shouldRetry, _ = c.shouldRetry(
+			&Error{Code: ErrorCodeRateLimit},
+			req,
+			&http.Response{Header: header, StatusCode: http.StatusTooManyRequests},
+			0,
+		)
+		assert.False(t, shouldRetry)
+	})
 }
 
 func TestDo_RetryOnTimeout(t *testing.T) {
@@ -394,6 +448,7 @@ func TestDo_LastResponsePopulated(t *testing.T) {
 
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Idempotency-Key", "key_123")
+		w.Header().Set("Idempotent-Replayed", "true")
 		w.Header().Set("Other-Header", "other_header")
 		w.Header().Set("Request-Id", "req_123")
 
@@ -427,6 +482,7 @@ func TestDo_LastResponsePopulated(t *testing.T) {
 	assert.Equal(t, message, resource.Message)
 
 	assert.Equal(t, "key_123", resource.LastResponse.IdempotencyKey)
+	assert.True(t, resource.LastResponse.IdempotentReplayed)
 	assert.Equal(t, "other_header", resource.LastResponse.Header.Get("Other-Header"))
 	assert.Equal(t, rawJSON, resource.LastResponse.RawJSON)
 	assert.Equal(t, "req_123", resource.LastResponse.RequestID)
@@ -436,6 +492,70 @@ func TestDo_LastResponsePopulated(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, resource.LastResponse.StatusCode)
 }
 
+func TestCallWithResponse(t *testing.T) {
+	type testServerResponse struct {
+		APIResource
+		Message string `json:"message"`
+	}
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/hello", r.URL.Path)
+		assert.Equal(t, "name=x", r.URL.RawQuery)
+
+		w.Header().Set("Trailer", "Stripe-Trailer")
+		w.Header().Set("Stripe-Deprecation", "soon")
+		w.Write([]byte(`{"message":"Hello, client."}`))
+		w.Header().Set("Stripe-Trailer", "done")
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+
+	params := &struct {
+		Params `form:"*"`
+		Name   *string `form:"name"`
+	}{Name: String("x")}
+
+	var resource testServerResponse
+	res, err := backend.CallWithResponse(http.MethodGet, "/v1/hello", "sk_test_123", params, &resource)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hello, client.", resource.Message)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "soon", res.Header.Get("Stripe-Deprecation"))
+	assert.Equal(t, "done", res.Trailer.Get("Stripe-Trailer"))
+}
+
+func TestCallWithResponse_Error(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Stripe-Deprecation", "soon")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"message":"bad"}}`))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+
+	var resource APIResource
+	res, err := backend.CallWithResponse(http.MethodGet, "/v1/hello", "sk_test_123", nil, &resource)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	assert.Equal(t, "soon", res.Header.Get("Stripe-Deprecation"))
+}
+
 // Test that telemetry metrics are not sent by default
 func TestDo_TelemetryDisabled(t *testing.T) {
 	type testServerResponse struct {
@@ -697,6 +817,34 @@ func TestDo_Redaction(t *testing.T) {
 	assert.Contains(t, logs.String(), "REDACTED")
 }
 
+func TestDo_SensitiveResponseNotLogged(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"ic_123","number":"4242424242424242","cvc":"123"}`))
+	}))
+	defer testServer.Close()
+
+	var logs bytes.Buffer
+	logger := &LeveledLogger{Level: LevelDebug, stderrOverride: &logs, stdoutOverride: &logs}
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			LeveledLogger:     logger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	)
+
+	params := &Params{Context: WithSensitiveResponse(context.Background())}
+	var card IssuingCard
+	err := backend.Call(http.MethodGet, "/v1/issuing/cards/ic_123", "sk_test_123", params, &card)
+	assert.NoError(t, err)
+	assert.Equal(t, "4242424242424242", card.Number)
+
+	assert.NotContains(t, logs.String(), "4242424242424242")
+	assert.Contains(t, logs.String(), "Response: [REDACTED]")
+}
+
 func TestDoStreaming(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(200)
@@ -958,6 +1106,46 @@ func TestNewBackends(t *testing.T) {
 	assert.Equal(t, httpClient, backends.Uploads.(*BackendImplementation).HTTPClient)
 }
 
+func TestNewBackendsWithConfig(t *testing.T) {
+	apiClient := &http.Client{}
+	uploadsClient := &http.Client{}
+	config := &BackendsConfig{
+		API:     &BackendConfig{HTTPClient: apiClient, URL: String("http://api-proxy.example.com")},
+		Uploads: &BackendConfig{HTTPClient: uploadsClient},
+	}
+	backends := NewBackendsWithConfig(config)
+
+	api := backends.API.(*BackendImplementation)
+	assert.Equal(t, apiClient, api.HTTPClient)
+	assert.Equal(t, "http://api-proxy.example.com", api.URL)
+
+	uploads := backends.Uploads.(*BackendImplementation)
+	assert.Equal(t, uploadsClient, uploads.HTTPClient)
+	assert.Equal(t, UploadsURL, uploads.URL)
+
+	connect := backends.Connect.(*BackendImplementation)
+	assert.Equal(t, ConnectURL, connect.URL)
+
+	// Defaults are filled into copies of the given configs
+	assert.Nil(t, config.Uploads.URL)
+}
+
+func TestSetBackendsWithConfig(t *testing.T) {
+	defer func(api, connect, uploads Backend) {
+		SetBackend(APIBackend, api)
+		SetBackend(ConnectBackend, connect)
+		SetBackend(UploadsBackend, uploads)
+	}(GetBackend(APIBackend), GetBackend(ConnectBackend), GetBackend(UploadsBackend))
+
+	SetBackendsWithConfig(&BackendsConfig{
+		Connect: &BackendConfig{URL: String("http://connect-recorder.example.com")},
+	})
+
+	assert.Equal(t, "http://connect-recorder.example.com", GetBackend(ConnectBackend).(*BackendImplementation).URL)
+	assert.Equal(t, APIURL, GetBackend(APIBackend).(*BackendImplementation).URL)
+	assert.Equal(t, UploadsURL, GetBackend(UploadsBackend).(*BackendImplementation).URL)
+}
+
 func TestStripeAccount(t *testing.T) {
 	c := GetBackend(APIBackend).(*BackendImplementation)
 	p := &Params{}
@@ -969,6 +1157,140 @@ func TestStripeAccount(t *testing.T) {
 	assert.Equal(t, "acct_123", req.Header.Get("Stripe-Account"))
 }
 
+func TestStripeAccount_Backend(t *testing.T) {
+	c := GetBackendWithConfig(APIBackend, &BackendConfig{
+		StripeAccount: String("acct_123"),
+	}).(*BackendImplementation)
+	assert.Equal(t, "acct_123", c.StripeAccount())
+
+	req, err := c.NewRequest("", "", "", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "acct_123", req.Header.Get("Stripe-Account"))
+
+	// A request's params take precedence over the backend's account
+	p := &Params{}
+	p.SetStripeAccount("acct_456")
+	req, err = c.NewRequest("", "", "", "", p)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"acct_456"}, req.Header["Stripe-Account"])
+}
+
+func TestSetKey(t *testing.T) {
+	defer func(key, setKey string, set bool) {
+		Key, globalKey, globalKeySet = key, setKey, set
+	}(Key, globalKey, globalKeySet)
+	globalKey, globalKeySet = "", false
+
+	// Key is used until SetKey is called
+	Key = "sk_test_old"
+	assert.Equal(t, "sk_test_old", GetKey())
+
+	SetKey("sk_test_123")
+	assert.Equal(t, "sk_test_123", GetKey())
+
+	// The key can be changed while it's being read
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				SetKey("sk_test_456")
+			} else {
+				GetKey()
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, "sk_test_456", GetKey())
+}
+
+func TestSetAppInfo_Concurrent(t *testing.T) {
+	defer SetAppInfo(nil)
+
+	c := GetBackend(APIBackend).(*BackendImplementation)
+
+	// The app info can be changed while requests are being made
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				SetAppInfo(&AppInfo{Name: "MyAwesomePlugin"})
+			} else {
+				_, err := c.NewRequest("", "", "", "", nil)
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	req, err := c.NewRequest("", "", "", "", nil)
+	assert.NoError(t, err)
+	assert.Contains(t, req.Header.Get("User-Agent"), "MyAwesomePlugin")
+}
+
+func TestSetDefaultLeveledLogger(t *testing.T) {
+	defer SetDefaultLeveledLogger(GetDefaultLeveledLogger())
+
+	logger := &LeveledLogger{Level: LevelDebug}
+	SetDefaultLeveledLogger(logger)
+	assert.Equal(t, logger, GetDefaultLeveledLogger())
+
+	// Backends created without a logger use the default one
+	backend := GetBackendWithConfig(APIBackend, &BackendConfig{}).(*BackendImplementation)
+	assert.Equal(t, logger, backend.LeveledLogger)
+
+	// The logger can be changed while backends are being created
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				SetDefaultLeveledLogger(&LeveledLogger{Level: LevelInfo})
+			} else {
+				GetBackendWithConfig(APIBackend, &BackendConfig{})
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestStripeVersion(t *testing.T) {
+	// Defaults to the library's API version
+	{
+		c := GetBackend(APIBackend).(*BackendImplementation)
+
+		req, err := c.NewRequest("", "", "", "", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, APIVersion, req.Header.Get("Stripe-Version"))
+	}
+
+	// Overridden by the backend
+	{
+		c := GetBackendWithConfig(APIBackend, &BackendConfig{
+			APIVersion: String("2019-12-03"),
+		}).(*BackendImplementation)
+
+		req, err := c.NewRequest("", "", "", "", &Params{})
+		assert.NoError(t, err)
+		assert.Equal(t, "2019-12-03", req.Header.Get("Stripe-Version"))
+
+		// And then by the request
+		req, err = c.NewRequest("", "", "", "", &Params{StripeVersion: String("2022-08-01")})
+		assert.NoError(t, err)
+		assert.Equal(t, "2022-08-01", req.Header.Get("Stripe-Version"))
+	}
+
+	// Carried over from list params
+	{
+		p := &ListParams{StripeVersion: String("2022-08-01")}
+		assert.Equal(t, "2022-08-01", StringValue(p.ToParams().StripeVersion))
+	}
+}
+
 func TestUnmarshalJSONVerbose(t *testing.T) {
 	type testServerResponse struct {
 		Message string `json:"message"`
@@ -1126,6 +1448,47 @@ func TestStripeClientUserAgentWithAppInfo(t *testing.T) {
 	assert.Equal(t, appInfo.Version, decodedAppInfo["version"])
 }
 
+func TestUserAgentWithBackendAppInfo(t *testing.T) {
+	SetAppInfo(&AppInfo{Name: "GlobalPlugin"})
+	defer SetAppInfo(nil)
+
+	c := GetBackendWithConfig(APIBackend, &BackendConfig{
+		AppInfo: &AppInfo{
+			Name:      "MyAwesomePlugin",
+			PartnerID: "partner_1234",
+			Version:   "1.2.34",
+		},
+		LeveledLogger: nullLeveledLogger,
+	}).(*BackendImplementation)
+
+	req, err := c.NewRequest("", "", "", "", nil)
+	assert.NoError(t, err)
+
+	expectedPattern := regexp.MustCompile(`^Stripe/v1 GoBindings/[1-9][0-9.]+[0-9] MyAwesomePlugin/1.2.34$`)
+	assert.True(t, expectedPattern.MatchString(req.Header.Get("User-Agent")))
+
+	var userAgent map[string]interface{}
+	err = json.Unmarshal([]byte(req.Header.Get("X-Stripe-Client-User-Agent")), &userAgent)
+	assert.NoError(t, err)
+	application := userAgent["application"].(map[string]interface{})
+	assert.Equal(t, "MyAwesomePlugin", application["name"])
+	assert.Equal(t, "partner_1234", application["partner_id"])
+
+	// Backends without their own app info use the global one
+	req, err = GetBackend(APIBackend).(*BackendImplementation).NewRequest("", "", "", "", nil)
+	assert.NoError(t, err)
+	assert.Contains(t, req.Header.Get("User-Agent"), " GlobalPlugin")
+}
+
+func TestBackendAppInfo_EmptyName(t *testing.T) {
+	assert.Panics(t, func() {
+		GetBackendWithConfig(APIBackend, &BackendConfig{
+			AppInfo:       &AppInfo{Version: "1.2.34"},
+			LeveledLogger: nullLeveledLogger,
+		})
+	})
+}
+
 func TestResponseToError(t *testing.T) {
 	c := GetBackend(APIBackend).(*BackendImplementation)
 
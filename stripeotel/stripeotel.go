@@ -0,0 +1,128 @@
+// Package stripeotel traces the requests made by stripe-go with
+// OpenTelemetry, by way of a stripe.RequestInterceptor:
+//
+//	backend := stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{
+//		Interceptors: []stripe.RequestInterceptor{stripeotel.Interceptor()},
+//	})
+//	stripe.SetBackend(stripe.APIBackend, backend)
+//
+// It's a module of its own so that only the applications that use it depend on
+// OpenTelemetry.
+package stripeotel
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//
+// Public types
+//
+
+// Option configures the interceptor returned by Interceptor.
+type Option func(*config)
+
+//
+// Public constants
+//
+
+const (
+	// PathTemplateKey is the attribute holding the path of a request with its
+	// IDs replaced by `{id}`, like `/v1/charges/{id}`. See stripe.PathTemplate.
+	PathTemplateKey = attribute.Key("stripe.path_template")
+
+	// RequestIDKey is the attribute holding the value of the `Request-Id`
+	// header of a response, which Stripe support can use to look up a
+	// request.
+	RequestIDKey = attribute.Key("stripe.request_id")
+)
+
+//
+// Public functions
+//
+
+// Interceptor returns a stripe.RequestInterceptor that creates a client span
+// for every request made to Stripe, named after its method and path
+// template, like `POST /v1/charges/{id}`. Following OpenTelemetry's
+// conventions for HTTP clients, each retry of a request gets a span of its
+// own, whose `http.request.resend_count` attribute is its number of retries.
+//
+// Spans are children of the span in the context of the request's params,
+// which is set with Params.Context.
+func Interceptor(opts ...Option) stripe.RequestInterceptor {
+	c := &config{tracerProvider: otel.GetTracerProvider()}
+	for _, opt := range opts {
+		opt(c)
+	}
+	tracer := c.tracerProvider.Tracer(instrumentationName)
+
+	return func(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+		pathTemplate := stripe.PathTemplate(req.URL.Path)
+
+		attrs := []attribute.KeyValue{
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Hostname()),
+			// The query string is left out, since it holds the parameters of
+			// `GET` requests, which may include personal data
+			semconv.URLFull((&url.URL{Scheme: req.URL.Scheme, Host: req.URL.Host, Path: req.URL.Path}).String()),
+			PathTemplateKey.String(pathTemplate),
+		}
+		if retries := stripe.RequestRetries(req.Context()); retries > 0 {
+			attrs = append(attrs, semconv.HTTPRequestResendCount(retries))
+		}
+
+		ctx, span := tracer.Start(req.Context(), req.Method+" "+pathTemplate,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		resp, err := next(req.WithContext(ctx))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return resp, err
+		}
+
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if requestID := resp.Header.Get("Request-Id"); requestID != "" {
+			span.SetAttributes(RequestIDKey.String(requestID))
+		}
+		if resp.StatusCode >= 400 {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP status code %d", resp.StatusCode))
+		}
+		return resp, nil
+	}
+}
+
+// WithTracerProvider sets the TracerProvider that spans are created with.
+//
+// Defaults to the global TracerProvider.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tracerProvider
+	}
+}
+
+//
+// Private types
+//
+
+type config struct {
+	tracerProvider trace.TracerProvider
+}
+
+//
+// Private constants
+//
+
+// instrumentationName is the name of the tracer that spans are created with.
+const instrumentationName = "github.com/stripe/stripe-go/v72/stripeotel"
@@ -0,0 +1,82 @@
+package stripeotel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInterceptor(t *testing.T) {
+	requestNum := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestNum++
+		w.Header().Set("Request-Id", "req_123")
+		// Fail the first attempt so that the request is retried
+		if requestNum == 1 {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"message":"Conflict"}}`))
+			return
+		}
+		w.Write([]byte(`{"id":"ch_123","object":"charge"}`))
+	}))
+	defer ts.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	backend := stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{
+		Interceptors:      []stripe.RequestInterceptor{Interceptor(WithTracerProvider(tracerProvider))},
+		LeveledLogger:     &stripe.LeveledLogger{Level: stripe.LevelNull},
+		MaxNetworkRetries: stripe.Int64(1),
+		URL:               stripe.String(ts.URL),
+	})
+	backend.(*stripe.BackendImplementation).SetNetworkRetriesSleep(false)
+
+	ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+	params := &stripe.ChargeParams{}
+	params.Context = ctx
+
+	charge := &stripe.Charge{}
+	err := backend.Call(http.MethodPost, "/v1/charges/ch_123", "sk_test_123", params, charge)
+	assert.NoError(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	assert.Equal(t, 3, len(spans))
+	for i, span := range spans[:2] {
+		assert.Equal(t, "POST /v1/charges/{id}", span.Name())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+
+		assert.Equal(t, "/v1/charges/{id}", attrValue(span, PathTemplateKey).AsString())
+		assert.Equal(t, "req_123", attrValue(span, RequestIDKey).AsString())
+		assert.Equal(t, http.MethodPost, attrValue(span, semconv.HTTPRequestMethodKey).AsString())
+		assert.Equal(t, ts.URL+"/v1/charges/ch_123", attrValue(span, semconv.URLFullKey).AsString())
+		assert.Equal(t, int64(i), attrValue(span, semconv.HTTPRequestResendCountKey).AsInt64())
+	}
+
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, int64(http.StatusConflict), attrValue(spans[0], semconv.HTTPResponseStatusCodeKey).AsInt64())
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+}
+
+// attrValue returns the value of the attribute of span with the given key,
+// which is empty if there's none.
+func attrValue(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return attribute.Value{}
+}
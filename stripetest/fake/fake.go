@@ -0,0 +1,282 @@
+// Package fake generates deterministic, fully populated Stripe resources for
+// tests that shouldn't depend on an API or on stripe-mock.
+//
+// A Generator created with the same seed always produces the same sequence of
+// resources, so generated values can be used in table-driven tests and golden
+// files:
+//
+//	g := fake.New(42)
+//	customer := g.Customer()
+//	invoice := g.Invoice(customer, 3)
+//	event, err := g.Event("invoice.paid", invoice)
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public types
+//
+
+// Generator produces fake resources from a seeded source of randomness. A
+// Generator isn't safe for concurrent use.
+type Generator struct {
+	// Now is the Unix timestamp around which creation times are generated.
+	// Each generated resource is created a few seconds after the previous
+	// one. Defaults to 2022-01-01T00:00:00Z.
+	Now int64
+
+	rand *rand.Rand
+}
+
+//
+// Public functions
+//
+
+// New returns a Generator seeded with the given value.
+func New(seed int64) *Generator {
+	return &Generator{
+		Now:  defaultNow,
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Charge generates a succeeded card charge for the given customer in its
+// currency. The customer may be nil.
+func (g *Generator) Charge(customer *stripe.Customer) *stripe.Charge {
+	id := g.ID("ch")
+	amount := g.amount()
+
+	charge := &stripe.Charge{
+		Amount:             amount,
+		AmountCaptured:     amount,
+		BalanceTransaction: &stripe.BalanceTransaction{ID: g.ID("txn")},
+		BillingDetails: &stripe.BillingDetails{
+			Address: &stripe.Address{Country: "US", PostalCode: g.digits(5)},
+		},
+		CalculatedStatementDescriptor: "FAKE",
+		Captured:                      true,
+		Created:                       g.created(),
+		Currency:                      stripe.CurrencyUSD,
+		ID:                            id,
+		Metadata:                      map[string]string{},
+		Object:                        "charge",
+		Outcome: &stripe.ChargeOutcome{
+			NetworkStatus: "approved_by_network",
+			RiskLevel:     "normal",
+			RiskScore:     int64(g.rand.Intn(65)),
+			SellerMessage: "Payment complete.",
+			Type:          "authorized",
+		},
+		Paid:          true,
+		PaymentMethod: g.ID("pm"),
+		PaymentMethodDetails: &stripe.ChargePaymentMethodDetails{
+			Card: &stripe.ChargePaymentMethodDetailsCard{
+				Brand:    "visa",
+				ExpMonth: uint64(1 + g.rand.Intn(12)),
+				ExpYear:  uint64(2030 + g.rand.Intn(5)),
+				Last4:    g.digits(4),
+			},
+			Type: stripe.ChargePaymentMethodDetailsTypeCard,
+		},
+		ReceiptURL: "https://pay.stripe.com/receipts/" + id,
+		Refunds: &stripe.RefundList{
+			ListMeta: stripe.ListMeta{URL: "/v1/charges/" + id + "/refunds"},
+			Data:     []*stripe.Refund{},
+		},
+		Status: stripe.ChargeStatusSucceeded,
+	}
+	if customer != nil {
+		charge.BillingDetails.Email = customer.Email
+		charge.BillingDetails.Name = customer.Name
+		charge.Currency = customer.Currency
+		charge.Customer = &stripe.Customer{ID: customer.ID}
+	}
+	return charge
+}
+
+// Customer generates a customer with a name, email address and address.
+func (g *Generator) Customer() *stripe.Customer {
+	first := firstNames[g.rand.Intn(len(firstNames))]
+	last := lastNames[g.rand.Intn(len(lastNames))]
+	id := g.ID("cus")
+
+	return &stripe.Customer{
+		Address: stripe.Address{
+			City:       "San Francisco",
+			Country:    "US",
+			Line1:      fmt.Sprintf("%d Market Street", 1+g.rand.Intn(999)),
+			PostalCode: "94" + g.digits(3),
+			State:      "CA",
+		},
+		Created:       g.created(),
+		Currency:      stripe.CurrencyUSD,
+		Email:         fmt.Sprintf("%s.%s@example.com", strings.ToLower(first), strings.ToLower(last)),
+		ID:            id,
+		InvoicePrefix: g.letters(8),
+		InvoiceSettings: &stripe.CustomerInvoiceSettings{
+			CustomFields: []*stripe.CustomerInvoiceCustomField{},
+		},
+		Metadata:            map[string]string{},
+		Name:                first + " " + last,
+		NextInvoiceSequence: 1,
+		Object:              "customer",
+		PreferredLocales:    []string{},
+		TaxExempt:           stripe.CustomerTaxExemptNone,
+	}
+}
+
+// Event wraps a resource in an event of the given type, as it would be
+// delivered to a webhook endpoint. Both Data.Raw and Data.Object are
+// populated.
+func (g *Generator) Event(typ string, object interface{}) (*stripe.Event, error) {
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &stripe.EventData{Raw: raw}
+	if err := json.Unmarshal(raw, &data.Object); err != nil {
+		return nil, err
+	}
+
+	return &stripe.Event{
+		APIVersion: stripe.APIVersion,
+		Created:    g.created(),
+		Data:       data,
+		ID:         g.ID("evt"),
+		Object:     "event",
+		Request:    &stripe.EventRequest{ID: g.ID("req"), IdempotencyKey: g.letters(16)},
+		Type:       typ,
+	}, nil
+}
+
+// ID generates an object ID with the given prefix, like `cus_` followed by
+// random alphanumeric characters.
+func (g *Generator) ID(prefix string) string {
+	b := make([]byte, idLength)
+	for i := range b {
+		b[i] = idAlphabet[g.rand.Intn(len(idAlphabet))]
+	}
+	return prefix + "_" + string(b)
+}
+
+// Invoice generates a paid invoice for the given customer with the given
+// number of invoice item lines. Its totals are the sum of its lines. The
+// customer's NextInvoiceSequence is incremented so that every invoice gets a
+// distinct number. The customer may be nil.
+func (g *Generator) Invoice(customer *stripe.Customer, lines int) *stripe.Invoice {
+	collectionMethod := stripe.InvoiceCollectionMethodChargeAutomatically
+	id := g.ID("in")
+	created := g.created()
+
+	invoice := &stripe.Invoice{
+		AccountCountry:   "US",
+		Charge:           &stripe.Charge{ID: g.ID("ch")},
+		CollectionMethod: &collectionMethod,
+		Created:          created,
+		Currency:         stripe.CurrencyUSD,
+		ID:               id,
+		Lines: &stripe.InvoiceLineList{
+			ListMeta: stripe.ListMeta{URL: "/v1/invoices/" + id + "/lines"},
+			Data:     make([]*stripe.InvoiceLine, lines),
+		},
+		Metadata:    map[string]string{},
+		Object:      "invoice",
+		Paid:        true,
+		PeriodEnd:   created,
+		PeriodStart: created,
+		Status:      stripe.InvoiceStatusPaid,
+	}
+	if customer != nil {
+		invoice.Currency = customer.Currency
+		invoice.Customer = &stripe.Customer{ID: customer.ID}
+		invoice.CustomerEmail = customer.Email
+		invoice.CustomerName = stripe.String(customer.Name)
+		invoice.Number = fmt.Sprintf("%s-%04d", customer.InvoicePrefix, customer.NextInvoiceSequence)
+		customer.NextInvoiceSequence++
+	}
+
+	var total int64
+	for i := range invoice.Lines.Data {
+		line := &stripe.InvoiceLine{
+			Amount:       g.amount(),
+			Currency:     invoice.Currency,
+			Description:  products[g.rand.Intn(len(products))],
+			Discountable: true,
+			ID:           g.ID("il"),
+			InvoiceItem:  g.ID("ii"),
+			Metadata:     map[string]string{},
+			Object:       "line_item",
+			Period:       &stripe.Period{End: created, Start: created},
+			Quantity:     1,
+			Type:         stripe.InvoiceLineTypeInvoiceItem,
+		}
+		total += line.Amount
+		invoice.Lines.Data[i] = line
+	}
+
+	invoice.AmountDue = total
+	invoice.AmountPaid = total
+	invoice.Subtotal = total
+	invoice.Total = total
+	return invoice
+}
+
+//
+// Private constants
+//
+
+const (
+	// defaultNow is 2022-01-01T00:00:00Z.
+	defaultNow = 1640995200
+
+	idAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	idLength   = 24
+)
+
+//
+// Private variables
+//
+
+var firstNames = []string{"Jenny", "Alex", "Sam", "Priya", "Kenji", "Amara", "Lucas", "Noor"}
+
+var lastNames = []string{"Rosen", "Garcia", "Okafor", "Tanaka", "Moreau", "Dubois", "Silva", "Khan"}
+
+var products = []string{"Starter plan", "Pro plan", "Setup fee", "Support hours", "Extra seats"}
+
+//
+// Private functions
+//
+
+// amount returns an amount between $1.00 and $500.00.
+func (g *Generator) amount() int64 {
+	return int64(100 + g.rand.Intn(49901))
+}
+
+func (g *Generator) created() int64 {
+	g.Now += int64(1 + g.rand.Intn(60))
+	return g.Now
+}
+
+func (g *Generator) digits(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('0' + g.rand.Intn(10))
+	}
+	return string(b)
+}
+
+func (g *Generator) letters(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('A' + g.rand.Intn(26))
+	}
+	return string(b)
+}
@@ -0,0 +1,74 @@
+package fake
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+func TestGenerator_Deterministic(t *testing.T) {
+	g1, g2 := New(42), New(42)
+	assert.Equal(t, g1.Customer(), g2.Customer())
+	assert.Equal(t, g1.Charge(nil), g2.Charge(nil))
+
+	assert.NotEqual(t, New(1).ID("cus"), New(2).ID("cus"))
+}
+
+func TestGenerator_Charge(t *testing.T) {
+	g := New(42)
+	customer := g.Customer()
+	charge := g.Charge(customer)
+
+	assert.Regexp(t, `^ch_[0-9A-Za-z]{24}$`, charge.ID)
+	assert.Equal(t, customer.ID, charge.Customer.ID)
+	assert.Equal(t, customer.Email, charge.BillingDetails.Email)
+	assert.Equal(t, charge.Amount, charge.AmountCaptured)
+	assert.True(t, charge.Created > customer.Created)
+
+	// Generated resources survive a round trip through JSON
+	data, err := json.Marshal(charge)
+	assert.NoError(t, err)
+	var decoded stripe.Charge
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, charge.ID, decoded.ID)
+	assert.Equal(t, stripe.ChargeStatusSucceeded, decoded.Status)
+	assert.Equal(t, customer.ID, decoded.Customer.ID)
+}
+
+func TestGenerator_Invoice(t *testing.T) {
+	g := New(42)
+	customer := g.Customer()
+	invoice := g.Invoice(customer, 3)
+
+	assert.Equal(t, 3, len(invoice.Lines.Data))
+	var total int64
+	for _, line := range invoice.Lines.Data {
+		assert.Regexp(t, `^il_`, line.ID)
+		total += line.Amount
+	}
+	assert.Equal(t, total, invoice.Total)
+	assert.Equal(t, total, invoice.AmountPaid)
+	assert.Equal(t, customer.InvoicePrefix+"-0001", invoice.Number)
+
+	next := g.Invoice(customer, 0)
+	assert.Equal(t, customer.InvoicePrefix+"-0002", next.Number)
+	assert.Equal(t, int64(0), next.Total)
+}
+
+func TestGenerator_Event(t *testing.T) {
+	g := New(42)
+	invoice := g.Invoice(nil, 2)
+
+	event, err := g.Event("invoice.paid", invoice)
+	assert.NoError(t, err)
+	assert.Regexp(t, `^evt_`, event.ID)
+	assert.Equal(t, "invoice.paid", event.Type)
+	assert.Equal(t, invoice.ID, event.GetObjectValue("id"))
+
+	var decoded stripe.Invoice
+	assert.NoError(t, json.Unmarshal(event.Data.Raw, &decoded))
+	assert.Equal(t, invoice.ID, decoded.ID)
+	assert.Equal(t, 2, len(decoded.Lines.Data))
+}
@@ -0,0 +1,200 @@
+package stripetest
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/form"
+)
+
+//
+// Public types
+//
+
+// Mock is a running instance of stripe-mock, a server that mimics the Stripe
+// API, which resource clients can be pointed at to write integration-style
+// tests without reaching Stripe:
+//
+//	func TestCreateCustomer(t *testing.T) {
+//		mock := stripetest.NewMock(t)
+//		defer mock.Close()
+//
+//		sc := &client.API{}
+//		sc.Init("sk_test_123", mock.Backends())
+//		c, err := sc.Customers.New(&stripe.CustomerParams{})
+//		...
+//	}
+type Mock struct {
+	// URL is the base URL of stripe-mock.
+	URL string
+
+	cmd        *exec.Cmd
+	httpClient *http.Client
+}
+
+//
+// Public functions
+//
+
+// AssertForm reports an error on tb if params aren't form encoded to expected,
+// which is how they'd be sent to Stripe.
+func AssertForm(tb testing.TB, expected url.Values, params interface{}) {
+	tb.Helper()
+
+	actual := EncodeForm(params)
+	if !reflect.DeepEqual(expected, actual) {
+		tb.Errorf("params are encoded as:\n%s\nexpected:\n%s", actual.Encode(), expected.Encode())
+	}
+}
+
+// EncodeForm returns the form values that params would be sent to Stripe
+// with.
+func EncodeForm(params interface{}) url.Values {
+	values := &form.Values{}
+	form.AppendTo(values, params)
+	return values.ToValues()
+}
+
+// NewBackend returns a backend that sends requests to url without logging
+// them, for tests that need responses stripe-mock can't give, like those of
+// an httptest.Server:
+//
+//	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//		w.Write([]byte(`{"id":"cus_123","object":"customer"}`))
+//	}))
+//	defer ts.Close()
+//
+//	c := customer.Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+func NewBackend(url string) stripe.Backend {
+	return stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{
+		LeveledLogger: &stripe.LeveledLogger{Level: stripe.LevelNull},
+		URL:           stripe.String(url),
+	})
+}
+
+// NewMock returns a stripe-mock to run tests against. The test is skipped if
+// none is available. Close must be called once the test is done.
+//
+// If the STRIPE_MOCK_PORT environment variable is set, the stripe-mock
+// listening for HTTPS on that port of localhost is used, like for the
+// library's own test suite. Otherwise, if a `stripe-mock` executable is found
+// in PATH, a new instance is started on a free port. Otherwise, the
+// stripe-mock listening on its default HTTPS port, 12112, is used.
+func NewMock(tb testing.TB) *Mock {
+	tb.Helper()
+
+	var m *Mock
+	if port := os.Getenv("STRIPE_MOCK_PORT"); port != "" {
+		m = newRemoteMock(port)
+	} else if path, err := exec.LookPath("stripe-mock"); err == nil {
+		m, err = startMock(path)
+		if err != nil {
+			tb.Fatalf("couldn't start stripe-mock: %v", err)
+		}
+	} else {
+		m = newRemoteMock(defaultMockPort)
+	}
+
+	if err := m.wait(); err != nil {
+		m.Close()
+		tb.Skipf("stripe-mock isn't available at %s: %v", m.URL, err)
+	}
+	return m
+}
+
+// Backends returns backends that send API and upload requests to
+// stripe-mock, which supports both.
+func (m *Mock) Backends() *stripe.Backends {
+	backend := stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{
+		HTTPClient:        m.httpClient,
+		LeveledLogger:     &stripe.LeveledLogger{Level: stripe.LevelNull},
+		MaxNetworkRetries: stripe.Int64(0),
+		URL:               stripe.String(m.URL),
+	})
+	return &stripe.Backends{API: backend, Connect: backend, Uploads: backend}
+}
+
+// Close stops stripe-mock if it was started by NewMock.
+func (m *Mock) Close() {
+	if m.cmd != nil {
+		m.cmd.Process.Kill()
+		m.cmd.Wait()
+		m.cmd = nil
+	}
+}
+
+//
+// Private constants
+//
+
+const (
+	defaultMockPort = "12112"
+
+	// mockStartTimeout is how long a started stripe-mock has to begin
+	// accepting requests.
+	mockStartTimeout = 10 * time.Second
+)
+
+//
+// Private functions
+//
+
+// newRemoteMock returns a Mock for a stripe-mock that's already listening for
+// HTTPS on the given port. Its certificate is self-signed, so it isn't
+// verified.
+func newRemoteMock(port string) *Mock {
+	return &Mock{
+		URL: "https://localhost:" + port,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		},
+	}
+}
+
+func startMock(path string) (*Mock, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	cmd := exec.Command(path, "-http-port", strconv.Itoa(port))
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &Mock{
+		URL:        fmt.Sprintf("http://127.0.0.1:%d", port),
+		cmd:        cmd,
+		httpClient: &http.Client{},
+	}, nil
+}
+
+// wait waits until stripe-mock accepts requests. A stripe-mock that wasn't
+// started by NewMock is only tried once.
+func (m *Mock) wait() error {
+	deadline := time.Now().Add(mockStartTimeout)
+	for {
+		resp, err := m.httpClient.Get(m.URL)
+		if err == nil {
+			resp.Body.Close()
+			return nil
+		}
+		if m.cmd == nil || time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
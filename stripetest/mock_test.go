@@ -0,0 +1,77 @@
+package stripetest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/customer"
+)
+
+// recordingTB records failures instead of reporting them.
+type recordingTB struct {
+	testing.TB
+	failed bool
+}
+
+func (tb *recordingTB) Errorf(format string, args ...interface{}) {
+	tb.failed = true
+}
+
+func (tb *recordingTB) Helper() {}
+
+func TestAssertForm(t *testing.T) {
+	params := &stripe.CustomerParams{
+		Email:            stripe.String("jenny@example.com"),
+		PreferredLocales: stripe.StringSlice([]string{"fr", "en"}),
+	}
+	AssertForm(t, url.Values{
+		"email":                {"jenny@example.com"},
+		"preferred_locales[0]": {"fr"},
+		"preferred_locales[1]": {"en"},
+	}, params)
+
+	tb := &recordingTB{TB: t}
+	AssertForm(tb, url.Values{
+		"email": {"jenny@example.com"},
+	}, params)
+	assert.True(t, tb.failed)
+}
+
+func TestNewBackend(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/customers/cus_123", r.URL.Path)
+		w.Write([]byte(`{"id":"cus_123","object":"customer"}`))
+	}))
+	defer ts.Close()
+
+	c := customer.Client{B: NewBackend(ts.URL), Key: "sk_test_123"}
+	cus, err := c.Get("cus_123", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "cus_123", cus.ID)
+}
+
+func TestNewMock(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/customers/cus_123" {
+			w.Write([]byte(`{"id":"cus_123","object":"customer"}`))
+		}
+	}))
+	defer ts.Close()
+
+	defer os.Setenv("STRIPE_MOCK_PORT", os.Getenv("STRIPE_MOCK_PORT"))
+	os.Setenv("STRIPE_MOCK_PORT", ts.URL[strings.LastIndex(ts.URL, ":")+1:])
+
+	mock := NewMock(t)
+	defer mock.Close()
+
+	c := customer.Client{B: mock.Backends().API, Key: "sk_test_123"}
+	cus, err := c.Get("cus_123", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "cus_123", cus.ID)
+}
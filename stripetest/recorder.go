@@ -0,0 +1,408 @@
+package stripetest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public types
+//
+
+// Interaction is a request made to Stripe and the response it got, as saved in
+// a Recorder's cassette.
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the request of an Interaction. Its `Authorization` and
+// `X-Stripe-Client-User-Agent` headers are never saved.
+type RecordedRequest struct {
+	// Body is the body of the request, like its form encoded parameters.
+	Body string `json:"body,omitempty"`
+
+	// Header holds the headers of the request.
+	Header http.Header `json:"header,omitempty"`
+
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+
+	// URL is the full URL of the request, including its query string.
+	URL string `json:"url"`
+}
+
+// RecordedResponse is the response of an Interaction.
+type RecordedResponse struct {
+	// Body is the body of the response. It's stored base64 encoded if it
+	// isn't valid UTF-8, like the contents of a PDF.
+	Body string `json:"body"`
+
+	// BodyEncoding is `base64` if Body is base64 encoded, and empty
+	// otherwise.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+
+	// Header holds the headers of the response.
+	Header http.Header `json:"header,omitempty"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"status_code"`
+}
+
+// Recorder is an http.RoundTripper that records the requests sent through it
+// and their responses to a cassette file, and replays them later, so that test
+// suites can run against real API responses without reaching Stripe or
+// stripe-mock:
+//
+//	func TestRefundFlow(t *testing.T) {
+//		mode := stripetest.RecorderModeReplay
+//		if os.Getenv("STRIPE_RECORD") != "" {
+//			mode = stripetest.RecorderModeRecord
+//		}
+//		rec, err := stripetest.NewRecorder("testdata/refund_flow.json", mode)
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		defer func() {
+//			if err := rec.Close(); err != nil {
+//				t.Error(err)
+//			}
+//		}()
+//
+//		sc := &client.API{}
+//		sc.Init(os.Getenv("STRIPE_SECRET_KEY"), rec.Backends())
+//		...
+//	}
+//
+// When replaying, a request is answered with the first recorded interaction
+// that hasn't been used yet and has the same method, URL and body. Bodies of
+// multipart requests, like file uploads, aren't compared, since their
+// boundaries are random. Requests are held to the same scrubbing as recorded
+// interactions before they're compared.
+//
+// API keys, ephemeral keys, webhook signing secrets and the client secrets of
+// PaymentIntents and SetupIntents are scrubbed from everything that's saved.
+// Other secrets, like personal details, can be scrubbed with Scrub.
+//
+// A Recorder is safe for concurrent use, but the order of concurrent requests
+// isn't deterministic, so the requests of a test should be recorded in sequence
+// if some of them are identical.
+type Recorder struct {
+	// Scrub is called on every interaction before it's saved, and on every
+	// request before it's matched against recorded interactions, with an
+	// empty Response. It can modify the interaction to remove secrets. It
+	// must be deterministic, so that replayed requests still match the
+	// scrubbed recordings.
+	Scrub func(*Interaction)
+
+	// Transport sends requests when recording. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	interactions []*Interaction
+	mode         RecorderMode
+	mu           sync.Mutex
+	path         string
+	used         []bool
+}
+
+// RecorderMode is whether a Recorder records or replays interactions.
+type RecorderMode int
+
+// List of values that RecorderMode can take
+const (
+	// RecorderModeReplay answers requests with the interactions of an existing
+	// cassette, and fails those that weren't recorded. No request reaches
+	// Stripe.
+	RecorderModeReplay RecorderMode = iota
+
+	// RecorderModeRecord sends requests to Stripe, and saves them and their
+	// responses to the cassette when the Recorder is closed, replacing its
+	// previous contents.
+	RecorderModeRecord
+)
+
+//
+// Public functions
+//
+
+// NewRecorder returns a Recorder that uses the cassette at path. In
+// RecorderModeReplay, the cassette is loaded immediately, and an error is
+// returned if it can't be.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path}
+	if mode != RecorderModeReplay {
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("error decoding cassette %s: %v", path, err)
+	}
+	r.interactions = c.Interactions
+	r.used = make([]bool, len(c.Interactions))
+	return r, nil
+}
+
+// Backends returns backends that send API, upload and Connect requests through
+// the Recorder to their default URLs. Retries are disabled so that replays are
+// deterministic.
+func (r *Recorder) Backends() *stripe.Backends {
+	newBackend := func(backendType stripe.SupportedBackend) stripe.Backend {
+		return stripe.GetBackendWithConfig(backendType, &stripe.BackendConfig{
+			HTTPClient:        r.HTTPClient(),
+			LeveledLogger:     &stripe.LeveledLogger{Level: stripe.LevelNull},
+			MaxNetworkRetries: stripe.Int64(0),
+		})
+	}
+	return &stripe.Backends{
+		API:     newBackend(stripe.APIBackend),
+		Connect: newBackend(stripe.ConnectBackend),
+		Uploads: newBackend(stripe.UploadsBackend),
+	}
+}
+
+// Close saves the recorded interactions to the cassette in RecorderModeRecord,
+// creating its directory if needed. It does nothing in RecorderModeReplay.
+func (r *Recorder) Close() error {
+	if r.mode != RecorderModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	interactions := r.interactions
+	if interactions == nil {
+		interactions = []*Interaction{}
+	}
+	data, err := json.MarshalIndent(&cassette{Interactions: interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+// HTTPClient returns an HTTP client that sends requests through the Recorder,
+// to configure backends with BackendConfig.HTTPClient.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip records or replays a single request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recorded := &RecordedRequest{
+		Body:   string(body),
+		Header: make(http.Header),
+		Method: req.Method,
+		URL:    req.URL.String(),
+	}
+	for key, values := range req.Header {
+		if _, ok := unrecordedHeaders[http.CanonicalHeaderKey(key)]; ok {
+			continue
+		}
+		recorded.Header[key] = append([]string(nil), values...)
+	}
+
+	if r.mode == RecorderModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, body, recorded)
+}
+
+//
+// Private types
+//
+
+// cassette is the format of the file interactions are saved to.
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+//
+// Private variables
+//
+
+// secretPatterns match the secrets that are scrubbed from every interaction,
+// with their replacements. Client secrets keep the ID of their object, which
+// isn't secret and tells recorded interactions apart.
+var secretPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// Secret and restricted API keys
+	{regexp.MustCompile(`\b(sk|rk)_(live|test)_[0-9A-Za-z]+`), "${1}_${2}_REDACTED"},
+	// Ephemeral keys
+	{regexp.MustCompile(`\bek_(live|test)_[0-9A-Za-z]+`), "ek_${1}_REDACTED"},
+	// Webhook signing secrets
+	{regexp.MustCompile(`\bwhsec_[0-9A-Za-z]+`), "whsec_REDACTED"},
+	// Client secrets of PaymentIntents and SetupIntents
+	{regexp.MustCompile(`\b((?:pi|seti)_[0-9A-Za-z]+)_secret_[0-9A-Za-z]+`), "${1}_secret_REDACTED"},
+}
+
+// unrecordedHeaders are the request headers that aren't saved: the API key,
+// and the client's description of the machine it runs on.
+var unrecordedHeaders = map[string]struct{}{
+	"Authorization":              {},
+	"X-Stripe-Client-User-Agent": {},
+}
+
+//
+// Private functions
+//
+
+func (r *Recorder) record(req *http.Request, body []byte, recorded *RecordedRequest) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	// RoundTrippers mustn't modify the request, so send a copy with the body
+	// that was read
+	out := req.Clone(req.Context())
+	if req.Body != nil {
+		out.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	resp, err := transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request:  recorded,
+		Response: &RecordedResponse{Header: resp.Header.Clone(), StatusCode: resp.StatusCode},
+	}
+	if utf8.Valid(respBody) {
+		interaction.Response.Body = string(respBody)
+	} else {
+		interaction.Response.Body = base64.StdEncoding.EncodeToString(respBody)
+		interaction.Response.BodyEncoding = "base64"
+	}
+	r.scrub(interaction)
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded *RecordedRequest) (*http.Response, error) {
+	live := &Interaction{Request: recorded, Response: &RecordedResponse{}}
+	r.scrub(live)
+	multipart := strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data")
+
+	r.mu.Lock()
+	var match *Interaction
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request == nil || interaction.Response == nil {
+			continue
+		}
+		if interaction.Request.Method != live.Request.Method || interaction.Request.URL != live.Request.URL {
+			continue
+		}
+		if !multipart && interaction.Request.Body != live.Request.Body {
+			continue
+		}
+		r.used[i] = true
+		match = interaction
+		break
+	}
+	r.mu.Unlock()
+
+	if match == nil {
+		return nil, fmt.Errorf("stripetest: no recorded interaction in %s matches %s %s", r.path, req.Method, req.URL)
+	}
+
+	body := []byte(match.Response.Body)
+	if match.Response.BodyEncoding == "base64" {
+		var err error
+		body, err = base64.StdEncoding.DecodeString(match.Response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("stripetest: error decoding recorded body: %v", err)
+		}
+	}
+
+	header := match.Response.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Header:        header,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       req,
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+	}, nil
+}
+
+// scrub removes API keys and other secrets from interaction, then applies the
+// Recorder's own scrubbing.
+func (r *Recorder) scrub(interaction *Interaction) {
+	interaction.Request.Body = scrubSecrets(interaction.Request.Body)
+	interaction.Request.URL = scrubSecrets(interaction.Request.URL)
+	scrubHeader(interaction.Request.Header)
+	if interaction.Response.BodyEncoding == "" {
+		interaction.Response.Body = scrubSecrets(interaction.Response.Body)
+	}
+	scrubHeader(interaction.Response.Header)
+
+	if r.Scrub != nil {
+		r.Scrub(interaction)
+	}
+}
+
+func scrubSecrets(s string) string {
+	for _, secret := range secretPatterns {
+		s = secret.pattern.ReplaceAllString(s, secret.replacement)
+	}
+	return s
+}
+
+func scrubHeader(header http.Header) {
+	for _, values := range header {
+		for i, value := range values {
+			values[i] = scrubSecrets(value)
+		}
+	}
+}
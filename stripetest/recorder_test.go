@@ -0,0 +1,169 @@
+package stripetest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/customer"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stripetest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassettes", "customers.json")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/customers":
+			r.ParseForm()
+			w.Write([]byte(`{"id":"cus_123","object":"customer","email":"` + r.PostForm.Get("email") + `","description":"made with sk_test_abc123"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/customers/cus_123":
+			w.Write([]byte(`{"id":"cus_123","object":"customer","email":"jenny@example.com"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"type":"invalid_request_error","message":"No such customer"}}`))
+		}
+	}))
+	serverURL := ts.URL
+
+	// Record
+	rec, err := NewRecorder(path, RecorderModeRecord)
+	assert.NoError(t, err)
+	c := newRecorderCustomerClient(rec, serverURL)
+
+	cus, err := c.New(&stripe.CustomerParams{Email: stripe.String("jenny@example.com")})
+	assert.NoError(t, err)
+	assert.Equal(t, "cus_123", cus.ID)
+	cus, err = c.Get("cus_123", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "jenny@example.com", cus.Email)
+	_, err = c.Get("cus_missing", nil)
+	assert.Error(t, err)
+
+	assert.NoError(t, rec.Close())
+	ts.Close()
+
+	// Secrets aren't saved
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "sk_test_abc123")
+	assert.NotContains(t, string(data), "Authorization")
+	assert.NotContains(t, string(data), "X-Stripe-Client-User-Agent")
+	assert.Contains(t, string(data), "sk_test_REDACTED")
+
+	var saved cassette
+	assert.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, 3, len(saved.Interactions))
+	assert.Equal(t, "email=jenny%40example.com", saved.Interactions[0].Request.Body)
+
+	// Replay, with the server gone
+	rec, err = NewRecorder(path, RecorderModeReplay)
+	assert.NoError(t, err)
+	c = newRecorderCustomerClient(rec, serverURL)
+
+	cus, err = c.New(&stripe.CustomerParams{Email: stripe.String("jenny@example.com")})
+	assert.NoError(t, err)
+	assert.Equal(t, "cus_123", cus.ID)
+	assert.Equal(t, "made with sk_test_REDACTED", cus.Description)
+	cus, err = c.Get("cus_123", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "jenny@example.com", cus.Email)
+
+	_, err = c.Get("cus_missing", nil)
+	stripeErr, ok := err.(*stripe.Error)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, stripeErr.HTTPStatusCode)
+
+	// Each interaction is only replayed once, and different params don't
+	// match
+	_, err = c.Get("cus_123", nil)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "no recorded interaction"))
+	_, err = c.New(&stripe.CustomerParams{Email: stripe.String("other@example.com")})
+	assert.Error(t, err)
+
+	assert.NoError(t, rec.Close())
+}
+
+func TestRecorder_Scrub(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stripetest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "customers.json")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"cus_123","object":"customer","email":"jenny@example.com"}`))
+	}))
+	defer ts.Close()
+
+	scrub := func(interaction *Interaction) {
+		interaction.Request.Body = strings.Replace(interaction.Request.Body, "jenny%40example.com", "REDACTED", -1)
+		interaction.Response.Body = strings.Replace(interaction.Response.Body, "jenny@example.com", "redacted@example.com", -1)
+	}
+
+	rec, err := NewRecorder(path, RecorderModeRecord)
+	assert.NoError(t, err)
+	rec.Scrub = scrub
+	_, err = newRecorderCustomerClient(rec, ts.URL).New(&stripe.CustomerParams{Email: stripe.String("jenny@example.com")})
+	assert.NoError(t, err)
+	assert.NoError(t, rec.Close())
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "jenny")
+
+	// Requests are scrubbed the same way before they're matched
+	rec, err = NewRecorder(path, RecorderModeReplay)
+	assert.NoError(t, err)
+	rec.Scrub = scrub
+	cus, err := newRecorderCustomerClient(rec, ts.URL).New(&stripe.CustomerParams{Email: stripe.String("jenny@example.com")})
+	assert.NoError(t, err)
+	assert.Equal(t, "redacted@example.com", cus.Email)
+}
+
+func TestScrubSecrets(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{"Bearer sk_test_abc123", "Bearer sk_test_REDACTED"},
+		{"Bearer sk_live_abc123", "Bearer sk_live_REDACTED"},
+		{"rk_test_abc123", "rk_test_REDACTED"},
+		{`{"secret":"ek_test_YWNjdF8xMjM"}`, `{"secret":"ek_test_REDACTED"}`},
+		{`{"secret":"whsec_abc123"}`, `{"secret":"whsec_REDACTED"}`},
+		{`{"client_secret":"pi_123_secret_abc456"}`, `{"client_secret":"pi_123_secret_REDACTED"}`},
+		{"client_secret=seti_123_secret_abc456", "client_secret=seti_123_secret_REDACTED"},
+		{`{"id":"pi_123","customer":"cus_123"}`, `{"id":"pi_123","customer":"cus_123"}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			assert.Equal(t, tc.want, scrubSecrets(tc.in))
+		})
+	}
+}
+
+func TestNewRecorder_MissingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join("testdata", "missing.json"), RecorderModeReplay)
+	assert.Error(t, err)
+}
+
+func newRecorderCustomerClient(rec *Recorder, url string) customer.Client {
+	return customer.Client{
+		B: stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{
+			HTTPClient:        rec.HTTPClient(),
+			LeveledLogger:     &stripe.LeveledLogger{Level: stripe.LevelNull},
+			MaxNetworkRetries: stripe.Int64(0),
+			URL:               stripe.String(url),
+		}),
+		Key: "sk_test_abc123",
+	}
+}
@@ -0,0 +1,186 @@
+// Package stripetest provides helpers for testing code that uses stripe-go.
+package stripetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+//
+// Public types
+//
+
+// RoundTripResult is the outcome of checking a single JSON payload with
+// RoundTripDir.
+type RoundTripResult struct {
+	// Err is set if the payload couldn't be decoded, or if re-encoding the
+	// decoded value and decoding it again didn't produce the same value.
+	Err error
+
+	// File is the path of the payload.
+	File string
+
+	// UnknownFields lists the fields of the payload that were dropped when
+	// decoding it, presumably because the library doesn't know about them.
+	// Fields are given as dotted paths, with `[]` standing in for array
+	// indexes, like `lines.data[].new_field`.
+	UnknownFields []string
+}
+
+//
+// Public functions
+//
+
+// AssertRoundTrip runs RoundTripDir and reports an error on t for every
+// payload that can't be decoded or isn't stable across a round trip. Unknown
+// fields are only logged, since payloads rendered with a newer API version
+// than the library's are expected to contain some.
+//
+// It's meant to be used to check a pinned version of the library against
+// payloads captured from the API version an integration is pinned to:
+//
+//	func TestInvoicePayloads(t *testing.T) {
+//		stripetest.AssertRoundTrip(t, "testdata/invoices", func() interface{} {
+//			return &stripe.Invoice{}
+//		})
+//	}
+func AssertRoundTrip(t testing.TB, dir string, newValue func() interface{}) {
+	t.Helper()
+
+	results, err := RoundTripDir(dir, newValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) == 0 {
+		t.Fatalf("no JSON payloads found in %s", dir)
+	}
+
+	for _, result := range results {
+		if result.Err != nil {
+			t.Errorf("%s: %v", result.File, result.Err)
+		}
+		for _, field := range result.UnknownFields {
+			t.Logf("%s: unknown field %s", result.File, field)
+		}
+	}
+}
+
+// RoundTrip decodes a JSON payload into the value returned by newValue,
+// encodes it and decodes the result again, and returns an error if the two
+// encodings differ. It also returns the fields of the payload that were lost
+// during decoding.
+//
+// Expandable fields that are IDs in the payload are encoded as objects, so
+// only fields of objects that were expanded in the payload are checked.
+func RoundTrip(data []byte, newValue func() interface{}) ([]string, error) {
+	value := newValue()
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, fmt.Errorf("error decoding payload: %v", err)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding decoded value: %v", err)
+	}
+
+	again := newValue()
+	if err := json.Unmarshal(encoded, again); err != nil {
+		return nil, fmt.Errorf("error decoding re-encoded value: %v", err)
+	}
+	reencoded, err := json.Marshal(again)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding re-decoded value: %v", err)
+	}
+	if !bytes.Equal(encoded, reencoded) {
+		return nil, fmt.Errorf("value changed after a round trip:\n%s\nbecame:\n%s", encoded, reencoded)
+	}
+
+	var original, kept interface{}
+	if err := json.Unmarshal(data, &original); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(encoded, &kept); err != nil {
+		return nil, err
+	}
+
+	unknown := map[string]struct{}{}
+	findUnknownFields(original, kept, "", unknown)
+
+	fields := make([]string, 0, len(unknown))
+	for field := range unknown {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// RoundTripDir runs RoundTrip on every `.json` file in dir, in lexical order.
+// An error is only returned if the directory can't be read; problems with
+// individual payloads are reported in the results.
+func RoundTripDir(dir string, newValue func() interface{}) ([]*RoundTripResult, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	results := make([]*RoundTripResult, 0, len(files))
+	for _, file := range files {
+		result := &RoundTripResult{File: file}
+		results = append(results, result)
+
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		result.UnknownFields, result.Err = RoundTrip(data, newValue)
+	}
+	return results, nil
+}
+
+//
+// Private functions
+//
+
+func findUnknownFields(original, kept interface{}, path string, unknown map[string]struct{}) {
+	switch o := original.(type) {
+	case map[string]interface{}:
+		k, ok := kept.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for key, value := range o {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			keptValue, ok := k[key]
+			if !ok {
+				// Lists don't keep their `object`, which is always `list`
+				// or `search_result`
+				if key == "object" && (value == "list" || value == "search_result") {
+					continue
+				}
+				unknown[keyPath] = struct{}{}
+				continue
+			}
+			findUnknownFields(value, keptValue, keyPath, unknown)
+		}
+
+	case []interface{}:
+		k, ok := kept.([]interface{})
+		if !ok {
+			return
+		}
+		for i, value := range o {
+			if i < len(k) {
+				findUnknownFields(value, k[i], path+"[]", unknown)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package stripetest
+
+import (
+	"path/filepath"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+func newInvoice() interface{} {
+	return &stripe.Invoice{}
+}
+
+func TestRoundTrip(t *testing.T) {
+	unknown, err := RoundTrip([]byte(`{"id": "in_123", "customer": "cus_123"}`), newInvoice)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, unknown)
+
+	unknown, err = RoundTrip([]byte(`{"id": "in_123", "new_field": 1, "lines": {"data": [{"id": "il_1", "a": 1}, {"id": "il_2", "a": 2}]}}`), newInvoice)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"lines.data[].a", "new_field"}, unknown)
+
+	_, err = RoundTrip([]byte(`{"id": 123}`), newInvoice)
+	assert.Error(t, err)
+}
+
+func TestRoundTripDir(t *testing.T) {
+	results, err := RoundTripDir(filepath.Join("testdata", "invoices"), newInvoice)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(results))
+
+	assert.Equal(t, filepath.Join("testdata", "invoices", "in_basic.json"), results[0].File)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, []string{}, results[0].UnknownFields)
+
+	assert.NoError(t, results[1].Err)
+	assert.Equal(t, []string{"lines.data[].not_yet_released", "shiny_new_field"}, results[1].UnknownFields)
+}
+
+func TestAssertRoundTrip(t *testing.T) {
+	AssertRoundTrip(t, filepath.Join("testdata", "invoices"), newInvoice)
+}
@@ -77,6 +77,19 @@ func (c Client) Cancel(id string, params *stripe.SubscriptionCancelParams) (*str
 	return subscription, err
 }
 
+// Resume resumes a paused subscription.
+func Resume(id string, params *stripe.SubscriptionResumeParams) (*stripe.Subscription, error) {
+	return getC().Resume(id, params)
+}
+
+// Resume resumes a paused subscription.
+func (c Client) Resume(id string, params *stripe.SubscriptionResumeParams) (*stripe.Subscription, error) {
+	path := stripe.FormatURLPath("/v1/subscriptions/%s/resume", id)
+	subscription := &stripe.Subscription{}
+	err := c.B.Call(http.MethodPost, path, c.Key, params, subscription)
+	return subscription, err
+}
+
 // List returns a list of subscriptions.
 func List(params *stripe.SubscriptionListParams) *Iter {
 	return getC().List(params)
@@ -156,5 +169,5 @@ func (i *SearchIter) SubscriptionSearchResult() *stripe.SubscriptionSearchResult
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
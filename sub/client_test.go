@@ -90,6 +90,15 @@ func TestSubscriptionNew_WithItems(t *testing.T) {
 	assert.NotNil(t, subscription)
 }
 
+func TestSubscriptionResume(t *testing.T) {
+	subscription, err := Resume("sub_123", &stripe.SubscriptionResumeParams{
+		BillingCycleAnchor: stripe.String(string(stripe.SubscriptionResumeBillingCycleAnchorNow)),
+		ProrationBehavior:  stripe.String(string(stripe.SubscriptionProrationBehaviorNone)),
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, subscription)
+}
+
 func TestSubscriptionUpdate(t *testing.T) {
 	params := &stripe.SubscriptionParams{
 		ProrationBehavior: stripe.String(string(stripe.SubscriptionProrationBehaviorNone)),
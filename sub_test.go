@@ -54,3 +54,87 @@ func TestSubscription_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, "sub_123", v.ID)
 	}
 }
+
+func TestSubscriptionAutomaticTax(t *testing.T) {
+	{
+		params := &SubscriptionParams{
+			AutomaticTax: &SubscriptionAutomaticTaxParams{
+				Enabled: Bool(true),
+				Liability: &SubscriptionAutomaticTaxLiabilityParams{
+					Type: String(string(SubscriptionAutomaticTaxLiabilityTypeSelf)),
+				},
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"true"}, body.Get("automatic_tax[enabled]"))
+		assert.Equal(t, []string{"self"}, body.Get("automatic_tax[liability][type]"))
+	}
+
+	{
+		data := []byte(`{"id": "sub_123", "automatic_tax": {"enabled": true, "liability": {"type": "self"}}}`)
+
+		var v Subscription
+		err := json.Unmarshal(data, &v)
+		assert.NoError(t, err)
+		assert.True(t, v.AutomaticTax.Enabled)
+		assert.Nil(t, v.AutomaticTax.Liability.Account)
+		assert.Equal(t, SubscriptionAutomaticTaxLiabilityTypeSelf, v.AutomaticTax.Liability.Type)
+	}
+}
+
+func TestSubscriptionTrialSettings(t *testing.T) {
+	{
+		params := &SubscriptionParams{
+			TrialPeriodDays: Int64(14),
+			TrialSettings: &SubscriptionTrialSettingsParams{
+				EndBehavior: &SubscriptionTrialSettingsEndBehaviorParams{
+					MissingPaymentMethod: String(string(SubscriptionTrialSettingsEndBehaviorMissingPaymentMethodPause)),
+				},
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"pause"}, body.Get("trial_settings[end_behavior][missing_payment_method]"))
+	}
+
+	{
+		data := []byte(`{"id": "sub_123", "trial_settings": {"end_behavior": {"missing_payment_method": "create_invoice"}}}`)
+
+		var v Subscription
+		err := json.Unmarshal(data, &v)
+		assert.NoError(t, err)
+		assert.Equal(t, SubscriptionTrialSettingsEndBehaviorMissingPaymentMethodCreateInvoice, v.TrialSettings.EndBehavior.MissingPaymentMethod)
+	}
+}
+
+func TestSubscriptionPendingInvoiceItemInterval(t *testing.T) {
+	{
+		params := &SubscriptionParams{
+			DaysUntilDue: Int64(14),
+			PendingInvoiceItemInterval: &SubscriptionPendingInvoiceItemIntervalParams{
+				Interval:      String(string(SubscriptionPendingInvoiceItemIntervalIntervalWeek)),
+				IntervalCount: Int64(2),
+			},
+		}
+		body := &form.Values{}
+		form.AppendTo(body, params)
+		t.Logf("body = %+v", body)
+		assert.Equal(t, []string{"14"}, body.Get("days_until_due"))
+		assert.Equal(t, []string{"week"}, body.Get("pending_invoice_item_interval[interval]"))
+		assert.Equal(t, []string{"2"}, body.Get("pending_invoice_item_interval[interval_count]"))
+	}
+
+	{
+		data := []byte(`{"id": "sub_123", "days_until_due": 14, "pending_invoice_item_interval": {"interval": "week", "interval_count": 2}}`)
+
+		var v Subscription
+		err := json.Unmarshal(data, &v)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(14), v.DaysUntilDue)
+		assert.Equal(t, SubscriptionPendingInvoiceItemIntervalIntervalWeek, v.PendingInvoiceItemInterval.Interval)
+		assert.Equal(t, int64(2), v.PendingInvoiceItemInterval.IntervalCount)
+	}
+}
@@ -138,10 +138,20 @@ type SubscriptionSchedulePhaseAddInvoiceItemParams struct {
 	TaxRates []*string `form:"tax_rates"`
 }
 
+// The account that's liable for tax. If set, the business address and tax registrations required to perform the tax calculation are loaded from this account. The tax transaction is returned in the report of the connected account.
+type SubscriptionSchedulePhaseAutomaticTaxLiabilityParams struct {
+	// The connected account being referenced when `type` is `account`.
+	Account *string `form:"account"`
+	// Type of the account referenced in the request.
+	Type *string `form:"type"`
+}
+
 // Automatic tax settings for this phase.
 type SubscriptionSchedulePhaseAutomaticTaxParams struct {
 	// Enabled automatic tax calculation which will automatically compute tax rates on all invoices generated by the subscription.
 	Enabled *bool `form:"enabled"`
+	// The account that's liable for tax. If set, the business address and tax registrations required to perform the tax calculation are loaded from this account. The tax transaction is returned in the report of the connected account.
+	Liability *SubscriptionSchedulePhaseAutomaticTaxLiabilityParams `form:"liability"`
 }
 
 // List of configuration items, each with an attached price, to apply during this phase of the subscription schedule.
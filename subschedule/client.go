@@ -130,5 +130,5 @@ func (i *Iter) SubscriptionScheduleList() *stripe.SubscriptionScheduleList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -0,0 +1,64 @@
+package calculation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestTaxCalculationNew(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/tax/calculations", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "usd", r.PostForm.Get("currency"))
+		assert.Equal(t, "US", r.PostForm.Get("customer_details[address][country]"))
+		assert.Equal(t, "1000", r.PostForm.Get("line_items[0][amount]"))
+		assert.Equal(t, "L1", r.PostForm.Get("line_items[0][reference]"))
+		w.Write([]byte(`{
+			"id": "taxcalc_123",
+			"object": "tax.calculation",
+			"amount_total": 1085,
+			"currency": "usd",
+			"tax_amount_exclusive": 85,
+			"tax_breakdown": [
+				{
+					"amount": 85,
+					"inclusive": false,
+					"tax_rate_details": {"country": "US", "percentage_decimal": "8.5", "state": "NY", "tax_type": "sales_tax"},
+					"taxability_reason": "standard_rated",
+					"taxable_amount": 1000
+				}
+			]
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+
+	calculation, err := c.New(&stripe.TaxCalculationParams{
+		Currency: stripe.String(string(stripe.CurrencyUSD)),
+		CustomerDetails: &stripe.TaxCalculationCustomerDetailsParams{
+			Address: &stripe.AddressParams{
+				Country:    stripe.String("US"),
+				PostalCode: stripe.String("10001"),
+			},
+			AddressSource: stripe.String(string(stripe.TaxCalculationCustomerDetailsAddressSourceShipping)),
+		},
+		LineItems: []*stripe.TaxCalculationLineItemParams{
+			{
+				Amount:    stripe.Int64(1000),
+				Reference: stripe.String("L1"),
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1085), calculation.AmountTotal)
+	assert.Equal(t, 1, len(calculation.TaxBreakdown))
+	assert.Equal(t, stripe.TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeSalesTax, calculation.TaxBreakdown[0].TaxRateDetails.TaxType)
+	assert.Equal(t, stripe.TaxCalculationTaxBreakdownTaxabilityReasonStandardRated, calculation.TaxBreakdown[0].TaxabilityReason)
+}
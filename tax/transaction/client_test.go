@@ -0,0 +1,63 @@
+package transaction
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	"github.com/stripe/stripe-go/v72/stripetest"
+)
+
+func TestTaxTransactionCreateReversal(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/tax/transactions/create_reversal", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "full", r.PostForm.Get("mode"))
+		assert.Equal(t, "tax_123", r.PostForm.Get("original_transaction"))
+		w.Write([]byte(`{
+			"id": "tax_456",
+			"object": "tax.transaction",
+			"reference": "myOrder_123-refund_1",
+			"reversal": {"original_transaction": "tax_123"},
+			"type": "reversal"
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	transaction, err := c.CreateReversal(&stripe.TaxTransactionCreateReversalParams{
+		Mode:                stripe.String("full"),
+		OriginalTransaction: stripe.String("tax_123"),
+		Reference:           stripe.String("myOrder_123-refund_1"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, stripe.TaxTransactionTypeReversal, transaction.Type)
+	assert.Equal(t, "tax_123", transaction.Reversal.OriginalTransaction)
+}
+
+func TestTaxTransactionListLineItems(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/tax/transactions/tax_123/line_items", r.URL.Path)
+		w.Write([]byte(`{
+			"object": "list",
+			"has_more": false,
+			"data": [
+				{"id": "tax_li_123", "object": "tax.transaction_line_item", "amount": 1000, "amount_tax": 85, "type": "transaction"}
+			]
+		}`))
+	}))
+	defer ts.Close()
+
+	c := Client{B: stripetest.NewBackend(ts.URL), Key: "sk_test_123"}
+	i := c.ListLineItems("tax_123", &stripe.TaxTransactionListLineItemsParams{})
+
+	assert.True(t, i.Next())
+	assert.Equal(t, int64(85), i.TaxTransactionLineItem().AmountTax)
+	assert.Equal(t, stripe.TaxTransactionLineItemTypeTransaction, i.TaxTransactionLineItem().Type)
+	assert.NotNil(t, i.TaxTransactionLineItemList())
+	assert.False(t, i.Next())
+	assert.Nil(t, i.Err())
+}
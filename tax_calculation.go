@@ -0,0 +1,240 @@
+//
+//
+// File generated from our OpenAPI spec
+//
+//
+
+package stripe
+
+// The type of customer address provided.
+type TaxCalculationCustomerDetailsAddressSource string
+
+// List of values that TaxCalculationCustomerDetailsAddressSource can take
+const (
+	TaxCalculationCustomerDetailsAddressSourceBilling  TaxCalculationCustomerDetailsAddressSource = "billing"
+	TaxCalculationCustomerDetailsAddressSourceShipping TaxCalculationCustomerDetailsAddressSource = "shipping"
+)
+
+// The taxability override used for taxation.
+type TaxCalculationCustomerDetailsTaxabilityOverride string
+
+// List of values that TaxCalculationCustomerDetailsTaxabilityOverride can take
+const (
+	TaxCalculationCustomerDetailsTaxabilityOverrideCustomerExempt TaxCalculationCustomerDetailsTaxabilityOverride = "customer_exempt"
+	TaxCalculationCustomerDetailsTaxabilityOverrideNone           TaxCalculationCustomerDetailsTaxabilityOverride = "none"
+	TaxCalculationCustomerDetailsTaxabilityOverrideReverseCharge  TaxCalculationCustomerDetailsTaxabilityOverride = "reverse_charge"
+)
+
+// Specifies whether the `amount` includes taxes. If `tax_behavior=inclusive`, then the amount includes taxes.
+type TaxCalculationShippingCostTaxBehavior string
+
+// List of values that TaxCalculationShippingCostTaxBehavior can take
+const (
+	TaxCalculationShippingCostTaxBehaviorExclusive TaxCalculationShippingCostTaxBehavior = "exclusive"
+	TaxCalculationShippingCostTaxBehaviorInclusive TaxCalculationShippingCostTaxBehavior = "inclusive"
+)
+
+// The tax type, such as `vat` or `sales_tax`.
+type TaxCalculationTaxBreakdownTaxRateDetailsTaxType string
+
+// List of values that TaxCalculationTaxBreakdownTaxRateDetailsTaxType can take
+const (
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeAmusementTax      TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "amusement_tax"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeCommunicationsTax TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "communications_tax"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeGST               TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "gst"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeHST               TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "hst"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeIGST              TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "igst"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeJCT               TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "jct"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeLeaseTax          TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "lease_tax"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypePST               TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "pst"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeQST               TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "qst"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeRST               TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "rst"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeSalesTax          TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "sales_tax"
+	TaxCalculationTaxBreakdownTaxRateDetailsTaxTypeVAT               TaxCalculationTaxBreakdownTaxRateDetailsTaxType = "vat"
+)
+
+// The reasoning behind this tax, for example, if the product is tax exempt. We might extend the possible values for this field to support new tax rules.
+type TaxCalculationTaxBreakdownTaxabilityReason string
+
+// List of values that TaxCalculationTaxBreakdownTaxabilityReason can take
+const (
+	TaxCalculationTaxBreakdownTaxabilityReasonCustomerExempt       TaxCalculationTaxBreakdownTaxabilityReason = "customer_exempt"
+	TaxCalculationTaxBreakdownTaxabilityReasonNotCollecting        TaxCalculationTaxBreakdownTaxabilityReason = "not_collecting"
+	TaxCalculationTaxBreakdownTaxabilityReasonNotSubjectToTax      TaxCalculationTaxBreakdownTaxabilityReason = "not_subject_to_tax"
+	TaxCalculationTaxBreakdownTaxabilityReasonNotSupported         TaxCalculationTaxBreakdownTaxabilityReason = "not_supported"
+	TaxCalculationTaxBreakdownTaxabilityReasonPortionProductExempt TaxCalculationTaxBreakdownTaxabilityReason = "portion_product_exempt"
+	TaxCalculationTaxBreakdownTaxabilityReasonPortionReducedRated  TaxCalculationTaxBreakdownTaxabilityReason = "portion_reduced_rated"
+	TaxCalculationTaxBreakdownTaxabilityReasonPortionStandardRated TaxCalculationTaxBreakdownTaxabilityReason = "portion_standard_rated"
+	TaxCalculationTaxBreakdownTaxabilityReasonProductExempt        TaxCalculationTaxBreakdownTaxabilityReason = "product_exempt"
+	TaxCalculationTaxBreakdownTaxabilityReasonProductExemptHoliday TaxCalculationTaxBreakdownTaxabilityReason = "product_exempt_holiday"
+	TaxCalculationTaxBreakdownTaxabilityReasonProportionallyRated  TaxCalculationTaxBreakdownTaxabilityReason = "proportionally_rated"
+	TaxCalculationTaxBreakdownTaxabilityReasonReducedRated         TaxCalculationTaxBreakdownTaxabilityReason = "reduced_rated"
+	TaxCalculationTaxBreakdownTaxabilityReasonReverseCharge        TaxCalculationTaxBreakdownTaxabilityReason = "reverse_charge"
+	TaxCalculationTaxBreakdownTaxabilityReasonStandardRated        TaxCalculationTaxBreakdownTaxabilityReason = "standard_rated"
+	TaxCalculationTaxBreakdownTaxabilityReasonTaxableBasisReduced  TaxCalculationTaxBreakdownTaxabilityReason = "taxable_basis_reduced"
+	TaxCalculationTaxBreakdownTaxabilityReasonZeroRated            TaxCalculationTaxBreakdownTaxabilityReason = "zero_rated"
+)
+
+// The customer's tax IDs.
+type TaxCalculationCustomerDetailsTaxIDParams struct {
+	// Type of the tax ID.
+	Type *string `form:"type"`
+	// Value of the tax ID.
+	Value *string `form:"value"`
+}
+
+// Details about the customer, including address and tax IDs.
+type TaxCalculationCustomerDetailsParams struct {
+	// The customer's postal address (for example, home or business location).
+	Address *AddressParams `form:"address"`
+	// The type of customer address provided.
+	AddressSource *string `form:"address_source"`
+	// The customer's IP address (IPv4 or IPv6).
+	IPAddress *string `form:"ip_address"`
+	// The customer's tax IDs. Stripe Tax might consider a transaction with applicable tax IDs to be B2B, which might affect the tax calculation result. Stripe Tax doesn't validate tax IDs for correctness.
+	TaxIDs []*TaxCalculationCustomerDetailsTaxIDParams `form:"tax_ids"`
+	// Overrides the tax calculation result to allow you to not collect tax from your customer. Use this if you've manually checked your customer's tax exemptions.
+	TaxabilityOverride *string `form:"taxability_override"`
+}
+
+// A list of items the customer is purchasing.
+type TaxCalculationLineItemParams struct {
+	// A positive integer in cents representing the line item's total price. If `tax_behavior=inclusive`, then this amount includes taxes. Otherwise, taxes are calculated on top of this amount.
+	Amount *int64 `form:"amount"`
+	// If provided, the product's `tax_code` will be used as the line item's `tax_code`.
+	Product *string `form:"product"`
+	// The number of units of the item being purchased. Used to calculate the per-unit price from the total `amount` for the line. For example, if `amount=100` and `quantity=4`, the calculated unit price is 25.
+	Quantity *int64 `form:"quantity"`
+	// A custom identifier for this line item, which must be unique across the line items in the calculation. The reference helps identify each line item in exported [tax reports](https://stripe.com/docs/tax/reports).
+	Reference *string `form:"reference"`
+	// Specifies whether the `amount` includes taxes. Defaults to `exclusive`.
+	TaxBehavior *string `form:"tax_behavior"`
+	// A [tax code](https://stripe.com/docs/tax/tax-categories) ID to use for this line item. If not provided, we will use the tax code from the provided `product` param. If neither `tax_code` nor `product` is provided, we will use the default tax code from your Tax Settings.
+	TaxCode *string `form:"tax_code"`
+}
+
+// Shipping cost details to be used for the calculation.
+type TaxCalculationShippingCostParams struct {
+	// A positive integer in cents representing the shipping charge. If `tax_behavior=inclusive`, then this amount includes taxes. Otherwise, taxes are calculated on top of this amount.
+	Amount *int64 `form:"amount"`
+	// If provided, the [shipping rate](https://stripe.com/docs/api/shipping_rates/object)'s `amount`, `tax_code` and `tax_behavior` are used. If you provide a shipping rate, then you cannot pass the `amount`, `tax_code`, or `tax_behavior` parameters.
+	ShippingRate *string `form:"shipping_rate"`
+	// Specifies whether the `amount` includes taxes. If `tax_behavior=inclusive`, then the amount includes taxes. Defaults to `exclusive`.
+	TaxBehavior *string `form:"tax_behavior"`
+	// The [tax code](https://stripe.com/docs/tax/tax-categories) used to calculate tax on shipping. If not provided, the default shipping tax code from your [Tax Settings](https://stripe.com/settings/tax) is used.
+	TaxCode *string `form:"tax_code"`
+}
+
+// Calculates tax based on input and returns a Tax `Calculation` object.
+type TaxCalculationParams struct {
+	Params `form:"*"`
+	// Three-letter [ISO currency code](https://www.iso.org/iso-4217-currency-codes.html), in lowercase. Must be a [supported currency](https://stripe.com/docs/currencies).
+	Currency *string `form:"currency"`
+	// The ID of an existing customer to use for this calculation. If provided, the customer's address and tax IDs are copied to `customer_details`.
+	Customer *string `form:"customer"`
+	// Details about the customer, including address and tax IDs.
+	CustomerDetails *TaxCalculationCustomerDetailsParams `form:"customer_details"`
+	// A list of items the customer is purchasing.
+	LineItems []*TaxCalculationLineItemParams `form:"line_items"`
+	// Shipping cost details to be used for the calculation.
+	ShippingCost *TaxCalculationShippingCostParams `form:"shipping_cost"`
+	// Timestamp of date at which the tax rules and rates in effect applies for the calculation. Measured in seconds since the Unix epoch. Can be up to 48 hours in the past, and up to 48 hours in the future.
+	TaxDate *int64 `form:"tax_date"`
+}
+
+// Retrieves the line items of a persisted tax calculation as a collection.
+type TaxCalculationListLineItemsParams struct {
+	ListParams  `form:"*"`
+	Calculation *string `form:"-"` // Included in URL
+}
+
+// The customer's tax IDs (for example, EU VAT numbers).
+type TaxCalculationCustomerDetailsTaxID struct {
+	// The type of the tax ID.
+	Type TaxIDType `json:"type"`
+	// The value of the tax ID.
+	Value string `json:"value"`
+}
+type TaxCalculationCustomerDetails struct {
+	// The customer's postal address (for example, home or business location).
+	Address *Address `json:"address"`
+	// The type of customer address provided.
+	AddressSource TaxCalculationCustomerDetailsAddressSource `json:"address_source"`
+	// The customer's IP address (IPv4 or IPv6).
+	IPAddress string `json:"ip_address"`
+	// The customer's tax IDs (for example, EU VAT numbers).
+	TaxIDs []*TaxCalculationCustomerDetailsTaxID `json:"tax_ids"`
+	// The taxability override used for taxation.
+	TaxabilityOverride TaxCalculationCustomerDetailsTaxabilityOverride `json:"taxability_override"`
+}
+
+// The shipping cost details for the calculation.
+type TaxCalculationShippingCost struct {
+	// The shipping amount in integer cents. If `tax_behavior=inclusive`, then this amount includes taxes. Otherwise, taxes were calculated on top of this amount.
+	Amount int64 `json:"amount"`
+	// The amount of tax calculated for shipping, in integer cents.
+	AmountTax int64 `json:"amount_tax"`
+	// The ID of an existing [ShippingRate](https://stripe.com/docs/api/shipping_rates/object).
+	ShippingRate string `json:"shipping_rate"`
+	// Specifies whether the `amount` includes taxes. If `tax_behavior=inclusive`, then the amount includes taxes.
+	TaxBehavior TaxCalculationShippingCostTaxBehavior `json:"tax_behavior"`
+	// The [tax code](https://stripe.com/docs/tax/tax-categories) ID used for shipping.
+	TaxCode string `json:"tax_code"`
+}
+type TaxCalculationTaxBreakdownTaxRateDetails struct {
+	// Two-letter country code ([ISO 3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2)).
+	Country string `json:"country"`
+	// The tax rate percentage as a string. For example, 8.5% is represented as `"8.5"`.
+	PercentageDecimal string `json:"percentage_decimal"`
+	// State, county, province, or region.
+	State string `json:"state"`
+	// The tax type, such as `vat` or `sales_tax`.
+	TaxType TaxCalculationTaxBreakdownTaxRateDetailsTaxType `json:"tax_type"`
+}
+
+// Breakdown of individual tax amounts that add up to the total.
+type TaxCalculationTaxBreakdown struct {
+	// The amount of tax, in integer cents.
+	Amount int64 `json:"amount"`
+	// Specifies whether the tax amount is included in the line item amount.
+	Inclusive      bool                                      `json:"inclusive"`
+	TaxRateDetails *TaxCalculationTaxBreakdownTaxRateDetails `json:"tax_rate_details"`
+	// The reasoning behind this tax, for example, if the product is tax exempt. We might extend the possible values for this field to support new tax rules.
+	TaxabilityReason TaxCalculationTaxBreakdownTaxabilityReason `json:"taxability_reason"`
+	// The amount on which tax is calculated, in integer cents.
+	TaxableAmount int64 `json:"taxable_amount"`
+}
+
+// A Tax Calculation allows you to calculate the tax to collect from your customer.
+//
+// Related guide: [Calculate tax in your custom payment flow](https://stripe.com/docs/tax/custom)
+type TaxCalculation struct {
+	APIResource
+	// Total after taxes.
+	AmountTotal int64 `json:"amount_total"`
+	// Three-letter [ISO currency code](https://www.iso.org/iso-4217-currency-codes.html), in lowercase. Must be a [supported currency](https://stripe.com/docs/currencies).
+	Currency Currency `json:"currency"`
+	// The ID of an existing [Customer](https://stripe.com/docs/api/customers/object) used for the resource.
+	Customer        string                         `json:"customer"`
+	CustomerDetails *TaxCalculationCustomerDetails `json:"customer_details"`
+	// Timestamp of date at which the tax calculation will expire.
+	ExpiresAt int64 `json:"expires_at"`
+	// Unique identifier for the calculation.
+	ID string `json:"id"`
+	// The list of items the customer is purchasing.
+	LineItems *TaxCalculationLineItemList `json:"line_items"`
+	// Has the value `true` if the object exists in live mode or the value `false` if the object exists in test mode.
+	Livemode bool `json:"livemode"`
+	// String representing the object's type. Objects of the same type share the same value.
+	Object string `json:"object"`
+	// The shipping cost details for the calculation.
+	ShippingCost *TaxCalculationShippingCost `json:"shipping_cost"`
+	// The amount of tax to be collected on top of the line item prices.
+	TaxAmountExclusive int64 `json:"tax_amount_exclusive"`
+	// The amount of tax already included in the line item prices.
+	TaxAmountInclusive int64 `json:"tax_amount_inclusive"`
+	// Breakdown of individual tax amounts that add up to the total.
+	TaxBreakdown []*TaxCalculationTaxBreakdown `json:"tax_breakdown"`
+	// Timestamp of date at which the tax rules and rates in effect applies for the calculation.
+	TaxDate int64 `json:"tax_date"`
+}
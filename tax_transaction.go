@@ -0,0 +1,179 @@
+//
+//
+// File generated from our OpenAPI spec
+//
+//
+
+package stripe
+
+// The type of customer address provided.
+type TaxTransactionCustomerDetailsAddressSource string
+
+// List of values that TaxTransactionCustomerDetailsAddressSource can take
+const (
+	TaxTransactionCustomerDetailsAddressSourceBilling  TaxTransactionCustomerDetailsAddressSource = "billing"
+	TaxTransactionCustomerDetailsAddressSourceShipping TaxTransactionCustomerDetailsAddressSource = "shipping"
+)
+
+// The taxability override used for taxation.
+type TaxTransactionCustomerDetailsTaxabilityOverride string
+
+// List of values that TaxTransactionCustomerDetailsTaxabilityOverride can take
+const (
+	TaxTransactionCustomerDetailsTaxabilityOverrideCustomerExempt TaxTransactionCustomerDetailsTaxabilityOverride = "customer_exempt"
+	TaxTransactionCustomerDetailsTaxabilityOverrideNone           TaxTransactionCustomerDetailsTaxabilityOverride = "none"
+	TaxTransactionCustomerDetailsTaxabilityOverrideReverseCharge  TaxTransactionCustomerDetailsTaxabilityOverride = "reverse_charge"
+)
+
+// Specifies whether the `amount` includes taxes. If `tax_behavior=inclusive`, then the amount includes taxes.
+type TaxTransactionShippingCostTaxBehavior string
+
+// List of values that TaxTransactionShippingCostTaxBehavior can take
+const (
+	TaxTransactionShippingCostTaxBehaviorExclusive TaxTransactionShippingCostTaxBehavior = "exclusive"
+	TaxTransactionShippingCostTaxBehaviorInclusive TaxTransactionShippingCostTaxBehavior = "inclusive"
+)
+
+// If `reversal`, this transaction reverses an earlier transaction.
+type TaxTransactionType string
+
+// List of values that TaxTransactionType can take
+const (
+	TaxTransactionTypeReversal    TaxTransactionType = "reversal"
+	TaxTransactionTypeTransaction TaxTransactionType = "transaction"
+)
+
+// Retrieves a Tax Transaction object.
+type TaxTransactionParams struct {
+	Params `form:"*"`
+}
+
+// Creates a Tax Transaction from a calculation.
+type TaxTransactionCreateFromCalculationParams struct {
+	Params `form:"*"`
+	// Tax Calculation ID to be used as input when creating the transaction.
+	Calculation *string `form:"calculation"`
+	// A custom order or sale identifier, such as 'myOrder_123'. Must be unique across all transactions, including reversals.
+	Reference *string `form:"reference"`
+}
+
+// The line item amounts to reverse.
+type TaxTransactionCreateReversalLineItemParams struct {
+	// The amount to reverse, in negative integer cents.
+	Amount *int64 `form:"amount"`
+	// The amount of tax to reverse, in negative integer cents.
+	AmountTax *int64 `form:"amount_tax"`
+	// Set of [key-value pairs](https://stripe.com/docs/api/metadata) that you can attach to an object. This can be useful for storing additional information about the object in a structured format.
+	Metadata map[string]string `form:"metadata"`
+	// The `id` of the line item to reverse in the original transaction.
+	OriginalLineItem *string `form:"original_line_item"`
+	// The quantity reversed. Appears in [tax exports](https://stripe.com/docs/tax/reports), but does not affect the amount of tax reversed.
+	Quantity *int64 `form:"quantity"`
+	// A custom identifier for this line item in the reversal transaction, such as 'L1-refund'.
+	Reference *string `form:"reference"`
+}
+
+// The shipping cost to reverse.
+type TaxTransactionCreateReversalShippingCostParams struct {
+	// The amount to reverse, in negative integer cents.
+	Amount *int64 `form:"amount"`
+	// The amount of tax to reverse, in negative integer cents.
+	AmountTax *int64 `form:"amount_tax"`
+}
+
+// Partially or fully reverses a previously created Transaction.
+type TaxTransactionCreateReversalParams struct {
+	Params `form:"*"`
+	// A flat amount to reverse across the entire transaction, in negative integer cents. This value represents the total amount to refund from the transaction, including taxes.
+	FlatAmount *int64 `form:"flat_amount"`
+	// The line item amounts to reverse.
+	LineItems []*TaxTransactionCreateReversalLineItemParams `form:"line_items"`
+	// If `partial`, the provided line item or shipping cost amounts are reversed. If `full`, the original transaction is fully reversed.
+	Mode *string `form:"mode"`
+	// The ID of the Transaction to partially or fully reverse.
+	OriginalTransaction *string `form:"original_transaction"`
+	// A custom identifier for this reversal, such as `myOrder_123-refund_1`, which must be unique across all transactions. The reference helps identify this reversal transaction in exported [tax reports](https://stripe.com/docs/tax/reports).
+	Reference *string `form:"reference"`
+	// The shipping cost to reverse.
+	ShippingCost *TaxTransactionCreateReversalShippingCostParams `form:"shipping_cost"`
+}
+
+// Retrieves the line items of a committed standalone transaction as a collection.
+type TaxTransactionListLineItemsParams struct {
+	ListParams  `form:"*"`
+	Transaction *string `form:"-"` // Included in URL
+}
+
+// The customer's tax IDs (for example, EU VAT numbers).
+type TaxTransactionCustomerDetailsTaxID struct {
+	// The type of the tax ID.
+	Type TaxIDType `json:"type"`
+	// The value of the tax ID.
+	Value string `json:"value"`
+}
+type TaxTransactionCustomerDetails struct {
+	// The customer's postal address (for example, home or business location).
+	Address *Address `json:"address"`
+	// The type of customer address provided.
+	AddressSource TaxTransactionCustomerDetailsAddressSource `json:"address_source"`
+	// The customer's IP address (IPv4 or IPv6).
+	IPAddress string `json:"ip_address"`
+	// The customer's tax IDs (for example, EU VAT numbers).
+	TaxIDs []*TaxTransactionCustomerDetailsTaxID `json:"tax_ids"`
+	// The taxability override used for taxation.
+	TaxabilityOverride TaxTransactionCustomerDetailsTaxabilityOverride `json:"taxability_override"`
+}
+
+// If `type=reversal`, contains information about what was reversed.
+type TaxTransactionReversal struct {
+	// The `id` of the reversed `Transaction` object.
+	OriginalTransaction string `json:"original_transaction"`
+}
+
+// The shipping cost details for the transaction.
+type TaxTransactionShippingCost struct {
+	// The shipping amount in integer cents. If `tax_behavior=inclusive`, then this amount includes taxes. Otherwise, taxes were calculated on top of this amount.
+	Amount int64 `json:"amount"`
+	// The amount of tax calculated for shipping, in integer cents.
+	AmountTax int64 `json:"amount_tax"`
+	// The ID of an existing [ShippingRate](https://stripe.com/docs/api/shipping_rates/object).
+	ShippingRate string `json:"shipping_rate"`
+	// Specifies whether the `amount` includes taxes. If `tax_behavior=inclusive`, then the amount includes taxes.
+	TaxBehavior TaxTransactionShippingCostTaxBehavior `json:"tax_behavior"`
+	// The [tax code](https://stripe.com/docs/tax/tax-categories) ID used for shipping.
+	TaxCode string `json:"tax_code"`
+}
+
+// A Tax Transaction records the tax collected from or refunded to your customer.
+//
+// Related guide: [Calculate tax in your custom payment flow](https://stripe.com/docs/tax/custom#tax-transaction)
+type TaxTransaction struct {
+	APIResource
+	// Time at which the object was created. Measured in seconds since the Unix epoch.
+	Created int64 `json:"created"`
+	// Three-letter [ISO currency code](https://www.iso.org/iso-4217-currency-codes.html), in lowercase. Must be a [supported currency](https://stripe.com/docs/currencies).
+	Currency Currency `json:"currency"`
+	// The ID of an existing [Customer](https://stripe.com/docs/api/customers/object) used for the resource.
+	Customer        string                         `json:"customer"`
+	CustomerDetails *TaxTransactionCustomerDetails `json:"customer_details"`
+	// Unique identifier for the transaction.
+	ID string `json:"id"`
+	// The tax collected or refunded, by line item.
+	LineItems *TaxTransactionLineItemList `json:"line_items"`
+	// Has the value `true` if the object exists in live mode or the value `false` if the object exists in test mode.
+	Livemode bool `json:"livemode"`
+	// Set of [key-value pairs](https://stripe.com/docs/api/metadata) that you can attach to an object. This can be useful for storing additional information about the object in a structured format.
+	Metadata map[string]string `json:"metadata"`
+	// String representing the object's type. Objects of the same type share the same value.
+	Object string `json:"object"`
+	// A custom unique identifier, such as 'myOrder_123'.
+	Reference string `json:"reference"`
+	// If `type=reversal`, contains information about what was reversed.
+	Reversal *TaxTransactionReversal `json:"reversal"`
+	// The shipping cost details for the transaction.
+	ShippingCost *TaxTransactionShippingCost `json:"shipping_cost"`
+	// Timestamp of date at which the tax rules and rates in effect applies for the calculation.
+	TaxDate int64 `json:"tax_date"`
+	// If `reversal`, this transaction reverses an earlier transaction.
+	Type TaxTransactionType `json:"type"`
+}
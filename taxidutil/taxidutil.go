@@ -0,0 +1,354 @@
+// Package taxidutil provides offline format validation for tax IDs, so that
+// obviously malformed values can be rejected before creating a tax ID through
+// the API.
+//
+// Validation is only syntactic: a value that passes may still be rejected by
+// Stripe or fail verification against a government database.
+package taxidutil
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+//
+// Public types
+//
+
+// Reason describes why a tax ID failed validation.
+type Reason string
+
+// List of values that Reason can take.
+const (
+	ReasonEmpty              Reason = "empty"
+	ReasonInvalidChecksum    Reason = "invalid_checksum"
+	ReasonInvalidCountryCode Reason = "invalid_country_code"
+	ReasonInvalidFormat      Reason = "invalid_format"
+	ReasonInvalidPrefix      Reason = "invalid_prefix"
+)
+
+// ValidationError is returned by Validate when a tax ID is malformed.
+type ValidationError struct {
+	Reason Reason
+	Type   stripe.TaxIDType
+	Value  string
+}
+
+// Error serializes the error object to a string.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s tax ID %q: %s", e.Type, e.Value, e.Reason)
+}
+
+//
+// Public functions
+//
+
+// IsSupported reports whether Validate checks the format of the given tax ID
+// type.
+func IsSupported(typ stripe.TaxIDType) bool {
+	_, ok := validators[typ]
+	return ok
+}
+
+// Normalize uppercases a tax ID and removes the spaces, dots, dashes and
+// slashes commonly used to group its characters.
+func Normalize(value string) string {
+	return strings.ToUpper(separators.Replace(strings.TrimSpace(value)))
+}
+
+// Validate checks the format of a tax ID of the given type and returns a
+// *ValidationError describing the first problem found. The value is
+// normalized with Normalize first, so `12-3456789` is a valid `us_ein`.
+//
+// Values of types that aren't supported aren't checked beyond being
+// non-empty, so that new tax ID types are never rejected before Stripe has a
+// chance to validate them.
+func Validate(typ stripe.TaxIDType, value string) error {
+	normalized := Normalize(value)
+	if normalized == "" {
+		return &ValidationError{Reason: ReasonEmpty, Type: typ, Value: value}
+	}
+
+	validate, ok := validators[typ]
+	if !ok {
+		return nil
+	}
+	if reason := validate(normalized); reason != "" {
+		return &ValidationError{Reason: reason, Type: typ, Value: value}
+	}
+	return nil
+}
+
+//
+// Private types
+//
+
+// validator returns the reason a normalized value is invalid, or an empty
+// string if it's valid.
+type validator func(v string) Reason
+
+//
+// Private variables
+//
+
+var separators = strings.NewReplacer(" ", "", ".", "", "-", "", "/", "")
+
+var validators = map[stripe.TaxIDType]validator{
+	stripe.TaxIDTypeAUABN:    validateAUABN,
+	stripe.TaxIDTypeBRCNPJ:   validateBRCNPJ,
+	stripe.TaxIDTypeBRCPF:    validateBRCPF,
+	stripe.TaxIDTypeCABN:     pattern(`^\d{9}$`),
+	stripe.TaxIDTypeCHVAT:    pattern(`^CHE\d{9}(MWST|TVA|IVA)?$`),
+	stripe.TaxIDTypeEUOSSVAT: pattern(`^EU\d{9}$`),
+	stripe.TaxIDTypeEUVAT:    validateEUVAT,
+	stripe.TaxIDTypeGBVAT:    validateGBVAT,
+	stripe.TaxIDTypeINGST:    pattern(`^\d{2}[A-Z]{5}\d{4}[A-Z][1-9A-Z]Z[0-9A-Z]$`),
+	stripe.TaxIDTypeJPCN:     pattern(`^\d{13}$`),
+	stripe.TaxIDTypeKRBRN:    pattern(`^\d{10}$`),
+	stripe.TaxIDTypeMXRFC:    pattern(`^[A-Z&Ñ]{3,4}\d{6}[A-Z0-9]{3}$`),
+	stripe.TaxIDTypeNOVAT:    pattern(`^\d{9}MVA$`),
+	stripe.TaxIDTypeNZGST:    pattern(`^\d{8,9}$`),
+	stripe.TaxIDTypeUSEIN:    validateUSEIN,
+	stripe.TaxIDTypeZAVAT:    pattern(`^4\d{9}$`),
+}
+
+// euVATPatterns holds the format of the number following the country code of
+// each EU VAT ID. `XI` is used by businesses in Northern Ireland.
+var euVATPatterns = map[string]*regexp.Regexp{
+	"AT": regexp.MustCompile(`^U\d{8}$`),
+	"BE": regexp.MustCompile(`^[01]\d{9}$`),
+	"BG": regexp.MustCompile(`^\d{9,10}$`),
+	"CY": regexp.MustCompile(`^\d{8}[A-Z]$`),
+	"CZ": regexp.MustCompile(`^\d{8,10}$`),
+	"DE": regexp.MustCompile(`^\d{9}$`),
+	"DK": regexp.MustCompile(`^\d{8}$`),
+	"EE": regexp.MustCompile(`^\d{9}$`),
+	"EL": regexp.MustCompile(`^\d{9}$`),
+	"ES": regexp.MustCompile(`^[A-Z0-9]\d{7}[A-Z0-9]$`),
+	"FI": regexp.MustCompile(`^\d{8}$`),
+	"FR": regexp.MustCompile(`^[A-HJ-NP-Z0-9]{2}\d{9}$`),
+	"HR": regexp.MustCompile(`^\d{11}$`),
+	"HU": regexp.MustCompile(`^\d{8}$`),
+	"IE": regexp.MustCompile(`^(\d{7}[A-W][A-I]?|\d[A-Z+*]\d{5}[A-W])$`),
+	"IT": regexp.MustCompile(`^\d{11}$`),
+	"LT": regexp.MustCompile(`^(\d{9}|\d{12})$`),
+	"LU": regexp.MustCompile(`^\d{8}$`),
+	"LV": regexp.MustCompile(`^\d{11}$`),
+	"MT": regexp.MustCompile(`^\d{8}$`),
+	"NL": regexp.MustCompile(`^\d{9}B\d{2}$`),
+	"PL": regexp.MustCompile(`^\d{10}$`),
+	"PT": regexp.MustCompile(`^\d{9}$`),
+	"RO": regexp.MustCompile(`^\d{2,10}$`),
+	"SE": regexp.MustCompile(`^\d{10}01$`),
+	"SI": regexp.MustCompile(`^\d{8}$`),
+	"SK": regexp.MustCompile(`^\d{10}$`),
+	"XI": regexp.MustCompile(`^(\d{9}|\d{12}|GD\d{3}|HA\d{3})$`),
+}
+
+// euVATChecksums holds check digit algorithms for the countries that have
+// well documented ones.
+var euVATChecksums = map[string]func(v string) bool{
+	"BE": checkBEVAT,
+	"DE": checkDEVAT,
+	"FR": checkFRVAT,
+	"IT": luhn,
+	"PL": checkPLVAT,
+}
+
+var gbVATPattern = regexp.MustCompile(`^GB(\d{9}|\d{12}|GD\d{3}|HA\d{3})$`)
+
+// invalidEINPrefixes are the two digit prefixes the IRS has never assigned.
+var invalidEINPrefixes = map[string]struct{}{
+	"00": {}, "07": {}, "08": {}, "09": {}, "17": {}, "18": {}, "19": {},
+	"28": {}, "29": {}, "49": {}, "69": {}, "70": {}, "78": {}, "79": {},
+	"89": {}, "96": {}, "97": {},
+}
+
+var digitsPattern = regexp.MustCompile(`^\d+$`)
+
+//
+// Private functions
+//
+
+func pattern(expr string) validator {
+	re := regexp.MustCompile(expr)
+	return func(v string) Reason {
+		if !re.MatchString(v) {
+			return ReasonInvalidFormat
+		}
+		return ""
+	}
+}
+
+func digits(v string) []int {
+	ds := make([]int, len(v))
+	for i, c := range v {
+		ds[i] = int(c - '0')
+	}
+	return ds
+}
+
+// weightedSum multiplies each digit by the weight at the same position.
+func weightedSum(ds []int, weights []int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += ds[i] * w
+	}
+	return sum
+}
+
+func allSame(v string) bool {
+	return strings.Count(v, v[:1]) == len(v)
+}
+
+func luhn(v string) bool {
+	sum := 0
+	for i, d := range digits(v) {
+		if (len(v)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func validateAUABN(v string) Reason {
+	if len(v) != 11 || !digitsPattern.MatchString(v) {
+		return ReasonInvalidFormat
+	}
+	ds := digits(v)
+	ds[0]--
+	if weightedSum(ds, []int{10, 1, 3, 5, 7, 9, 11, 13, 15, 17, 19})%89 != 0 {
+		return ReasonInvalidChecksum
+	}
+	return ""
+}
+
+// mod11CheckDigit is the check digit used by CNPJ and CPF numbers.
+func mod11CheckDigit(ds []int, weights []int) int {
+	r := weightedSum(ds, weights) % 11
+	if r < 2 {
+		return 0
+	}
+	return 11 - r
+}
+
+func validateBRCNPJ(v string) Reason {
+	if len(v) != 14 || !digitsPattern.MatchString(v) || allSame(v) {
+		return ReasonInvalidFormat
+	}
+	ds := digits(v)
+	weights := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	if mod11CheckDigit(ds, weights[1:]) != ds[12] || mod11CheckDigit(ds, weights) != ds[13] {
+		return ReasonInvalidChecksum
+	}
+	return ""
+}
+
+func validateBRCPF(v string) Reason {
+	if len(v) != 11 || !digitsPattern.MatchString(v) || allSame(v) {
+		return ReasonInvalidFormat
+	}
+	ds := digits(v)
+	weights := []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}
+	if mod11CheckDigit(ds, weights[1:]) != ds[9] || mod11CheckDigit(ds, weights) != ds[10] {
+		return ReasonInvalidChecksum
+	}
+	return ""
+}
+
+func validateEUVAT(v string) Reason {
+	if len(v) < 3 {
+		return ReasonInvalidFormat
+	}
+	country, number := v[:2], v[2:]
+
+	re, ok := euVATPatterns[country]
+	if !ok {
+		return ReasonInvalidCountryCode
+	}
+	if !re.MatchString(number) {
+		return ReasonInvalidFormat
+	}
+	if check, ok := euVATChecksums[country]; ok && !check(number) {
+		return ReasonInvalidChecksum
+	}
+	return ""
+}
+
+func checkBEVAT(v string) bool {
+	first, _ := strconv.Atoi(v[:8])
+	last, _ := strconv.Atoi(v[8:])
+	return 97-first%97 == last
+}
+
+// checkDEVAT implements ISO 7064 MOD 11,10.
+func checkDEVAT(v string) bool {
+	ds := digits(v)
+	product := 10
+	for _, d := range ds[:8] {
+		sum := (d + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (2 * sum) % 11
+	}
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+	return check == ds[8]
+}
+
+// checkFRVAT verifies numeric keys against the SIREN that follows them.
+// Alphanumeric keys have no published algorithm and are accepted as is.
+func checkFRVAT(v string) bool {
+	if !digitsPattern.MatchString(v[:2]) {
+		return true
+	}
+	key, _ := strconv.Atoi(v[:2])
+	siren, _ := strconv.Atoi(v[2:])
+	return key == (12+3*(siren%97))%97
+}
+
+func checkPLVAT(v string) bool {
+	ds := digits(v)
+	check := weightedSum(ds, []int{6, 5, 7, 2, 3, 4, 5, 6, 7}) % 11
+	return check != 10 && check == ds[9]
+}
+
+func validateGBVAT(v string) Reason {
+	if !gbVATPattern.MatchString(v) {
+		return ReasonInvalidFormat
+	}
+
+	// Government department and health authority numbers, and the branch
+	// suffix of 12 digit numbers, aren't covered by the check digits.
+	number := v[2:]
+	if !digitsPattern.MatchString(number) {
+		return ""
+	}
+	ds := digits(number[:9])
+	sum := weightedSum(ds, []int{8, 7, 6, 5, 4, 3, 2}) + ds[7]*10 + ds[8]
+	if sum%97 != 0 && (sum+55)%97 != 0 {
+		return ReasonInvalidChecksum
+	}
+	return ""
+}
+
+func validateUSEIN(v string) Reason {
+	if len(v) != 9 || !digitsPattern.MatchString(v) {
+		return ReasonInvalidFormat
+	}
+	if _, ok := invalidEINPrefixes[v[:2]]; ok {
+		return ReasonInvalidPrefix
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package taxidutil
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "DE136695976", Normalize(" de 136.695.976 "))
+	assert.Equal(t, "123456789", Normalize("12-3456789"))
+}
+
+func TestValidate_Valid(t *testing.T) {
+	testCases := []struct {
+		typ   stripe.TaxIDType
+		value string
+	}{
+		{stripe.TaxIDTypeAUABN, "51 824 753 556"},
+		{stripe.TaxIDTypeBRCNPJ, "11.222.333/0001-81"},
+		{stripe.TaxIDTypeBRCPF, "529.982.247-25"},
+		{stripe.TaxIDTypeCHVAT, "CHE-123.456.789 MWST"},
+		{stripe.TaxIDTypeEUVAT, "ATU12345678"},
+		{stripe.TaxIDTypeEUVAT, "BE0776091951"},
+		{stripe.TaxIDTypeEUVAT, "DE136695976"},
+		{stripe.TaxIDTypeEUVAT, "FR40303265045"},
+		{stripe.TaxIDTypeEUVAT, "IT00743110157"},
+		{stripe.TaxIDTypeEUVAT, "NL123456789B01"},
+		{stripe.TaxIDTypeEUVAT, "PL5260250274"},
+		{stripe.TaxIDTypeGBVAT, "GB980780684"},
+		{stripe.TaxIDTypeGBVAT, "GBGD001"},
+		{stripe.TaxIDTypeUSEIN, "12-3456789"},
+		// Types without a validator are accepted
+		{stripe.TaxIDTypeTHVAT, "1234567891234"},
+	}
+	for _, tc := range testCases {
+		assert.NoError(t, Validate(tc.typ, tc.value), "%s %s", tc.typ, tc.value)
+	}
+}
+
+func TestValidate_Invalid(t *testing.T) {
+	testCases := []struct {
+		typ    stripe.TaxIDType
+		value  string
+		reason Reason
+	}{
+		{stripe.TaxIDTypeAUABN, "51 824 753 557", ReasonInvalidChecksum},
+		{stripe.TaxIDTypeBRCNPJ, "11.111.111/1111-11", ReasonInvalidFormat},
+		{stripe.TaxIDTypeBRCNPJ, "11.222.333/0001-82", ReasonInvalidChecksum},
+		{stripe.TaxIDTypeBRCPF, "529.982.247-26", ReasonInvalidChecksum},
+		{stripe.TaxIDTypeEUVAT, "US123456789", ReasonInvalidCountryCode},
+		{stripe.TaxIDTypeEUVAT, "DE12345678", ReasonInvalidFormat},
+		{stripe.TaxIDTypeEUVAT, "DE136695977", ReasonInvalidChecksum},
+		{stripe.TaxIDTypeGBVAT, "123456789", ReasonInvalidFormat},
+		{stripe.TaxIDTypeGBVAT, "GB123456789", ReasonInvalidChecksum},
+		{stripe.TaxIDTypeUSEIN, "07-3456789", ReasonInvalidPrefix},
+		{stripe.TaxIDTypeUSEIN, "12-345678", ReasonInvalidFormat},
+		{stripe.TaxIDTypeTHVAT, " ", ReasonEmpty},
+	}
+	for _, tc := range testCases {
+		err := Validate(tc.typ, tc.value)
+		assert.Error(t, err, "%s %s", tc.typ, tc.value)
+
+		validationErr, ok := err.(*ValidationError)
+		assert.True(t, ok)
+		assert.Equal(t, tc.reason, validationErr.Reason, "%s %s", tc.typ, tc.value)
+		assert.Equal(t, tc.typ, validationErr.Type)
+		assert.Equal(t, tc.value, validationErr.Value)
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := Validate(stripe.TaxIDTypeUSEIN, "1234")
+	assert.EqualError(t, err, `invalid us_ein tax ID "1234": invalid_format`)
+}
+
+func TestIsSupported(t *testing.T) {
+	assert.True(t, IsSupported(stripe.TaxIDTypeEUVAT))
+	assert.False(t, IsSupported(stripe.TaxIDTypeUnknown))
+}
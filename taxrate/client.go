@@ -111,5 +111,5 @@ func (i *Iter) TaxRateList() *stripe.TaxRateList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
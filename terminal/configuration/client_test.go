@@ -0,0 +1,68 @@
+package configuration
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	_ "github.com/stripe/stripe-go/v72/testing"
+)
+
+func TestTerminalConfigurationDel(t *testing.T) {
+	configuration, err := Del("tmc_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, configuration)
+	assert.Equal(t, "terminal.configuration", configuration.Object)
+}
+
+func TestTerminalConfigurationGet(t *testing.T) {
+	configuration, err := Get("tmc_123", nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, configuration)
+	assert.Equal(t, "terminal.configuration", configuration.Object)
+}
+
+func TestTerminalConfigurationList(t *testing.T) {
+	i := List(&stripe.TerminalConfigurationListParams{
+		IsAccountDefault: stripe.Bool(false),
+	})
+
+	// Verify that we can get at least one configuration
+	assert.True(t, i.Next())
+	assert.Nil(t, i.Err())
+	assert.NotNil(t, i.TerminalConfiguration())
+	assert.Equal(t, "terminal.configuration", i.TerminalConfiguration().Object)
+	assert.NotNil(t, i.TerminalConfigurationList())
+}
+
+func TestTerminalConfigurationNew(t *testing.T) {
+	configuration, err := New(&stripe.TerminalConfigurationParams{
+		BBPOSWisePOSE: &stripe.TerminalConfigurationBBPOSWisePOSEParams{
+			Splashscreen: stripe.String("file_123"),
+		},
+		Name: stripe.String("Retail fleet"),
+		Offline: &stripe.TerminalConfigurationOfflineParams{
+			Enabled: stripe.Bool(true),
+		},
+		Tipping: &stripe.TerminalConfigurationTippingParams{
+			USD: &stripe.TerminalConfigurationTippingUSDParams{
+				Percentages:       stripe.Int64Slice([]int64{15, 18, 20}),
+				SmartTipThreshold: stripe.Int64(1000),
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, configuration)
+	assert.Equal(t, "terminal.configuration", configuration.Object)
+}
+
+func TestTerminalConfigurationUpdate(t *testing.T) {
+	configuration, err := Update("tmc_123", &stripe.TerminalConfigurationParams{
+		VerifoneP400: &stripe.TerminalConfigurationVerifoneP400Params{
+			Splashscreen: stripe.String("file_123"),
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, configuration)
+	assert.Equal(t, "terminal.configuration", configuration.Object)
+}
@@ -175,5 +175,5 @@ func (i *Iter) TerminalReaderList() *stripe.TerminalReaderList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -12,6 +12,12 @@ type TerminalConfigurationBBPOSWisePOSEParams struct {
 	Splashscreen *string `form:"splashscreen"`
 }
 
+// Configurations for collecting transactions offline.
+type TerminalConfigurationOfflineParams struct {
+	// Determines whether to allow transactions to be collected while reader is offline. Defaults to false.
+	Enabled *bool `form:"enabled"`
+}
+
 // Tipping configuration for AUD
 type TerminalConfigurationTippingAUDParams struct {
 	// Fixed amounts displayed when collecting a tip
@@ -195,6 +201,10 @@ type TerminalConfigurationParams struct {
 	Params `form:"*"`
 	// An object containing device type specific settings for BBPOS WisePOS E readers
 	BBPOSWisePOSE *TerminalConfigurationBBPOSWisePOSEParams `form:"bbpos_wisepos_e"`
+	// Name of the configuration
+	Name *string `form:"name"`
+	// Configurations for collecting transactions offline.
+	Offline *TerminalConfigurationOfflineParams `form:"offline"`
 	// Tipping configurations for readers supporting on-reader tips
 	Tipping *TerminalConfigurationTippingParams `form:"tipping"`
 	// An object containing device type specific settings for Verifone P400 readers
@@ -211,6 +221,10 @@ type TerminalConfigurationBBPOSWisePOSE struct {
 	// A File ID representing an image you would like displayed on the reader.
 	Splashscreen *File `json:"splashscreen"`
 }
+type TerminalConfigurationOffline struct {
+	// Determines whether to allow transactions to be collected while reader is offline. Defaults to false.
+	Enabled bool `json:"enabled"`
+}
 type TerminalConfigurationTippingAUD struct {
 	// Fixed amounts displayed when collecting a tip
 	FixedAmounts []int64 `json:"fixed_amounts"`
@@ -355,8 +369,11 @@ type TerminalConfiguration struct {
 	IsAccountDefault bool `json:"is_account_default"`
 	// Has the value `true` if the object exists in live mode or the value `false` if the object exists in test mode.
 	Livemode bool `json:"livemode"`
+	// String indicating the name of the Configuration object, set by the user
+	Name string `json:"name"`
 	// String representing the object's type. Objects of the same type share the same value.
 	Object       string                             `json:"object"`
+	Offline      *TerminalConfigurationOffline      `json:"offline"`
 	Tipping      *TerminalConfigurationTipping      `json:"tipping"`
 	VerifoneP400 *TerminalConfigurationVerifoneP400 `json:"verifone_p400"`
 }
@@ -0,0 +1,37 @@
+package card
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	_ "github.com/stripe/stripe-go/v72/testing"
+)
+
+func TestIssuingCardDeliverCard(t *testing.T) {
+	card, err := DeliverCard("ic_123", &stripe.TestHelpersIssuingCardDeliverCardParams{})
+	assert.Nil(t, err)
+	assert.NotNil(t, card)
+	assert.Equal(t, "issuing.card", card.Object)
+}
+
+func TestIssuingCardFailCard(t *testing.T) {
+	card, err := FailCard("ic_123", &stripe.TestHelpersIssuingCardFailCardParams{})
+	assert.Nil(t, err)
+	assert.NotNil(t, card)
+	assert.Equal(t, "issuing.card", card.Object)
+}
+
+func TestIssuingCardReturnCard(t *testing.T) {
+	card, err := ReturnCard("ic_123", &stripe.TestHelpersIssuingCardReturnCardParams{})
+	assert.Nil(t, err)
+	assert.NotNil(t, card)
+	assert.Equal(t, "issuing.card", card.Object)
+}
+
+func TestIssuingCardShipCard(t *testing.T) {
+	card, err := ShipCard("ic_123", &stripe.TestHelpersIssuingCardShipCardParams{})
+	assert.Nil(t, err)
+	assert.NotNil(t, card)
+	assert.Equal(t, "issuing.card", card.Object)
+}
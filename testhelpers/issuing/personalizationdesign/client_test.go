@@ -0,0 +1,33 @@
+package personalizationdesign
+
+import (
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+	stripe "github.com/stripe/stripe-go/v72"
+	_ "github.com/stripe/stripe-go/v72/testing"
+)
+
+func TestTestHelpersIssuingPersonalizationDesignActivate(t *testing.T) {
+	design, err := Activate("pd_123", &stripe.TestHelpersIssuingPersonalizationDesignActivateParams{})
+	assert.Nil(t, err)
+	assert.NotNil(t, design)
+}
+
+func TestTestHelpersIssuingPersonalizationDesignDeactivate(t *testing.T) {
+	design, err := Deactivate("pd_123", &stripe.TestHelpersIssuingPersonalizationDesignDeactivateParams{})
+	assert.Nil(t, err)
+	assert.NotNil(t, design)
+}
+
+func TestTestHelpersIssuingPersonalizationDesignReject(t *testing.T) {
+	design, err := Reject("pd_123", &stripe.TestHelpersIssuingPersonalizationDesignRejectParams{
+		RejectionReasons: &stripe.TestHelpersIssuingPersonalizationDesignRejectRejectionReasonsParams{
+			CardLogo: stripe.StringSlice([]string{
+				string(stripe.IssuingPersonalizationDesignRejectionReasonsCardLogoInappropriate),
+			}),
+		},
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, design)
+}
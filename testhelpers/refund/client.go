@@ -33,5 +33,5 @@ func (c Client) Expire(id string, params *stripe.TestHelpersRefundExpireParams)
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
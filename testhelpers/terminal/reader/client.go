@@ -36,5 +36,5 @@ func (c Client) PresentPaymentMethod(id string, params *stripe.TestHelpersTermin
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -68,5 +68,5 @@ func (c Client) Succeed(id string, params *stripe.TestHelpersTreasuryInboundTran
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -68,5 +68,5 @@ func (c Client) ReturnOutboundPayment(id string, params *stripe.TestHelpersTreas
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
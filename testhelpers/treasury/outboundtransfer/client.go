@@ -68,5 +68,5 @@ func (c Client) ReturnOutboundTransfer(id string, params *stripe.TestHelpersTrea
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -38,5 +38,5 @@ func (c Client) New(params *stripe.TestHelpersTreasuryReceivedDebitParams) (*str
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
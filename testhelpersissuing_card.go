@@ -0,0 +1,27 @@
+//
+//
+// File generated from our OpenAPI spec
+//
+//
+
+package stripe
+
+// Updates the shipping status of the specified Issuing Card object to delivered.
+type TestHelpersIssuingCardDeliverCardParams struct {
+	Params `form:"*"`
+}
+
+// Updates the shipping status of the specified Issuing Card object to failure.
+type TestHelpersIssuingCardFailCardParams struct {
+	Params `form:"*"`
+}
+
+// Updates the shipping status of the specified Issuing Card object to returned.
+type TestHelpersIssuingCardReturnCardParams struct {
+	Params `form:"*"`
+}
+
+// Updates the shipping status of the specified Issuing Card object to shipped.
+type TestHelpersIssuingCardShipCardParams struct {
+	Params `form:"*"`
+}
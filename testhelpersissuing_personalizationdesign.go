@@ -0,0 +1,32 @@
+//
+//
+// File generated from our OpenAPI spec
+//
+//
+
+package stripe
+
+// Updates the status of the specified testmode personalization design object to active.
+type TestHelpersIssuingPersonalizationDesignActivateParams struct {
+	Params `form:"*"`
+}
+
+// Updates the status of the specified testmode personalization design object to inactive.
+type TestHelpersIssuingPersonalizationDesignDeactivateParams struct {
+	Params `form:"*"`
+}
+
+// The reason(s) the personalization design was rejected.
+type TestHelpersIssuingPersonalizationDesignRejectRejectionReasonsParams struct {
+	// The reason(s) the card logo was rejected.
+	CardLogo []*string `form:"card_logo"`
+	// The reason(s) the carrier text was rejected.
+	CarrierText []*string `form:"carrier_text"`
+}
+
+// Updates the status of the specified testmode personalization design object to rejected.
+type TestHelpersIssuingPersonalizationDesignRejectParams struct {
+	Params `form:"*"`
+	// The reason(s) the personalization design was rejected.
+	RejectionReasons *TestHelpersIssuingPersonalizationDesignRejectRejectionReasonsParams `form:"rejection_reasons"`
+}
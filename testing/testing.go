@@ -82,7 +82,7 @@ func init() {
 		os.Exit(1)
 	}
 
-	stripe.Key = "sk_test_myTestKey"
+	stripe.SetKey("sk_test_myTestKey")
 
 	// Configure a backend for stripe-mock and set it for both the API and
 	// Uploads (unlike the real Stripe API, stripe-mock supports both these
@@ -92,7 +92,7 @@ func init() {
 		&stripe.BackendConfig{
 			URL:           stripe.String("https://localhost:" + port),
 			HTTPClient:    httpClient,
-			LeveledLogger: stripe.DefaultLeveledLogger,
+			LeveledLogger: stripe.GetDefaultLeveledLogger(),
 		},
 	)
 	stripe.SetBackend(stripe.APIBackend, stripeMockBackend)
@@ -0,0 +1,60 @@
+package stripe
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+//
+// Private types
+//
+
+// cancelOnCloseBody is the body of a response to a request whose context has
+// a timeout. The context is released when the body is closed, which is once
+// it has been read.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+//
+// Private functions
+//
+
+// withAttemptTimeout returns a copy of req for a single attempt at a request,
+// whose context expires at the earliest of deadline, which is the end of the
+// request's overall timeout, and the end of the attempt timeout. The returned
+// function releases the context. req is returned as is if neither timeout is
+// set.
+func (s *BackendImplementation) withAttemptTimeout(req *http.Request, deadline time.Time) (*http.Request, context.CancelFunc) {
+	if s.attemptTimeout > 0 {
+		attemptDeadline := time.Now().Add(s.attemptTimeout)
+		if deadline.IsZero() || attemptDeadline.Before(deadline) {
+			deadline = attemptDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return req, func() {}
+	}
+
+	ctx, cancel := context.WithDeadline(req.Context(), deadline)
+	return req.WithContext(ctx), cancel
+}
+
+// releaseAttempt arranges for cancel to be called once resp has been handled,
+// or right away if there's no response.
+func releaseAttempt(resp *http.Response, cancel context.CancelFunc) {
+	if resp == nil || resp.Body == nil {
+		cancel()
+		return
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+}
@@ -0,0 +1,95 @@
+package stripe
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestDo_AttemptTimeout(t *testing.T) {
+	requestNum := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestNum++
+		// Stall the first attempt until it times out
+		if requestNum == 1 {
+			<-r.Context().Done()
+			return
+		}
+		_, err := w.Write([]byte(`{}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			AttemptTimeout:    50 * time.Millisecond,
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(1),
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+	backend.SetNetworkRetriesSleep(false)
+
+	var response APIResource
+	err := backend.Call(http.MethodGet, "/v1/charges", "sk_test_123", nil, &response)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requestNum)
+}
+
+func TestDo_Timeout(t *testing.T) {
+	requestNum := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestNum++
+		<-r.Context().Done()
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(2),
+			Timeout:           50 * time.Millisecond,
+			URL:               String(testServer.URL),
+		},
+	).(*BackendImplementation)
+
+	start := time.Now()
+	var response APIResource
+	err := backend.Call(http.MethodGet, "/v1/charges", "sk_test_123", nil, &response)
+	assert.Error(t, err)
+	assert.True(t, time.Since(start) < time.Second)
+
+	// Waiting before a retry would exceed the timeout
+	assert.Equal(t, 1, requestNum)
+}
+
+func TestDoStreaming_AttemptTimeout(t *testing.T) {
+	data := strings.Repeat("pdf", 10000)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(data))
+	}))
+	defer testServer.Close()
+
+	backend := GetBackendWithConfig(
+		APIBackend,
+		&BackendConfig{
+			AttemptTimeout:    time.Minute,
+			LeveledLogger:     nullLeveledLogger,
+			MaxNetworkRetries: Int64(0),
+			URL:               String(testServer.URL),
+		},
+	)
+
+	// The body is still readable once the request has returned
+	var buf bytes.Buffer
+	_, err := CallStreamingTo(backend, http.MethodGet, "/v1/quotes/qt_123/pdf", "sk_test_123", nil, &buf, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, data, buf.String())
+}
@@ -45,5 +45,5 @@ func (c Client) Get(id string, params *stripe.TokenParams) (*stripe.Token, error
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
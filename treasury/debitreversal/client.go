@@ -91,5 +91,5 @@ func (i *Iter) TreasuryDebitReversalList() *stripe.TreasuryDebitReversalList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
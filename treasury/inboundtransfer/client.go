@@ -104,5 +104,5 @@ func (i *Iter) TreasuryInboundTransferList() *stripe.TreasuryInboundTransferList
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -73,5 +73,5 @@ func (i *Iter) TreasuryReceivedCreditList() *stripe.TreasuryReceivedCreditList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
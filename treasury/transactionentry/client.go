@@ -73,5 +73,5 @@ func (i *Iter) TreasuryTransactionEntryList() *stripe.TreasuryTransactionEntryLi
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -6,6 +6,8 @@
 
 package stripe
 
+import "encoding/json"
+
 // The rails used to reverse the funds.
 type TreasuryCreditReversalNetwork string
 
@@ -83,3 +85,22 @@ type TreasuryCreditReversalList struct {
 	ListMeta
 	Data []*TreasuryCreditReversal `json:"data"`
 }
+
+// UnmarshalJSON handles deserialization of a TreasuryCreditReversal.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TreasuryCreditReversal) UnmarshalJSON(data []byte) error {
+	if id, ok := ParseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type treasuryCreditReversal TreasuryCreditReversal
+	var v treasuryCreditReversal
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = TreasuryCreditReversal(v)
+	return nil
+}
@@ -6,6 +6,8 @@
 
 package stripe
 
+import "encoding/json"
+
 // The rails used to reverse the funds.
 type TreasuryDebitReversalNetwork string
 
@@ -93,3 +95,22 @@ type TreasuryDebitReversalList struct {
 	ListMeta
 	Data []*TreasuryDebitReversal `json:"data"`
 }
+
+// UnmarshalJSON handles deserialization of a TreasuryDebitReversal.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TreasuryDebitReversal) UnmarshalJSON(data []byte) error {
+	if id, ok := ParseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type treasuryDebitReversal TreasuryDebitReversal
+	var v treasuryDebitReversal
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = TreasuryDebitReversal(v)
+	return nil
+}
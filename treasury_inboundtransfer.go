@@ -6,6 +6,8 @@
 
 package stripe
 
+import "encoding/json"
+
 // Reason for the failure.
 type TreasuryInboundTransferFailureDetailsCode string
 
@@ -200,3 +202,22 @@ type TreasuryInboundTransferList struct {
 	ListMeta
 	Data []*TreasuryInboundTransfer `json:"data"`
 }
+
+// UnmarshalJSON handles deserialization of a TreasuryInboundTransfer.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TreasuryInboundTransfer) UnmarshalJSON(data []byte) error {
+	if id, ok := ParseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type treasuryInboundTransfer TreasuryInboundTransfer
+	var v treasuryInboundTransfer
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = TreasuryInboundTransfer(v)
+	return nil
+}
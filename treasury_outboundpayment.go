@@ -6,6 +6,8 @@
 
 package stripe
 
+import "encoding/json"
+
 // The rails used to send funds.
 type TreasuryOutboundPaymentDestinationPaymentMethodDetailsFinancialAccountNetwork string
 
@@ -298,3 +300,22 @@ type TreasuryOutboundPaymentList struct {
 	ListMeta
 	Data []*TreasuryOutboundPayment `json:"data"`
 }
+
+// UnmarshalJSON handles deserialization of a TreasuryOutboundPayment.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TreasuryOutboundPayment) UnmarshalJSON(data []byte) error {
+	if id, ok := ParseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type treasuryOutboundPayment TreasuryOutboundPayment
+	var v treasuryOutboundPayment
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = TreasuryOutboundPayment(v)
+	return nil
+}
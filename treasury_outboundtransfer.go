@@ -6,6 +6,8 @@
 
 package stripe
 
+import "encoding/json"
+
 // The type of the payment method used in the OutboundTransfer.
 type TreasuryOutboundTransferDestinationPaymentMethodDetailsType string
 
@@ -211,3 +213,22 @@ type TreasuryOutboundTransferList struct {
 	ListMeta
 	Data []*TreasuryOutboundTransfer `json:"data"`
 }
+
+// UnmarshalJSON handles deserialization of a TreasuryOutboundTransfer.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TreasuryOutboundTransfer) UnmarshalJSON(data []byte) error {
+	if id, ok := ParseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type treasuryOutboundTransfer TreasuryOutboundTransfer
+	var v treasuryOutboundTransfer
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = TreasuryOutboundTransfer(v)
+	return nil
+}
@@ -6,6 +6,8 @@
 
 package stripe
 
+import "encoding/json"
+
 // Reason for the failure. A ReceivedCredit might fail because the receiving FinancialAccount is closed or frozen.
 type TreasuryReceivedCreditFailureCode string
 
@@ -201,3 +203,22 @@ type TreasuryReceivedCreditList struct {
 	ListMeta
 	Data []*TreasuryReceivedCredit `json:"data"`
 }
+
+// UnmarshalJSON handles deserialization of a TreasuryReceivedCredit.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TreasuryReceivedCredit) UnmarshalJSON(data []byte) error {
+	if id, ok := ParseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type treasuryReceivedCredit TreasuryReceivedCredit
+	var v treasuryReceivedCredit
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = TreasuryReceivedCredit(v)
+	return nil
+}
@@ -0,0 +1,37 @@
+package stripe
+
+import (
+	"encoding/json"
+	"testing"
+
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestTreasuryReceivedCredit_UnmarshalJSON(t *testing.T) {
+	// Unmarshals from a JSON string
+	{
+		var v TreasuryReceivedCredit
+		err := json.Unmarshal([]byte(`"rc_123"`), &v)
+		assert.NoError(t, err)
+		assert.Equal(t, "rc_123", v.ID)
+	}
+
+	// Unmarshals from a JSON object
+	{
+		v := TreasuryReceivedCredit{ID: "rc_123"}
+		data, err := json.Marshal(&v)
+		assert.NoError(t, err)
+
+		err = json.Unmarshal(data, &v)
+		assert.NoError(t, err)
+		assert.Equal(t, "rc_123", v.ID)
+	}
+
+	// Unmarshals flows that are only given as IDs
+	{
+		var v TreasuryReceivedCredit
+		err := json.Unmarshal([]byte(`{"id":"rc_123","linked_flows":{"source_flow_details":{"type":"credit_reversal","credit_reversal":"credrev_123"}}}`), &v)
+		assert.NoError(t, err)
+		assert.Equal(t, "credrev_123", v.LinkedFlows.SourceFlowDetails.CreditReversal.ID)
+	}
+}
@@ -6,6 +6,8 @@
 
 package stripe
 
+import "encoding/json"
+
 // Reason for the failure. A ReceivedDebit might fail because the FinancialAccount doesn't have sufficient funds, is closed, or is frozen.
 type TreasuryReceivedDebitFailureCode string
 
@@ -155,3 +157,22 @@ type TreasuryReceivedDebitList struct {
 	ListMeta
 	Data []*TreasuryReceivedDebit `json:"data"`
 }
+
+// UnmarshalJSON handles deserialization of a TreasuryReceivedDebit.
+// This custom unmarshaling is needed because the resulting
+// property may be an id or the full struct if it was expanded.
+func (t *TreasuryReceivedDebit) UnmarshalJSON(data []byte) error {
+	if id, ok := ParseID(data); ok {
+		t.ID = id
+		return nil
+	}
+
+	type treasuryReceivedDebit TreasuryReceivedDebit
+	var v treasuryReceivedDebit
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = TreasuryReceivedDebit(v)
+	return nil
+}
@@ -36,5 +36,5 @@ func (c Client) New(params *stripe.UsageRecordParams) (*stripe.UsageRecord, erro
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
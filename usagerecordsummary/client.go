@@ -64,5 +64,5 @@ func (i *Iter) UsageRecordSummaryList() *stripe.UsageRecordSummaryList {
 }
 
 func getC() Client {
-	return Client{stripe.GetBackend(stripe.APIBackend), stripe.Key}
+	return Client{stripe.GetBackend(stripe.APIBackend), stripe.GetKey()}
 }
@@ -19,6 +19,10 @@ import (
 //
 
 const (
+	// DefaultDeduplicationTTL is how long ConstructEventWithOptions remembers
+	// events by default. It covers the three days over which Stripe retries
+	// the delivery of an event.
+	DefaultDeduplicationTTL time.Duration = 72 * time.Hour
 	// DefaultTolerance indicates that signatures older than this will be rejected by ConstructEvent.
 	DefaultTolerance time.Duration = 300 * time.Second
 	// signingVersion represents the version of the signature we currently use.
@@ -31,12 +35,47 @@ const (
 
 // This block represents the list of errors that could be raised when using the webhook package.
 var (
-	ErrInvalidHeader    = errors.New("webhook has invalid Stripe-Signature header")
-	ErrNoValidSignature = errors.New("webhook had no valid signature")
-	ErrNotSigned        = errors.New("webhook has no Stripe-Signature header")
-	ErrTooOld           = errors.New("timestamp wasn't within tolerance")
+	ErrAPIVersionMismatch = errors.New("webhook event's API version doesn't match the expected version")
+	ErrDuplicateEvent     = errors.New("webhook event was already received")
+	ErrInvalidHeader      = errors.New("webhook has invalid Stripe-Signature header")
+	ErrNoValidSignature   = errors.New("webhook had no valid signature")
+	ErrNotSigned          = errors.New("webhook has no Stripe-Signature header")
+	ErrTooOld             = errors.New("timestamp wasn't within tolerance")
 )
 
+//
+// Public types
+//
+
+// ConstructEventOptions configures the checks made by
+// ConstructEventWithOptions on top of validating the signature.
+type ConstructEventOptions struct {
+	// APIVersion is the API version events must have been rendered with when
+	// MatchAPIVersion is true. Defaults to stripe.APIVersion, the version the
+	// library's types are built for.
+	APIVersion string
+
+	// Deduplicator rejects events that were already received with
+	// ErrDuplicateEvent. Defaults to nil, which accepts duplicates.
+	Deduplicator Deduplicator
+
+	// DeduplicationTTL is how long the Deduplicator remembers an event.
+	// Defaults to DefaultDeduplicationTTL.
+	DeduplicationTTL time.Duration
+
+	// IgnoreTolerance disables the check of the signature's timestamp.
+	IgnoreTolerance bool
+
+	// MatchAPIVersion rejects events rendered with another API version than
+	// APIVersion with ErrAPIVersionMismatch, since they may not unmarshal
+	// correctly into the library's types.
+	MatchAPIVersion bool
+
+	// Tolerance is how old the signature's timestamp can be. Defaults to
+	// DefaultTolerance.
+	Tolerance time.Duration
+}
+
 //
 // Public functions
 //
@@ -94,6 +133,77 @@ func ConstructEventWithTolerance(payload []byte, header string, secret string, t
 	return constructEvent(payload, header, secret, tolerance, true)
 }
 
+// ConstructEventWithOptions initializes an Event object from a JSON webhook
+// payload, validating the Stripe-Signature header using the specified signing
+// secret, then making the additional checks configured by options, which may
+// be nil:
+//
+//	event, err := webhook.ConstructEventWithOptions(body, sigHeader, secret, &webhook.ConstructEventOptions{
+//		Deduplicator:    deduplicator,
+//		MatchAPIVersion: true,
+//	})
+//	if err == webhook.ErrDuplicateEvent {
+//		w.WriteHeader(http.StatusOK) // Already handled
+//		return
+//	}
+//	...
+//	if err := handleEvent(event); err != nil {
+//		deduplicator.Forget(event.ID) // Accept Stripe's retry
+//		w.WriteHeader(http.StatusInternalServerError)
+//		return
+//	}
+//
+// The event is only recorded by the Deduplicator once all the other checks
+// have passed, so that a delivery that's rejected can still be accepted when
+// Stripe retries it. An event that was accepted but couldn't be handled must
+// be forgotten with Deduplicator.Forget, or Stripe's retries of it are
+// rejected with ErrDuplicateEvent.
+//
+// NOTE: Stripe will only send Webhook signing headers after you have retrieved
+// your signing secret from the Stripe dashboard:
+// https://dashboard.stripe.com/webhooks
+//
+func ConstructEventWithOptions(payload []byte, header string, secret string, options *ConstructEventOptions) (stripe.Event, error) {
+	if options == nil {
+		options = &ConstructEventOptions{}
+	}
+
+	tolerance := options.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	}
+	e, err := constructEvent(payload, header, secret, tolerance, !options.IgnoreTolerance)
+	if err != nil {
+		return e, err
+	}
+
+	if options.MatchAPIVersion {
+		apiVersion := options.APIVersion
+		if apiVersion == "" {
+			apiVersion = stripe.APIVersion
+		}
+		if e.APIVersion != apiVersion {
+			return e, ErrAPIVersionMismatch
+		}
+	}
+
+	if options.Deduplicator != nil {
+		ttl := options.DeduplicationTTL
+		if ttl == 0 {
+			ttl = DefaultDeduplicationTTL
+		}
+		seen, err := options.Deduplicator.MarkSeen(e.ID, ttl)
+		if err != nil {
+			return e, fmt.Errorf("error deduplicating webhook event %s: %v", e.ID, err)
+		}
+		if seen {
+			return e, ErrDuplicateEvent
+		}
+	}
+
+	return e, nil
+}
+
 // ValidatePayload validates the payload against the Stripe-Signature header
 // using the specified signing secret. Returns an error if the body or
 // Stripe-Signature header provided are unreadable, if the signature doesn't
@@ -182,3 +182,83 @@ func TestTokenNew(t *testing.T) {
 		t.Errorf("Received %v error when timestamp outside window but no tolerance specified", err)
 	}
 }
+
+func TestConstructEventWithOptions(t *testing.T) {
+	p := newSignedPayload()
+	evt, err := ConstructEventWithOptions(p.payload, p.header, p.secret, nil)
+	if err != nil {
+		t.Errorf("Error validating signature: %v", err)
+	} else if evt.ID != "evt_test_webhook" {
+		t.Errorf("Expected a parsed event matching the test payload, got %v", evt)
+	}
+
+	p = newSignedPayload(func(p *SignedPayload) {
+		p.timestamp = time.Now().Add(-15 * time.Second)
+	})
+	_, err = ConstructEventWithOptions(p.payload, p.header, p.secret, &ConstructEventOptions{Tolerance: 10 * time.Second})
+	if err != ErrTooOld {
+		t.Errorf("Received %v error when validating timestamp outside of allowed timing window", err)
+	}
+	_, err = ConstructEventWithOptions(p.payload, p.header, p.secret, &ConstructEventOptions{IgnoreTolerance: true, Tolerance: 10 * time.Second})
+	if err != nil {
+		t.Errorf("Received %v error when timestamp outside window but tolerance is ignored", err)
+	}
+
+	p = newSignedPayload(func(p *SignedPayload) {
+		p.secret = "whsec_other_secret"
+	})
+	_, err = ConstructEventWithOptions(p.payload, p.header, testSecret, nil)
+	if err != ErrNoValidSignature {
+		t.Errorf("Expected ErrNoValidSignature, got %v", err)
+	}
+}
+
+func TestConstructEventWithOptions_APIVersion(t *testing.T) {
+	p := newSignedPayload(func(p *SignedPayload) {
+		p.payload = []byte(`{"id": "evt_test_webhook", "object": "event", "api_version": "2019-02-19"}`)
+	})
+
+	_, err := ConstructEventWithOptions(p.payload, p.header, p.secret, &ConstructEventOptions{})
+	if err != nil {
+		t.Errorf("Received %v error when the API version isn't matched", err)
+	}
+	_, err = ConstructEventWithOptions(p.payload, p.header, p.secret, &ConstructEventOptions{MatchAPIVersion: true})
+	if err != ErrAPIVersionMismatch {
+		t.Errorf("Expected ErrAPIVersionMismatch, got %v", err)
+	}
+	_, err = ConstructEventWithOptions(p.payload, p.header, p.secret, &ConstructEventOptions{APIVersion: "2019-02-19", MatchAPIVersion: true})
+	if err != nil {
+		t.Errorf("Received %v error when the API version matches", err)
+	}
+}
+
+func TestConstructEventWithOptions_Deduplicator(t *testing.T) {
+	options := &ConstructEventOptions{Deduplicator: &MemoryDeduplicator{}, MatchAPIVersion: true}
+
+	// Events rejected by another check aren't recorded
+	p := newSignedPayload()
+	_, err := ConstructEventWithOptions(p.payload, p.header, p.secret, options)
+	if err != ErrAPIVersionMismatch {
+		t.Errorf("Expected ErrAPIVersionMismatch, got %v", err)
+	}
+
+	options.MatchAPIVersion = false
+	_, err = ConstructEventWithOptions(p.payload, p.header, p.secret, options)
+	if err != nil {
+		t.Errorf("Received %v error on the first delivery of an event", err)
+	}
+	evt, err := ConstructEventWithOptions(p.payload, p.header, p.secret, options)
+	if err != ErrDuplicateEvent {
+		t.Errorf("Expected ErrDuplicateEvent, got %v", err)
+	}
+	if evt.ID != "evt_test_webhook" {
+		t.Errorf("Expected the duplicate event to still be parsed, got %v", evt)
+	}
+
+	// A forgotten event, like one that failed to be handled, is accepted again
+	options.Deduplicator.Forget(evt.ID)
+	_, err = ConstructEventWithOptions(p.payload, p.header, p.secret, options)
+	if err != nil {
+		t.Errorf("Received %v error on a delivery of a forgotten event", err)
+	}
+}
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+)
+
+//
+// Public types
+//
+
+// Deduplicator remembers the IDs of the events that were received, so that
+// ConstructEventWithOptions can reject the events that Stripe delivers more
+// than once. Implementations backed by a shared store, like Redis, let
+// several servers deduplicate events together.
+//
+// An event is recorded as soon as it's received, before it's handled, so
+// that concurrent deliveries are rejected. If handling the event fails, call
+// Forget so that Stripe's next delivery of it is accepted instead of being
+// rejected as a duplicate.
+//
+// Implementations must be safe for concurrent use.
+type Deduplicator interface {
+	// Forget removes the event with the given ID, so that it's accepted
+	// again the next time it's received.
+	Forget(id string) error
+
+	// MarkSeen records that the event with the given ID was received, and
+	// reports whether it had already been recorded within ttl. Checking and
+	// recording must happen atomically, so that only one of two concurrent
+	// deliveries of an event is accepted.
+	MarkSeen(id string, ttl time.Duration) (bool, error)
+}
+
+// MemoryDeduplicator is a Deduplicator that keeps event IDs in memory, which
+// is suitable for a single server. The zero value is ready to use.
+type MemoryDeduplicator struct {
+	expirations map[string]time.Time
+	mu          sync.Mutex
+	nextPrune   time.Time
+}
+
+// Forget removes the event with the given ID, so that it's accepted again
+// the next time it's received.
+func (d *MemoryDeduplicator) Forget(id string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.expirations, id)
+	return nil
+}
+
+// MarkSeen records that the event with the given ID was received, and
+// reports whether it had already been recorded within ttl.
+func (d *MemoryDeduplicator) MarkSeen(id string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.expirations == nil {
+		d.expirations = make(map[string]time.Time)
+	}
+	if now.After(d.nextPrune) {
+		d.prune(now)
+	}
+
+	if expiration, ok := d.expirations[id]; ok && now.Before(expiration) {
+		return true, nil
+	}
+	d.expirations[id] = now.Add(ttl)
+	return false, nil
+}
+
+//
+// Private constants
+//
+
+// memoryDeduplicatorPruneInterval is how often a MemoryDeduplicator removes
+// expired event IDs.
+const memoryDeduplicatorPruneInterval = 1 * time.Minute
+
+//
+// Private functions
+//
+
+// prune removes expired event IDs. It must be called with d.mu held.
+func (d *MemoryDeduplicator) prune(now time.Time) {
+	for id, expiration := range d.expirations {
+		if !now.Before(expiration) {
+			delete(d.expirations, id)
+		}
+	}
+	d.nextPrune = now.Add(memoryDeduplicatorPruneInterval)
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryDeduplicator(t *testing.T) {
+	d := &MemoryDeduplicator{}
+
+	seen, err := d.MarkSeen("evt_123", time.Hour)
+	if err != nil || seen {
+		t.Errorf("Expected a new event not to be seen, got %v, %v", seen, err)
+	}
+	seen, err = d.MarkSeen("evt_123", time.Hour)
+	if err != nil || !seen {
+		t.Errorf("Expected a recorded event to be seen, got %v, %v", seen, err)
+	}
+	seen, err = d.MarkSeen("evt_456", time.Hour)
+	if err != nil || seen {
+		t.Errorf("Expected another event not to be seen, got %v, %v", seen, err)
+	}
+}
+
+func TestMemoryDeduplicator_Forget(t *testing.T) {
+	d := &MemoryDeduplicator{}
+
+	if err := d.Forget("evt_123"); err != nil {
+		t.Errorf("Received %v error forgetting an unknown event", err)
+	}
+
+	d.MarkSeen("evt_123", time.Hour)
+	if err := d.Forget("evt_123"); err != nil {
+		t.Errorf("Received %v error forgetting an event", err)
+	}
+	seen, err := d.MarkSeen("evt_123", time.Hour)
+	if err != nil || seen {
+		t.Errorf("Expected a forgotten event not to be seen, got %v, %v", seen, err)
+	}
+}
+
+func TestMemoryDeduplicator_Expiration(t *testing.T) {
+	d := &MemoryDeduplicator{}
+
+	d.MarkSeen("evt_123", time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	seen, _ := d.MarkSeen("evt_123", time.Hour)
+	if seen {
+		t.Errorf("Expected an expired event not to be seen")
+	}
+
+	// Expired events are pruned
+	d.MarkSeen("evt_456", -time.Second)
+	d.prune(time.Now())
+	if _, ok := d.expirations["evt_456"]; ok {
+		t.Errorf("Expected an expired event to be pruned")
+	}
+	if _, ok := d.expirations["evt_123"]; !ok {
+		t.Errorf("Expected an unexpired event to be kept")
+	}
+}